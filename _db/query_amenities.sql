@@ -0,0 +1,47 @@
+-- Amenity queries for the amenities catalogue and the amenities of facilities
+
+-- name: ListAmenities :many
+SELECT id, slug, name, description, created_at, updated_at
+FROM amenities
+ORDER BY name ASC, slug ASC;
+
+-- name: GetAmenityByID :one
+SELECT id, slug, name, description, created_at, updated_at
+FROM amenities
+WHERE id = $1;
+
+-- name: CreateAmenity :one
+INSERT INTO amenities (id, slug, name, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, slug, name, description, created_at, updated_at;
+
+-- name: UpdateAmenity :one
+UPDATE amenities
+SET slug = $2,
+    name = $3,
+    description = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, slug, name, description, created_at, updated_at;
+
+-- name: DeleteAmenity :execrows
+DELETE FROM amenities
+WHERE id = $1;
+
+-- name: ListFacilityAmenities :many
+SELECT fa.facility_id, a.slug
+FROM facility_amenities fa
+JOIN amenities a ON a.id = fa.amenity_id
+WHERE fa.facility_id = ANY(sqlc.arg('facility_ids')::integer[])
+ORDER BY fa.facility_id ASC, a.slug ASC;
+
+-- name: AddFacilityAmenities :execrows
+-- Slugs of amenities that do not exist are ignored, so callers compare the number of added rows with the slugs.
+INSERT INTO facility_amenities (facility_id, amenity_id)
+SELECT sqlc.arg('facility_id')::integer, id
+FROM amenities
+WHERE slug = ANY(sqlc.arg('slugs')::varchar[]);
+
+-- name: DeleteFacilityAmenities :exec
+DELETE FROM facility_amenities
+WHERE facility_id = $1;
@@ -0,0 +1,30 @@
+-- Blackout queries for facility maintenance windows
+
+-- name: ListBlackouts :many
+SELECT id, facility_id, reason, starts_at, ends_at, rrule, time_zone, last_ends_at, created_at
+FROM facility_blackouts
+WHERE facility_id = $1
+ORDER BY starts_at ASC, id ASC;
+
+-- name: ListBlackoutsInRange :many
+-- Blackouts whose occurrences may overlap [from, to); recurring ones still have to be expanded.
+SELECT id, facility_id, reason, starts_at, ends_at, rrule, time_zone, last_ends_at, created_at
+FROM facility_blackouts
+WHERE facility_id = ANY(sqlc.arg('facility_ids')::integer[])
+  AND starts_at < sqlc.arg('to')::timestamptz
+  AND last_ends_at > sqlc.arg('from')::timestamptz
+ORDER BY facility_id ASC, starts_at ASC, id ASC;
+
+-- name: GetBlackoutByID :one
+SELECT id, facility_id, reason, starts_at, ends_at, rrule, time_zone, last_ends_at, created_at
+FROM facility_blackouts
+WHERE id = $1 AND facility_id = $2;
+
+-- name: CreateBlackout :one
+INSERT INTO facility_blackouts (id, facility_id, reason, starts_at, ends_at, rrule, time_zone, last_ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, facility_id, reason, starts_at, ends_at, rrule, time_zone, last_ends_at, created_at;
+
+-- name: DeleteBlackout :execrows
+DELETE FROM facility_blackouts
+WHERE id = $1 AND facility_id = $2;
@@ -0,0 +1,30 @@
+-- Booking policy queries for per-facility rules and the organization-wide default
+
+-- name: GetDefaultBookingPolicy :one
+SELECT id, facility_id, min_duration_minutes, max_duration_minutes, granularity_minutes, max_advance_days,
+       same_day_cutoff, created_at, updated_at
+FROM booking_policies
+WHERE facility_id IS NULL;
+
+-- name: GetFacilityBookingPolicy :one
+SELECT id, facility_id, min_duration_minutes, max_duration_minutes, granularity_minutes, max_advance_days,
+       same_day_cutoff, created_at, updated_at
+FROM booking_policies
+WHERE facility_id = $1;
+
+-- name: UpsertBookingPolicy :one
+-- A NULL facility_id sets the organization-wide default.
+INSERT INTO booking_policies (
+    id, facility_id, min_duration_minutes, max_duration_minutes, granularity_minutes, max_advance_days,
+    same_day_cutoff
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (facility_id) DO UPDATE
+SET min_duration_minutes = EXCLUDED.min_duration_minutes,
+    max_duration_minutes = EXCLUDED.max_duration_minutes,
+    granularity_minutes = EXCLUDED.granularity_minutes,
+    max_advance_days = EXCLUDED.max_advance_days,
+    same_day_cutoff = EXCLUDED.same_day_cutoff,
+    updated_at = NOW()
+RETURNING id, facility_id, min_duration_minutes, max_duration_minutes, granularity_minutes, max_advance_days,
+          same_day_cutoff, created_at, updated_at;
@@ -0,0 +1,45 @@
+-- Booking quota queries for per-user limits, organization-wide or per facility
+
+-- name: GetBookingQuota :one
+SELECT id, facility_id, user_id, max_hours_per_week, max_upcoming_reservations, created_at, updated_at
+FROM booking_quotas
+WHERE facility_id IS NOT DISTINCT FROM sqlc.narg('facility_id')
+  AND user_id IS NOT DISTINCT FROM sqlc.narg('user_id');
+
+-- name: ListUserBookingQuotas :many
+-- Quotas of every user together with those set for the given user, ordered by scope
+-- with the organization-wide ones first and overrides after the quota they replace.
+SELECT id, facility_id, user_id, max_hours_per_week, max_upcoming_reservations, created_at, updated_at
+FROM booking_quotas
+WHERE user_id IS NULL OR user_id = $1
+ORDER BY facility_id ASC NULLS FIRST, user_id ASC NULLS FIRST;
+
+-- name: UpsertBookingQuota :one
+INSERT INTO booking_quotas (id, facility_id, user_id, max_hours_per_week, max_upcoming_reservations)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (facility_id, user_id) DO UPDATE
+SET max_hours_per_week = EXCLUDED.max_hours_per_week,
+    max_upcoming_reservations = EXCLUDED.max_upcoming_reservations,
+    updated_at = NOW()
+RETURNING id, facility_id, user_id, max_hours_per_week, max_upcoming_reservations, created_at, updated_at;
+
+-- name: DeleteUserBookingQuota :execrows
+DELETE FROM booking_quotas
+WHERE facility_id IS NOT DISTINCT FROM sqlc.narg('facility_id')
+  AND user_id = sqlc.arg('user_id');
+
+-- name: GetBookingQuotaUsage :one
+-- Usage of the confirmed, pending and held reservations of a user counted against a quota, and of the part of
+-- no-shows blocked until their release.
+-- A NULL facility_id counts every facility.
+SELECT
+    COALESCE(SUM(EXTRACT(EPOCH FROM upper(period) - lower(period)) / 60) FILTER (
+        WHERE lower(period) >= sqlc.arg('week_starts_at')::timestamptz
+          AND lower(period) < sqlc.arg('week_ends_at')::timestamptz
+    ), 0)::bigint AS booked_minutes,
+    COUNT(*) FILTER (WHERE upper(period) > sqlc.arg('now')::timestamptz)::bigint AS upcoming_reservations
+FROM reservations
+WHERE user_id = sqlc.arg('user_id')
+  AND status IN ('confirmed', 'pending', 'held', 'no_show')
+  AND (sqlc.narg('facility_id')::integer IS NULL OR facility_id = sqlc.narg('facility_id'))
+  AND (sqlc.narg('exclude_id')::uuid IS NULL OR id <> sqlc.narg('exclude_id'));
@@ -0,0 +1,32 @@
+-- Delegation grant queries for booking on behalf of other users
+
+-- name: ListDelegationGrants :many
+-- Users filtered by user_id see the grants they gave and received.
+SELECT id, grantor_id, delegate_id, created_at
+FROM delegation_grants
+WHERE sqlc.narg('user_id')::uuid IS NULL
+   OR grantor_id = sqlc.narg('user_id')
+   OR delegate_id = sqlc.narg('user_id')
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetDelegationGrantByID :one
+SELECT id, grantor_id, delegate_id, created_at
+FROM delegation_grants
+WHERE id = $1;
+
+-- name: CreateDelegationGrant :one
+INSERT INTO delegation_grants (id, grantor_id, delegate_id)
+VALUES ($1, $2, $3)
+RETURNING id, grantor_id, delegate_id, created_at;
+
+-- name: DeleteDelegationGrant :exec
+DELETE FROM delegation_grants
+WHERE id = $1;
+
+-- name: HasDelegationGrant :one
+SELECT EXISTS (
+    SELECT 1
+    FROM delegation_grants
+    WHERE grantor_id = $1
+      AND delegate_id = $2
+);
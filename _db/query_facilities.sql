@@ -1,25 +1,131 @@
 -- Facilities queries for public and admin operations
 
 -- name: ListFacilities :many
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+-- Facilities filtered by amenities offer every one of them. The amenities must be listed once.
+-- Facilities filtered by location are located in it or in any location within it.
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
 WHERE is_active = true
+  AND (sqlc.narg('amenities')::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY(sqlc.narg('amenities')::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality(sqlc.narg('amenities')::varchar[])
+  ))
+  AND (sqlc.narg('location_id')::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = sqlc.narg('location_id')
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
 ORDER BY priority ASC, name ASC;
 
--- name: ListAllFacilities :many
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+-- name: ListFacilitiesPageByPriority :many
+-- Facilities are ordered by priority, with facilities without one last, then by name and id, in the order of
+-- idx_facilities_priority_page. A page starts after the priority, name and id of the last facility of the previous
+-- one. Facilities are filtered by amenities and location like ListFacilities.
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
-ORDER BY priority ASC, name ASC;
+WHERE (sqlc.narg('is_active')::boolean IS NULL OR is_active = sqlc.narg('is_active'))
+  AND (sqlc.narg('amenities')::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY(sqlc.narg('amenities')::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality(sqlc.narg('amenities')::varchar[])
+  ))
+  AND (sqlc.narg('location_id')::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = sqlc.narg('location_id')
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
+  AND (sqlc.narg('after_id')::integer IS NULL OR (COALESCE(priority, 9223372036854775807), name, id)
+      > (sqlc.narg('after_rank')::bigint, sqlc.narg('after_name')::varchar, sqlc.narg('after_id')::integer))
+ORDER BY COALESCE(priority, 9223372036854775807) ASC, name ASC, id ASC
+LIMIT sqlc.arg('page_limit');
+
+-- name: ListFacilitiesPageByName :many
+-- Facilities are ordered by name and id, in the order of idx_facilities_name_page. A page starts after the name and
+-- id of the last facility of the previous one. Facilities are filtered like ListFacilitiesPageByPriority.
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE (sqlc.narg('is_active')::boolean IS NULL OR is_active = sqlc.narg('is_active'))
+  AND (sqlc.narg('amenities')::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY(sqlc.narg('amenities')::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality(sqlc.narg('amenities')::varchar[])
+  ))
+  AND (sqlc.narg('location_id')::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = sqlc.narg('location_id')
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
+  AND (sqlc.narg('after_id')::integer IS NULL
+      OR (name, id) > (sqlc.narg('after_name')::varchar, sqlc.narg('after_id')::integer))
+ORDER BY name ASC, id ASC
+LIMIT sqlc.arg('page_limit');
 
 -- name: GetFacilityByID :one
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
 WHERE id = $1;
 
+-- name: GetFacilityByIDForUpdate :one
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetFacilityByIDForShare :one
+-- Locking the facility for share keeps its blackouts from being created until the reservation checked against
+-- them is written.
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE id = $1
+FOR SHARE;
+
+-- name: ListFacilitiesByIDs :many
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE id = ANY(sqlc.arg('ids')::integer[]);
+
 -- name: CreateFacility :one
-INSERT INTO facilities (name, description, location, priority, is_active)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at;
+INSERT INTO facilities (
+    name, description, location, priority, is_active, setup_buffer_minutes, teardown_buffer_minutes, requires_approval,
+    check_in_grace_minutes, capacity, location_id, time_zone
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone;
 
 -- name: UpdateFacility :one
 UPDATE facilities
@@ -28,9 +134,18 @@ SET name = $2,
     location = $4,
     priority = $5,
     is_active = $6,
+    setup_buffer_minutes = $7,
+    teardown_buffer_minutes = $8,
+    requires_approval = $9,
+    check_in_grace_minutes = $10,
+    capacity = $11,
+    location_id = $12,
+    time_zone = $13,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at;
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone;
 
 -- name: UpdateFacilityPartial :one
 UPDATE facilities
@@ -39,10 +154,67 @@ SET name = COALESCE(sqlc.narg('name'), name),
     location = COALESCE(sqlc.narg('location'), location),
     priority = COALESCE(sqlc.narg('priority'), priority),
     is_active = COALESCE(sqlc.narg('is_active'), is_active),
+    setup_buffer_minutes = COALESCE(sqlc.narg('setup_buffer_minutes'), setup_buffer_minutes),
+    teardown_buffer_minutes = COALESCE(sqlc.narg('teardown_buffer_minutes'), teardown_buffer_minutes),
+    requires_approval = COALESCE(sqlc.narg('requires_approval'), requires_approval),
+    check_in_grace_minutes = COALESCE(sqlc.narg('check_in_grace_minutes'), check_in_grace_minutes),
+    capacity = COALESCE(sqlc.narg('capacity'), capacity),
+    location_id = COALESCE(sqlc.narg('location_id'), location_id),
+    time_zone = COALESCE(sqlc.narg('time_zone'), time_zone),
     updated_at = NOW()
 WHERE id = sqlc.arg('id')
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at;
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone;
 
--- name: DeleteFacility :exec
+-- name: DeleteFacility :execrows
 DELETE FROM facilities
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: ListFacilityTimeZones :many
+-- The time zone of a facility is its own, else that of the nearest location containing it that sets one, else UTC.
+WITH RECURSIVE chain AS (
+    SELECT f.id AS facility_id, f.time_zone, f.location_id AS next_id, 0 AS depth
+    FROM facilities f
+    WHERE f.id = ANY(sqlc.arg('facility_ids')::integer[])
+    UNION ALL
+    SELECT c.facility_id, l.time_zone, l.parent_id, c.depth + 1
+    FROM chain c
+    JOIN locations l ON l.id = c.next_id
+    WHERE c.time_zone IS NULL
+)
+SELECT DISTINCT ON (facility_id) facility_id, COALESCE(time_zone, 'UTC')::varchar AS time_zone
+FROM chain
+ORDER BY facility_id, time_zone IS NULL, depth;
+
+-- name: SearchFacilities :many
+-- Active facilities match when their name, location or description contains any word of the query, or when their
+-- name is similar to the query. They are ranked by how well their words match, weighted by field, plus the similarity
+-- of their name. Headlines enclose the matched words in <mark> and </mark>.
+-- The document must be the expression of idx_facilities_search for the index to be used.
+WITH search AS (
+    SELECT replace(plainto_tsquery('english', sqlc.arg('query')::text)::text, ' & ', ' | ')::tsquery AS terms
+)
+SELECT f.id,
+       (ts_rank_cd(
+           setweight(to_tsvector('english', f.name), 'A')
+           || setweight(to_tsvector('english', coalesce(f.location, '')), 'B')
+           || setweight(to_tsvector('english', coalesce(f.description, '')), 'C'),
+           s.terms
+       ) + similarity(f.name, sqlc.arg('query')::text))::float8 AS rank,
+       ts_headline('english', f.name, s.terms,
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS name_headline,
+       ts_headline('english', coalesce(f.location, ''), s.terms,
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS location_headline,
+       ts_headline('english', coalesce(f.description, ''), s.terms,
+                   'MaxFragments=2, StartSel=<mark>, StopSel=</mark>')::text AS description_headline
+FROM facilities f
+CROSS JOIN search s
+WHERE f.is_active = true
+  AND ((
+      setweight(to_tsvector('english', f.name), 'A')
+      || setweight(to_tsvector('english', coalesce(f.location, '')), 'B')
+      || setweight(to_tsvector('english', coalesce(f.description, '')), 'C')
+  ) @@ s.terms OR f.name % sqlc.arg('query')::text)
+ORDER BY rank DESC, f.name ASC, f.id ASC
+LIMIT sqlc.arg('result_limit');
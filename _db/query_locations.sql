@@ -0,0 +1,29 @@
+-- Location queries for the site, building and floor hierarchy
+
+-- name: ListLocations :many
+SELECT id, parent_id, kind, name, created_at, updated_at, time_zone
+FROM locations
+ORDER BY name ASC, id ASC;
+
+-- name: GetLocationByID :one
+SELECT id, parent_id, kind, name, created_at, updated_at, time_zone
+FROM locations
+WHERE id = $1;
+
+-- name: CreateLocation :one
+INSERT INTO locations (id, parent_id, kind, name, time_zone)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, parent_id, kind, name, created_at, updated_at, time_zone;
+
+-- name: UpdateLocation :one
+UPDATE locations
+SET parent_id = $2,
+    name = $3,
+    time_zone = $4,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, parent_id, kind, name, created_at, updated_at, time_zone;
+
+-- name: DeleteLocation :execrows
+DELETE FROM locations
+WHERE id = $1;
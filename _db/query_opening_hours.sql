@@ -0,0 +1,38 @@
+-- Opening hours queries for facility booking windows
+
+-- name: ListOpeningHours :many
+SELECT id, facility_id, weekday, opens_at, closes_at, created_at
+FROM facility_opening_hours
+WHERE facility_id = ANY(sqlc.arg('facility_ids')::integer[])
+ORDER BY facility_id ASC, weekday ASC, opens_at ASC;
+
+-- name: CreateOpeningHours :one
+INSERT INTO facility_opening_hours (id, facility_id, weekday, opens_at, closes_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, facility_id, weekday, opens_at, closes_at, created_at;
+
+-- name: DeleteOpeningHours :exec
+DELETE FROM facility_opening_hours
+WHERE facility_id = $1;
+
+-- name: ListOpeningHourOverrides :many
+SELECT facility_id, date, opens_at, closes_at, reason, created_at, updated_at
+FROM facility_opening_hour_overrides
+WHERE facility_id = ANY(sqlc.arg('facility_ids')::integer[])
+  AND (sqlc.narg('from')::date IS NULL OR date >= sqlc.narg('from'))
+  AND (sqlc.narg('to')::date IS NULL OR date <= sqlc.narg('to'))
+ORDER BY facility_id ASC, date ASC;
+
+-- name: UpsertOpeningHourOverride :one
+INSERT INTO facility_opening_hour_overrides (facility_id, date, opens_at, closes_at, reason)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (facility_id, date) DO UPDATE
+SET opens_at = EXCLUDED.opens_at,
+    closes_at = EXCLUDED.closes_at,
+    reason = EXCLUDED.reason,
+    updated_at = NOW()
+RETURNING facility_id, date, opens_at, closes_at, reason, created_at, updated_at;
+
+-- name: DeleteOpeningHourOverride :execrows
+DELETE FROM facility_opening_hour_overrides
+WHERE facility_id = $1 AND date = $2;
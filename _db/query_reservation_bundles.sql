@@ -0,0 +1,42 @@
+-- Reservation bundle queries for booking several facilities at once
+
+-- name: GetReservationBundleByID :one
+SELECT id, user_id, title, description, status, cancelled_at, created_at, updated_at
+FROM reservation_bundles
+WHERE id = $1;
+
+-- name: GetReservationBundleByIDForUpdate :one
+SELECT id, user_id, title, description, status, cancelled_at, created_at, updated_at
+FROM reservation_bundles
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListReservationBundles :many
+-- Users filtered by user_id see their own bundles and those of the users who granted them delegation.
+SELECT id, user_id, title, description, status, cancelled_at, created_at, updated_at
+FROM reservation_bundles
+WHERE (
+    sqlc.narg('user_id')::uuid IS NULL
+    OR user_id = sqlc.narg('user_id')
+    OR user_id IN (SELECT grantor_id FROM delegation_grants WHERE delegate_id = sqlc.narg('user_id'))
+)
+ORDER BY created_at ASC, id ASC;
+
+-- name: CreateReservationBundle :one
+INSERT INTO reservation_bundles (id, user_id, title, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, title, description, status, cancelled_at, created_at, updated_at;
+
+-- name: TouchReservationBundle :one
+UPDATE reservation_bundles
+SET updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, title, description, status, cancelled_at, created_at, updated_at;
+
+-- name: CancelReservationBundle :one
+UPDATE reservation_bundles
+SET status = 'cancelled',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, title, description, status, cancelled_at, created_at, updated_at;
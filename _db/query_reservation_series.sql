@@ -0,0 +1,49 @@
+-- Reservation series queries for recurring bookings
+
+-- name: GetReservationSeriesByID :one
+SELECT id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at
+FROM reservation_series
+WHERE id = $1;
+
+-- name: GetReservationSeriesByIDForUpdate :one
+SELECT id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at
+FROM reservation_series
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListReservationSeries :many
+-- Users filtered by user_id see their own series and those of the users who granted them delegation.
+SELECT id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at
+FROM reservation_series
+WHERE (
+    sqlc.narg('user_id')::uuid IS NULL
+    OR user_id = sqlc.narg('user_id')
+    OR user_id IN (SELECT grantor_id FROM delegation_grants WHERE delegate_id = sqlc.narg('user_id'))
+)
+ORDER BY starts_at ASC, id ASC;
+
+-- name: CreateReservationSeries :one
+INSERT INTO reservation_series (id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at;
+
+-- name: UpdateReservationSeries :one
+UPDATE reservation_series
+SET facility_id = $2,
+    title = $3,
+    description = $4,
+    rrule = $5,
+    time_zone = $6,
+    starts_at = $7,
+    ends_at = $8,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at;
+
+-- name: CancelReservationSeries :one
+UPDATE reservation_series
+SET status = 'cancelled',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, facility_id, user_id, title, description, rrule, time_zone, starts_at, ends_at, status, cancelled_at, created_at, updated_at;
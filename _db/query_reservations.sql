@@ -0,0 +1,413 @@
+-- Reservations queries for booking operations
+
+-- name: GetReservationByID :one
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE id = $1;
+
+-- name: GetReservationByIDForUpdate :one
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListReservations :many
+-- Users filtered by user_id see their own reservations, those of the users who granted them delegation
+-- and those they attend.
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE (
+    sqlc.narg('user_id')::uuid IS NULL
+    OR user_id = sqlc.narg('user_id')
+    OR user_id IN (SELECT grantor_id FROM delegation_grants WHERE delegate_id = sqlc.narg('user_id'))
+    OR sqlc.narg('user_id') = ANY(attendee_user_ids)
+)
+  AND (sqlc.narg('facility_id')::integer IS NULL OR facility_id = sqlc.narg('facility_id'))
+  AND (sqlc.narg('from')::timestamptz IS NULL OR upper(period) > sqlc.narg('from'))
+  AND (sqlc.narg('to')::timestamptz IS NULL OR lower(period) < sqlc.narg('to'))
+  AND status <> 'held'
+  AND (sqlc.arg('include_cancelled')::boolean OR status IN ('confirmed', 'pending'))
+ORDER BY lower(period) ASC, id ASC;
+
+-- name: CreateReservation :one
+INSERT INTO reservations (
+    id, facility_id, user_id, title, description, period, blocked_period, status, booked_by, attendee_user_ids,
+    attendee_emails
+)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('title'),
+    sqlc.narg('description'),
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    sqlc.arg('status'),
+    sqlc.arg('booked_by')::uuid,
+    sqlc.arg('attendee_user_ids')::uuid[],
+    sqlc.arg('attendee_emails')::varchar[]
+)
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: CreateReservationIfFree :execrows
+-- Used for promotions from the waitlist, which are skipped instead of failing the transaction when the period is taken.
+INSERT INTO reservations (id, facility_id, user_id, title, description, period, blocked_period, status, booked_by)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('title'),
+    sqlc.narg('description'),
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    sqlc.arg('status'),
+    sqlc.arg('user_id')
+)
+ON CONFLICT DO NOTHING;
+
+-- name: UpdateReservation :one
+-- Attendees are kept unless given.
+UPDATE reservations
+SET facility_id = sqlc.arg('facility_id'),
+    title = sqlc.arg('title'),
+    description = sqlc.narg('description'),
+    period = tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    blocked_period = tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    status = sqlc.arg('status'),
+    attendee_user_ids = COALESCE(sqlc.narg('attendee_user_ids')::uuid[], attendee_user_ids),
+    attendee_emails = COALESCE(sqlc.narg('attendee_emails')::varchar[], attendee_emails),
+    is_exception = series_id IS NOT NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: CancelReservation :one
+UPDATE reservations
+SET status = 'cancelled',
+    cancelled_at = NOW(),
+    is_exception = series_id IS NOT NULL,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: ListPendingReservations :many
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE status = 'pending'
+  AND (sqlc.narg('facility_id')::integer IS NULL OR facility_id = sqlc.narg('facility_id'))
+ORDER BY lower(period) ASC, id ASC;
+
+-- name: ReviewReservation :one
+UPDATE reservations
+SET status = sqlc.arg('status'),
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: ExpirePendingReservations :many
+-- Requests that were neither approved nor rejected before they start release their period.
+UPDATE reservations
+SET status = 'expired',
+    updated_at = NOW()
+WHERE status = 'pending'
+  AND lower(period) <= sqlc.arg('now')::timestamptz
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: CheckInReservation :one
+UPDATE reservations
+SET checked_in_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: CheckOutReservation :one
+-- The rest of the period, including the teardown buffer, is released for other reservations.
+UPDATE reservations
+SET checked_out_at = NOW(),
+    period = tstzrange(lower(period), sqlc.arg('ends_at')::timestamptz, '[)'),
+    blocked_period = tstzrange(lower(blocked_period), sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: ReleaseNoShows :many
+-- Running reservations of facilities with a check-in grace period that were not checked in by its end
+-- release their remaining period. Like checking out, the period ends at the release, so that the part of it
+-- that was blocked stays counted against booking quotas. The deadline must have passed strictly, so that a
+-- reservation without a grace period is not released into an empty period at its start.
+-- Reservations are returned as they were before the release, so that the released periods can be read from them.
+WITH released AS (
+    SELECT r.id, r.facility_id, r.user_id, r.title, r.description, r.period, r.status, r.cancelled_at, r.created_at,
+           r.updated_at, r.series_id, r.original_starts_at, r.is_exception, r.blocked_period, r.reviewed_at,
+           r.hold_expires_at, r.checked_in_at, r.checked_out_at, r.booked_by, r.bundle_id, r.attendee_user_ids,
+           r.attendee_emails
+    FROM reservations r
+    JOIN facilities f ON f.id = r.facility_id
+    WHERE f.check_in_grace_minutes IS NOT NULL
+      AND r.status = 'confirmed'
+      AND r.checked_in_at IS NULL
+      AND lower(r.period) + f.check_in_grace_minutes * INTERVAL '1 minute' < sqlc.arg('now')::timestamptz
+      AND upper(r.period) > sqlc.arg('now')::timestamptz
+    FOR UPDATE OF r
+)
+UPDATE reservations r
+SET status = 'no_show',
+    period = tstzrange(lower(r.period), sqlc.arg('now')::timestamptz, '[)'),
+    blocked_period = tstzrange(lower(r.blocked_period), sqlc.arg('now')::timestamptz, '[)'),
+    updated_at = NOW()
+FROM released
+WHERE r.id = released.id
+RETURNING released.id, released.facility_id, released.user_id, released.title, released.description, released.period,
+          released.status, released.cancelled_at, released.created_at, released.updated_at, released.series_id,
+          released.original_starts_at, released.is_exception, released.blocked_period, released.reviewed_at,
+          released.hold_expires_at, released.checked_in_at, released.checked_out_at, released.booked_by,
+          released.bundle_id, released.attendee_user_ids, released.attendee_emails;
+
+-- name: CreateHold :one
+-- A hold has no details until it is confirmed.
+INSERT INTO reservations (id, facility_id, user_id, title, period, blocked_period, status, hold_expires_at, booked_by)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    '',
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    'held',
+    sqlc.arg('expires_at')::timestamptz,
+    sqlc.arg('user_id')
+)
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: ConfirmHold :one
+UPDATE reservations
+SET title = sqlc.arg('title'),
+    description = sqlc.narg('description'),
+    status = sqlc.arg('status'),
+    hold_expires_at = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: DeleteExpiredHolds :many
+DELETE FROM reservations
+WHERE status = 'held'
+  AND hold_expires_at <= sqlc.arg('now')::timestamptz
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: DeleteExpiredFacilityHolds :exec
+DELETE FROM reservations
+WHERE facility_id = sqlc.arg('facility_id')
+  AND status = 'held'
+  AND hold_expires_at <= sqlc.arg('now')::timestamptz;
+
+-- name: DeleteReservation :exec
+DELETE FROM reservations
+WHERE id = $1;
+
+-- name: ListFacilityAvailability :many
+-- A new reservation needs room for its own buffers, so the periods blocked by existing reservations are widened
+-- by the teardown buffer before and the setup buffer after them.
+-- Facilities filtered by amenities offer every one of them. The amenities must be listed once.
+-- Facilities filtered by location are located in it or in any location within it.
+-- Buffers are at most 1440 minutes, so reservations further away from the window than that are never read.
+WITH blocked AS (
+    SELECT r.facility_id,
+           tstzrange(
+               lower(r.blocked_period) - f.teardown_buffer_minutes * INTERVAL '1 minute',
+               upper(r.blocked_period) + f.setup_buffer_minutes * INTERVAL '1 minute',
+               '[)'
+           ) AS period
+    FROM reservations r
+    JOIN facilities f ON f.id = r.facility_id
+    WHERE r.status IN ('confirmed', 'pending', 'held')
+      AND r.blocked_period && tstzrange(
+          sqlc.arg('from')::timestamptz - INTERVAL '1440 minutes',
+          sqlc.arg('to')::timestamptz + INTERVAL '1440 minutes',
+          '[)'
+      )
+),
+busy AS (
+    SELECT facility_id, range_agg(period) AS periods
+    FROM blocked
+    WHERE period && tstzrange(sqlc.arg('from')::timestamptz, sqlc.arg('to')::timestamptz, '[)')
+    GROUP BY facility_id
+)
+SELECT f.id AS facility_id,
+       f.name AS facility_name,
+       lower(free.period)::timestamptz AS starts_at,
+       upper(free.period)::timestamptz AS ends_at
+FROM facilities f
+LEFT JOIN busy b ON b.facility_id = f.id
+CROSS JOIN LATERAL unnest(
+    tstzmultirange(tstzrange(sqlc.arg('from')::timestamptz, sqlc.arg('to')::timestamptz, '[)'))
+    - COALESCE(b.periods, '{}'::tstzmultirange)
+) AS free(period)
+WHERE f.is_active = true
+  AND (sqlc.narg('facility_ids')::integer[] IS NULL OR f.id = ANY(sqlc.narg('facility_ids')::integer[]))
+  AND (sqlc.narg('amenities')::varchar[] IS NULL OR f.id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY(sqlc.narg('amenities')::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality(sqlc.narg('amenities')::varchar[])
+  ))
+  AND (sqlc.narg('location_id')::uuid IS NULL OR f.location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = sqlc.narg('location_id')
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
+  AND lower(free.period) + sqlc.arg('min_duration_minutes')::integer * INTERVAL '1 minute' <= upper(free.period)
+ORDER BY f.priority ASC, f.name ASC, f.id ASC, lower(free.period) ASC;
+
+-- name: CreateSeriesOccurrence :execrows
+-- Occurrences overlapping a confirmed reservation are skipped instead of failing the transaction.
+INSERT INTO reservations (
+    id, facility_id, user_id, title, description, period, blocked_period, series_id, original_starts_at, booked_by
+)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('title'),
+    sqlc.narg('description'),
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    sqlc.arg('series_id')::uuid,
+    sqlc.arg('starts_at')::timestamptz,
+    sqlc.arg('user_id')
+)
+ON CONFLICT DO NOTHING;
+
+-- name: ListReservationsBySeriesIDs :many
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE series_id = ANY(sqlc.arg('series_ids')::uuid[])
+  AND status = 'confirmed'
+ORDER BY lower(period) ASC, id ASC;
+
+-- name: ListSeriesExceptions :many
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE series_id = sqlc.arg('series_id')::uuid
+  AND is_exception
+ORDER BY original_starts_at ASC;
+
+-- name: MoveSeriesExceptions :execrows
+-- Used when a series is split so that exceptions after the split point follow the new series.
+UPDATE reservations
+SET series_id = sqlc.arg('new_series_id')::uuid,
+    updated_at = NOW()
+WHERE series_id = sqlc.arg('series_id')::uuid
+  AND is_exception
+  AND original_starts_at >= sqlc.arg('from')::timestamptz;
+
+-- name: DeleteSeriesReservationsFrom :execrows
+-- Occurrences edited or skipped individually are kept.
+DELETE FROM reservations
+WHERE series_id = sqlc.arg('series_id')::uuid
+  AND status = 'confirmed'
+  AND NOT is_exception
+  AND lower(period) >= sqlc.arg('from')::timestamptz;
+
+-- name: CancelSeriesReservationsFrom :many
+UPDATE reservations
+SET status = 'cancelled',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE series_id = sqlc.arg('series_id')::uuid
+  AND status = 'confirmed'
+  AND lower(period) >= sqlc.arg('from')::timestamptz
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: CreateBundleReservation :one
+-- Facilities requiring approval cannot be reserved by bundle, so reservations of a bundle are always confirmed.
+-- Bundles have no attendees.
+INSERT INTO reservations (
+    id, facility_id, user_id, title, description, period, blocked_period, booked_by, bundle_id, attendee_user_ids,
+    attendee_emails
+)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('title'),
+    sqlc.narg('description'),
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)'),
+    tstzrange(sqlc.arg('blocked_starts_at')::timestamptz, sqlc.arg('blocked_ends_at')::timestamptz, '[)'),
+    sqlc.arg('user_id'),
+    sqlc.arg('bundle_id')::uuid,
+    '{}'::uuid[],
+    '{}'::varchar[]
+)
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
+
+-- name: ListReservationsByBundleIDs :many
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE bundle_id = ANY(sqlc.arg('bundle_ids')::uuid[])
+ORDER BY lower(period) ASC, id ASC;
+
+-- name: ListActiveBundleReservationsForUpdate :many
+SELECT id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+       original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+       booked_by, bundle_id, attendee_user_ids, attendee_emails
+FROM reservations
+WHERE bundle_id = sqlc.arg('bundle_id')::uuid
+  AND status = 'confirmed'
+ORDER BY lower(period) ASC, id ASC
+FOR UPDATE;
+
+-- name: CancelBundleReservations :many
+UPDATE reservations
+SET status = 'cancelled',
+    cancelled_at = NOW(),
+    updated_at = NOW()
+WHERE bundle_id = sqlc.arg('bundle_id')::uuid
+  AND status = 'confirmed'
+RETURNING id, facility_id, user_id, title, description, period, status, cancelled_at, created_at, updated_at, series_id,
+          original_starts_at, is_exception, blocked_period, reviewed_at, hold_expires_at, checked_in_at, checked_out_at,
+          booked_by, bundle_id, attendee_user_ids, attendee_emails;
@@ -8,29 +8,52 @@ WHERE t.token = $1
   AND (t.expires_at IS NULL OR t.expires_at > NOW());
 
 -- name: GetUserByID :one
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users 
 WHERE id = $1;
 
+-- name: GetUserByIDForUpdate :one
+SELECT id, username, is_staff, created_at, email
+FROM users
+WHERE id = $1
+FOR UPDATE;
+
 -- name: GetUserByUsername :one
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users 
 WHERE username = $1;
 
 -- name: ListUsers :many
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users
 ORDER BY created_at;
 
 -- name: CreateUser :one
-INSERT INTO users (id, username, is_staff)
-VALUES ($1, $2, $3)
-RETURNING id, username, is_staff, created_at;
+INSERT INTO users (id, username, is_staff, email)
+VALUES ($1, $2, $3, $4)
+RETURNING id, username, is_staff, created_at, email;
+
+-- name: UpdateUser :one
+UPDATE users
+SET username = $2, email = $3, is_staff = $4
+WHERE id = $1
+RETURNING id, username, is_staff, created_at, email;
 
--- name: DeleteUser :exec
+-- name: DeleteUser :execrows
+-- The user is removed from the attendees of reservations as well.
+WITH detached AS (
+    UPDATE reservations
+    SET attendee_user_ids = array_remove(attendee_user_ids, $1)
+    WHERE $1 = ANY(attendee_user_ids)
+)
 DELETE FROM users
 WHERE id = $1;
 
+-- name: CountUsersByIDs :one
+SELECT COUNT(*)
+FROM users
+WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
 -- name: CreateToken :one
 INSERT INTO user_tokens (id, user_id, token, name, expires_at)
 VALUES ($1, $2, $3, $4, $5)
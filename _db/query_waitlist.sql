@@ -0,0 +1,49 @@
+-- Waitlist queries for requests of already reserved periods
+
+-- name: ListWaitlistEntries :many
+SELECT id, facility_id, user_id, title, description, period, reservation_id, promoted_at, created_at
+FROM waitlist_entries
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id'))
+  AND (sqlc.narg('facility_id')::integer IS NULL OR facility_id = sqlc.narg('facility_id'))
+  AND (sqlc.arg('include_promoted')::boolean OR promoted_at IS NULL)
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetWaitlistEntryByIDForUpdate :one
+SELECT id, facility_id, user_id, title, description, period, reservation_id, promoted_at, created_at
+FROM waitlist_entries
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CreateWaitlistEntry :one
+INSERT INTO waitlist_entries (id, facility_id, user_id, title, description, period)
+VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('facility_id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('title'),
+    sqlc.narg('description'),
+    tstzrange(sqlc.arg('starts_at')::timestamptz, sqlc.arg('ends_at')::timestamptz, '[)')
+)
+RETURNING id, facility_id, user_id, title, description, period, reservation_id, promoted_at, created_at;
+
+-- name: DeleteWaitlistEntry :exec
+DELETE FROM waitlist_entries
+WHERE id = $1;
+
+-- name: ListWaitlistCandidatesForUpdate :many
+-- Waiting entries of the facility overlapping the released period that have not started, in the order they were made.
+SELECT id, facility_id, user_id, title, description, period, reservation_id, promoted_at, created_at
+FROM waitlist_entries
+WHERE facility_id = sqlc.arg('facility_id')
+  AND promoted_at IS NULL
+  AND period && tstzrange(sqlc.arg('from')::timestamptz, sqlc.arg('to')::timestamptz, '[)')
+  AND lower(period) > sqlc.arg('now')::timestamptz
+ORDER BY created_at ASC, id ASC
+FOR UPDATE;
+
+-- name: PromoteWaitlistEntry :one
+UPDATE waitlist_entries
+SET reservation_id = sqlc.arg('reservation_id')::uuid,
+    promoted_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING id, facility_id, user_id, title, description, period, reservation_id, promoted_at, created_at;
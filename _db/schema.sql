@@ -14,6 +14,34 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: btree_gist; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS btree_gist WITH SCHEMA public;
+
+
+--
+-- Name: EXTENSION btree_gist; Type: COMMENT; Schema: -; Owner: -
+--
+
+COMMENT ON EXTENSION btree_gist IS 'support for indexing common datatypes in GiST';
+
+
+--
+-- Name: pg_trgm; Type: EXTENSION; Schema: -; Owner: -
+--
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+
+--
+-- Name: EXTENSION pg_trgm; Type: COMMENT; Schema: -; Owner: -
+--
+
+COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement and index searching based on trigrams';
+
+
 --
 -- Name: uuid-ossp; Type: EXTENSION; Schema: -; Owner: -
 --
@@ -28,10 +56,102 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
 
 
+--
+-- Name: location_kind; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.location_kind AS ENUM (
+    'site',
+    'building',
+    'floor'
+);
+
+
+--
+-- Name: reservation_status; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.reservation_status AS ENUM (
+    'confirmed',
+    'cancelled',
+    'pending',
+    'rejected',
+    'expired',
+    'held',
+    'no_show'
+);
+
+
 SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: amenities; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.amenities (
+    id uuid NOT NULL,
+    slug character varying(50) NOT NULL,
+    name character varying(100) NOT NULL,
+    description text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT amenities_slug_check CHECK (((slug)::text ~ '^[a-z0-9]+(-[a-z0-9]+)*$'::text))
+);
+
+
+--
+-- Name: booking_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.booking_policies (
+    id uuid NOT NULL,
+    facility_id integer,
+    min_duration_minutes integer,
+    max_duration_minutes integer,
+    granularity_minutes integer,
+    max_advance_days integer,
+    same_day_cutoff time without time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT booking_policies_granularity CHECK (((granularity_minutes > 0) AND ((1440 % granularity_minutes) = 0))),
+    CONSTRAINT booking_policies_max_advance_days CHECK ((max_advance_days > 0)),
+    CONSTRAINT booking_policies_max_duration CHECK ((max_duration_minutes >= COALESCE(min_duration_minutes, 1))),
+    CONSTRAINT booking_policies_min_duration CHECK ((min_duration_minutes > 0))
+);
+
+
+--
+-- Name: booking_quotas; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.booking_quotas (
+    id uuid NOT NULL,
+    facility_id integer,
+    user_id uuid,
+    max_hours_per_week integer,
+    max_upcoming_reservations integer,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT booking_quotas_max_hours_per_week CHECK (((max_hours_per_week >= 0) AND (max_hours_per_week <= 168))),
+    CONSTRAINT booking_quotas_max_upcoming_reservations CHECK ((max_upcoming_reservations >= 0))
+);
+
+
+--
+-- Name: delegation_grants; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.delegation_grants (
+    id uuid NOT NULL,
+    grantor_id uuid NOT NULL,
+    delegate_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT delegation_grants_distinct_users CHECK ((grantor_id <> delegate_id))
+);
+
+
 --
 -- Name: facilities; Type: TABLE; Schema: public; Owner: -
 --
@@ -45,6 +165,16 @@ CREATE TABLE public.facilities (
     is_active boolean DEFAULT true NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    setup_buffer_minutes integer DEFAULT 0 NOT NULL,
+    teardown_buffer_minutes integer DEFAULT 0 NOT NULL,
+    requires_approval boolean DEFAULT false NOT NULL,
+    check_in_grace_minutes integer,
+    capacity integer,
+    location_id uuid,
+    time_zone character varying(64),
+    CONSTRAINT facilities_buffers_check CHECK ((((setup_buffer_minutes >= 0) AND (setup_buffer_minutes <= 1440)) AND ((teardown_buffer_minutes >= 0) AND (teardown_buffer_minutes <= 1440)))),
+    CONSTRAINT facilities_capacity_check CHECK ((capacity > 0)),
+    CONSTRAINT facilities_check_in_grace_minutes_check CHECK (((check_in_grace_minutes >= 0) AND (check_in_grace_minutes <= 1440))),
     CONSTRAINT facilities_priority_check CHECK ((priority >= 0))
 );
 
@@ -69,6 +199,158 @@ CREATE SEQUENCE public.facilities_id_seq
 ALTER SEQUENCE public.facilities_id_seq OWNED BY public.facilities.id;
 
 
+--
+-- Name: facility_amenities; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.facility_amenities (
+    facility_id integer NOT NULL,
+    amenity_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: facility_blackouts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.facility_blackouts (
+    id uuid NOT NULL,
+    facility_id integer NOT NULL,
+    reason character varying(200) NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    rrule text,
+    time_zone character varying(64),
+    last_ends_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT facility_blackouts_first_occurrence CHECK ((starts_at < ends_at)),
+    CONSTRAINT facility_blackouts_last_ends_at CHECK ((ends_at <= last_ends_at)),
+    CONSTRAINT facility_blackouts_recurrence CHECK (((rrule IS NULL) = (time_zone IS NULL)))
+);
+
+
+--
+-- Name: facility_opening_hour_overrides; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.facility_opening_hour_overrides (
+    facility_id integer NOT NULL,
+    date date NOT NULL,
+    opens_at time without time zone,
+    closes_at time without time zone,
+    reason text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT facility_opening_hour_overrides_period CHECK ((((opens_at IS NULL) AND (closes_at IS NULL)) OR (opens_at < closes_at)))
+);
+
+
+--
+-- Name: facility_opening_hours; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.facility_opening_hours (
+    id uuid NOT NULL,
+    facility_id integer NOT NULL,
+    weekday smallint NOT NULL,
+    opens_at time without time zone NOT NULL,
+    closes_at time without time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT facility_opening_hours_period CHECK ((opens_at < closes_at)),
+    CONSTRAINT facility_opening_hours_weekday CHECK (((weekday >= 0) AND (weekday <= 6)))
+);
+
+
+--
+-- Name: locations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.locations (
+    id uuid NOT NULL,
+    parent_id uuid,
+    kind public.location_kind NOT NULL,
+    name character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    time_zone character varying(64),
+    CONSTRAINT locations_site_is_root CHECK (((kind = 'site'::public.location_kind) = (parent_id IS NULL)))
+);
+
+
+--
+-- Name: reservation_bundles; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.reservation_bundles (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    title character varying(200) NOT NULL,
+    description text,
+    status public.reservation_status DEFAULT 'confirmed'::public.reservation_status NOT NULL,
+    cancelled_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: reservation_series; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.reservation_series (
+    id uuid NOT NULL,
+    facility_id integer NOT NULL,
+    user_id uuid NOT NULL,
+    title character varying(200) NOT NULL,
+    description text,
+    rrule text NOT NULL,
+    time_zone character varying(64) NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    status public.reservation_status DEFAULT 'confirmed'::public.reservation_status NOT NULL,
+    cancelled_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT reservation_series_first_occurrence CHECK ((starts_at < ends_at))
+);
+
+
+--
+-- Name: reservations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.reservations (
+    id uuid NOT NULL,
+    facility_id integer NOT NULL,
+    user_id uuid NOT NULL,
+    title character varying(200) NOT NULL,
+    description text,
+    period tstzrange NOT NULL,
+    status public.reservation_status DEFAULT 'confirmed'::public.reservation_status NOT NULL,
+    cancelled_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    series_id uuid,
+    original_starts_at timestamp with time zone,
+    is_exception boolean DEFAULT false NOT NULL,
+    blocked_period tstzrange NOT NULL,
+    reviewed_at timestamp with time zone,
+    hold_expires_at timestamp with time zone,
+    checked_in_at timestamp with time zone,
+    checked_out_at timestamp with time zone,
+    booked_by uuid,
+    bundle_id uuid,
+    attendee_user_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    attendee_emails character varying(254)[] DEFAULT '{}'::character varying[] NOT NULL,
+    CONSTRAINT reservations_blocked_period_covers CHECK ((blocked_period @> period)),
+    CONSTRAINT reservations_checked_out_at CHECK (((checked_out_at IS NULL) OR (checked_in_at IS NOT NULL))),
+    CONSTRAINT reservations_hold_expires_at CHECK (((status = 'held'::public.reservation_status) = (hold_expires_at IS NOT NULL))),
+    CONSTRAINT reservations_period_bounded CHECK (((NOT isempty(period)) AND (NOT lower_inf(period)) AND (NOT upper_inf(period)))),
+    CONSTRAINT reservations_series_original_starts_at CHECK (((series_id IS NULL) OR (original_starts_at IS NOT NULL)))
+);
+
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -101,7 +383,27 @@ CREATE TABLE public.users (
     id uuid NOT NULL,
     username character varying(100) NOT NULL,
     is_staff boolean DEFAULT false NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    email character varying(254)
+);
+
+
+--
+-- Name: waitlist_entries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.waitlist_entries (
+    id uuid NOT NULL,
+    facility_id integer NOT NULL,
+    user_id uuid NOT NULL,
+    title character varying(200) NOT NULL,
+    description text,
+    period tstzrange NOT NULL,
+    reservation_id uuid,
+    promoted_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT waitlist_entries_period_bounded CHECK (((NOT isempty(period)) AND (NOT lower_inf(period)) AND (NOT upper_inf(period)))),
+    CONSTRAINT waitlist_entries_promotion CHECK (((reservation_id IS NULL) OR (promoted_at IS NOT NULL)))
 );
 
 
@@ -112,6 +414,70 @@ CREATE TABLE public.users (
 ALTER TABLE ONLY public.facilities ALTER COLUMN id SET DEFAULT nextval('public.facilities_id_seq'::regclass);
 
 
+--
+-- Name: amenities amenities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.amenities
+    ADD CONSTRAINT amenities_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: amenities amenities_slug_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.amenities
+    ADD CONSTRAINT amenities_slug_key UNIQUE (slug);
+
+
+--
+-- Name: booking_policies booking_policies_facility_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_policies
+    ADD CONSTRAINT booking_policies_facility_id_key UNIQUE NULLS NOT DISTINCT (facility_id);
+
+
+--
+-- Name: booking_policies booking_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_policies
+    ADD CONSTRAINT booking_policies_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: booking_quotas booking_quotas_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_quotas
+    ADD CONSTRAINT booking_quotas_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: booking_quotas booking_quotas_scope_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_quotas
+    ADD CONSTRAINT booking_quotas_scope_key UNIQUE NULLS NOT DISTINCT (facility_id, user_id);
+
+
+--
+-- Name: delegation_grants delegation_grants_grantor_id_delegate_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.delegation_grants
+    ADD CONSTRAINT delegation_grants_grantor_id_delegate_id_key UNIQUE (grantor_id, delegate_id);
+
+
+--
+-- Name: delegation_grants delegation_grants_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.delegation_grants
+    ADD CONSTRAINT delegation_grants_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: facilities facilities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -120,6 +486,86 @@ ALTER TABLE ONLY public.facilities
     ADD CONSTRAINT facilities_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: facility_amenities facility_amenities_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_amenities
+    ADD CONSTRAINT facility_amenities_pkey PRIMARY KEY (facility_id, amenity_id);
+
+
+--
+-- Name: facility_blackouts facility_blackouts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_blackouts
+    ADD CONSTRAINT facility_blackouts_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: facility_opening_hour_overrides facility_opening_hour_overrides_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_opening_hour_overrides
+    ADD CONSTRAINT facility_opening_hour_overrides_pkey PRIMARY KEY (facility_id, date);
+
+
+--
+-- Name: facility_opening_hours facility_opening_hours_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_opening_hours
+    ADD CONSTRAINT facility_opening_hours_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: locations locations_parent_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.locations
+    ADD CONSTRAINT locations_parent_id_name_key UNIQUE NULLS NOT DISTINCT (parent_id, name);
+
+
+--
+-- Name: locations locations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.locations
+    ADD CONSTRAINT locations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: reservation_bundles reservation_bundles_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservation_bundles
+    ADD CONSTRAINT reservation_bundles_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: reservation_series reservation_series_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservation_series
+    ADD CONSTRAINT reservation_series_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: reservations reservations_no_overlap; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (facility_id WITH =, blocked_period WITH &&) WHERE ((status = ANY (ARRAY['confirmed'::public.reservation_status, 'pending'::public.reservation_status, 'held'::public.reservation_status])));
+
+
+--
+-- Name: reservations reservations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -160,6 +606,28 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_username_key UNIQUE (username);
 
 
+--
+-- Name: waitlist_entries waitlist_entries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.waitlist_entries
+    ADD CONSTRAINT waitlist_entries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: idx_booking_quotas_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_booking_quotas_user_id ON public.booking_quotas USING btree (user_id);
+
+
+--
+-- Name: idx_delegation_grants_delegate_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_delegation_grants_delegate_id ON public.delegation_grants USING btree (delegate_id);
+
+
 --
 -- Name: idx_facilities_is_active; Type: INDEX; Schema: public; Owner: -
 --
@@ -167,6 +635,13 @@ ALTER TABLE ONLY public.users
 CREATE INDEX idx_facilities_is_active ON public.facilities USING btree (is_active);
 
 
+--
+-- Name: idx_facilities_location_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facilities_location_id ON public.facilities USING btree (location_id);
+
+
 --
 -- Name: idx_facilities_name; Type: INDEX; Schema: public; Owner: -
 --
@@ -174,6 +649,20 @@ CREATE INDEX idx_facilities_is_active ON public.facilities USING btree (is_activ
 CREATE INDEX idx_facilities_name ON public.facilities USING btree (name);
 
 
+--
+-- Name: idx_facilities_name_page; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facilities_name_page ON public.facilities USING btree (name, id);
+
+
+--
+-- Name: idx_facilities_name_trgm; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facilities_name_trgm ON public.facilities USING gin (name public.gin_trgm_ops);
+
+
 --
 -- Name: idx_facilities_priority; Type: INDEX; Schema: public; Owner: -
 --
@@ -181,6 +670,118 @@ CREATE INDEX idx_facilities_name ON public.facilities USING btree (name);
 CREATE INDEX idx_facilities_priority ON public.facilities USING btree (priority);
 
 
+--
+-- Name: idx_facilities_priority_page; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facilities_priority_page ON public.facilities USING btree (COALESCE(priority, '9223372036854775807'::bigint), name, id);
+
+
+--
+-- Name: idx_facilities_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facilities_search ON public.facilities USING gin ((((setweight(to_tsvector('english'::regconfig, (name)::text), 'A'::"char") || setweight(to_tsvector('english'::regconfig, (COALESCE(location, ''::character varying))::text), 'B'::"char")) || setweight(to_tsvector('english'::regconfig, COALESCE(description, ''::text)), 'C'::"char"))));
+
+
+--
+-- Name: idx_facility_amenities_amenity_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facility_amenities_amenity_id ON public.facility_amenities USING btree (amenity_id);
+
+
+--
+-- Name: idx_facility_blackouts_facility_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facility_blackouts_facility_id ON public.facility_blackouts USING btree (facility_id);
+
+
+--
+-- Name: idx_facility_opening_hours_facility_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_facility_opening_hours_facility_id ON public.facility_opening_hours USING btree (facility_id);
+
+
+--
+-- Name: idx_locations_parent_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_locations_parent_id ON public.locations USING btree (parent_id);
+
+
+--
+-- Name: idx_reservation_bundles_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservation_bundles_user_id ON public.reservation_bundles USING btree (user_id);
+
+
+--
+-- Name: idx_reservation_series_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservation_series_user_id ON public.reservation_series USING btree (user_id);
+
+
+--
+-- Name: idx_reservations_attendee_user_ids; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_attendee_user_ids ON public.reservations USING gin (attendee_user_ids);
+
+
+--
+-- Name: idx_reservations_awaiting_check_in; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_awaiting_check_in ON public.reservations USING btree (lower(period)) WHERE ((status = 'confirmed'::public.reservation_status) AND (checked_in_at IS NULL));
+
+
+--
+-- Name: idx_reservations_bundle_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_bundle_id ON public.reservations USING btree (bundle_id);
+
+
+--
+-- Name: idx_reservations_hold_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_hold_expires_at ON public.reservations USING btree (hold_expires_at) WHERE (status = 'held'::public.reservation_status);
+
+
+--
+-- Name: idx_reservations_pending; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_pending ON public.reservations USING btree (lower(period)) WHERE (status = 'pending'::public.reservation_status);
+
+
+--
+-- Name: idx_reservations_period; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_period ON public.reservations USING gist (period);
+
+
+--
+-- Name: idx_reservations_series_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_series_id ON public.reservations USING btree (series_id);
+
+
+--
+-- Name: idx_reservations_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_reservations_user_id ON public.reservations USING btree (user_id);
+
+
 --
 -- Name: idx_user_tokens_token; Type: INDEX; Schema: public; Owner: -
 --
@@ -209,6 +810,187 @@ CREATE INDEX idx_users_is_staff ON public.users USING btree (is_staff);
 CREATE INDEX idx_users_username ON public.users USING btree (username);
 
 
+--
+-- Name: idx_waitlist_entries_user_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_waitlist_entries_user_id ON public.waitlist_entries USING btree (user_id);
+
+
+--
+-- Name: idx_waitlist_entries_waiting; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_waitlist_entries_waiting ON public.waitlist_entries USING gist (facility_id, period) WHERE (promoted_at IS NULL);
+
+
+--
+-- Name: reservations_series_occurrence; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX reservations_series_occurrence ON public.reservations USING btree (series_id, original_starts_at);
+
+
+--
+-- Name: booking_policies booking_policies_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_policies
+    ADD CONSTRAINT booking_policies_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: booking_quotas booking_quotas_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_quotas
+    ADD CONSTRAINT booking_quotas_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: booking_quotas booking_quotas_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.booking_quotas
+    ADD CONSTRAINT booking_quotas_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: delegation_grants delegation_grants_delegate_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.delegation_grants
+    ADD CONSTRAINT delegation_grants_delegate_id_fkey FOREIGN KEY (delegate_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: delegation_grants delegation_grants_grantor_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.delegation_grants
+    ADD CONSTRAINT delegation_grants_grantor_id_fkey FOREIGN KEY (grantor_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: facilities facilities_location_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facilities
+    ADD CONSTRAINT facilities_location_id_fkey FOREIGN KEY (location_id) REFERENCES public.locations(id) ON DELETE RESTRICT;
+
+
+--
+-- Name: facility_amenities facility_amenities_amenity_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_amenities
+    ADD CONSTRAINT facility_amenities_amenity_id_fkey FOREIGN KEY (amenity_id) REFERENCES public.amenities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: facility_amenities facility_amenities_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_amenities
+    ADD CONSTRAINT facility_amenities_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: facility_blackouts facility_blackouts_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_blackouts
+    ADD CONSTRAINT facility_blackouts_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: facility_opening_hour_overrides facility_opening_hour_overrides_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_opening_hour_overrides
+    ADD CONSTRAINT facility_opening_hour_overrides_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: facility_opening_hours facility_opening_hours_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.facility_opening_hours
+    ADD CONSTRAINT facility_opening_hours_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: locations locations_parent_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.locations
+    ADD CONSTRAINT locations_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.locations(id) ON DELETE RESTRICT;
+
+
+--
+-- Name: reservation_bundles reservation_bundles_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservation_bundles
+    ADD CONSTRAINT reservation_bundles_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservation_series reservation_series_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservation_series
+    ADD CONSTRAINT reservation_series_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservation_series reservation_series_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservation_series
+    ADD CONSTRAINT reservation_series_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservations reservations_booked_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_booked_by_fkey FOREIGN KEY (booked_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: reservations reservations_bundle_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_bundle_id_fkey FOREIGN KEY (bundle_id) REFERENCES public.reservation_bundles(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservations reservations_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservations reservations_series_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_series_id_fkey FOREIGN KEY (series_id) REFERENCES public.reservation_series(id) ON DELETE CASCADE;
+
+
+--
+-- Name: reservations reservations_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.reservations
+    ADD CONSTRAINT reservations_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: user_tokens user_tokens_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -217,6 +999,30 @@ ALTER TABLE ONLY public.user_tokens
     ADD CONSTRAINT user_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: waitlist_entries waitlist_entries_facility_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.waitlist_entries
+    ADD CONSTRAINT waitlist_entries_facility_id_fkey FOREIGN KEY (facility_id) REFERENCES public.facilities(id) ON DELETE CASCADE;
+
+
+--
+-- Name: waitlist_entries waitlist_entries_reservation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.waitlist_entries
+    ADD CONSTRAINT waitlist_entries_reservation_id_fkey FOREIGN KEY (reservation_id) REFERENCES public.reservations(id) ON DELETE SET NULL;
+
+
+--
+-- Name: waitlist_entries waitlist_entries_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.waitlist_entries
+    ADD CONSTRAINT waitlist_entries_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -0,0 +1,2 @@
+-- Add optional email address to users for admin user management
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(254);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS reservations;
+DROP TYPE IF EXISTS reservation_status;
+
+DROP EXTENSION IF EXISTS btree_gist;
@@ -0,0 +1,30 @@
+-- Reservations of facilities by users
+-- Double bookings are rejected by an exclusion constraint instead of application-side checks
+
+-- Required for the integer equality operator in the GiST exclusion constraint
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled');
+
+CREATE TABLE IF NOT EXISTS reservations (
+    id UUID PRIMARY KEY,
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    period TSTZRANGE NOT NULL,
+    status reservation_status NOT NULL DEFAULT 'confirmed',
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT reservations_period_bounded CHECK (
+        NOT isempty(period) AND NOT lower_inf(period) AND NOT upper_inf(period)
+    ),
+    CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        period WITH &&
+    ) WHERE (status = 'confirmed')
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservations_user_id ON reservations(user_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_period ON reservations USING gist (period);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_reservations_series_id;
+ALTER TABLE reservations DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS reservation_series;
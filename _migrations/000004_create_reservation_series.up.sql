@@ -0,0 +1,29 @@
+-- Recurring reservation series
+-- Occurrences are expanded into reservations rows that reference their series
+
+CREATE TABLE IF NOT EXISTS reservation_series (
+    id UUID PRIMARY KEY,
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    -- RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10
+    rrule TEXT NOT NULL,
+    -- IANA time zone in which the rule is expanded, e.g. Asia/Tokyo
+    time_zone VARCHAR(64) NOT NULL,
+    -- Period of the first occurrence
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status reservation_status NOT NULL DEFAULT 'confirmed',
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT reservation_series_first_occurrence CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservation_series_user_id ON reservation_series(user_id);
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES reservation_series(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_reservations_series_id ON reservations(series_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS reservations_series_occurrence;
+
+ALTER TABLE reservations DROP CONSTRAINT IF EXISTS reservations_series_original_starts_at;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS is_exception,
+    DROP COLUMN IF EXISTS original_starts_at;
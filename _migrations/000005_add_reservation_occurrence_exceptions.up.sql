@@ -0,0 +1,16 @@
+-- Occurrence-level exceptions of recurring series
+-- A series occurrence keeps the start generated by its rule so that it can be edited or skipped individually
+
+ALTER TABLE reservations
+    -- Start of the occurrence as generated by the series rule (the RFC 5545 RECURRENCE-ID)
+    ADD COLUMN IF NOT EXISTS original_starts_at TIMESTAMP WITH TIME ZONE,
+    -- Whether the occurrence was edited or skipped individually and must survive regeneration of its series
+    ADD COLUMN IF NOT EXISTS is_exception BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE reservations SET original_starts_at = lower(period) WHERE series_id IS NOT NULL;
+
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_series_original_starts_at
+    CHECK (series_id IS NULL OR original_starts_at IS NOT NULL);
+
+CREATE UNIQUE INDEX IF NOT EXISTS reservations_series_occurrence ON reservations(series_id, original_starts_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS facility_opening_hour_overrides;
+
+DROP INDEX IF EXISTS idx_facility_opening_hours_facility_id;
+DROP TABLE IF EXISTS facility_opening_hours;
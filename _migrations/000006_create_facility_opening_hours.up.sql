@@ -0,0 +1,32 @@
+-- Facility opening hours
+-- Weekly rules define when a facility can be reserved; a date override replaces them for that day
+
+CREATE TABLE IF NOT EXISTS facility_opening_hours (
+    id UUID PRIMARY KEY,
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    -- Day of the week as in EXTRACT(DOW): 0 = Sunday, 6 = Saturday
+    weekday SMALLINT NOT NULL,
+    opens_at TIME NOT NULL,
+    -- 24:00 closes at the end of the day
+    closes_at TIME NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT facility_opening_hours_weekday CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT facility_opening_hours_period CHECK (opens_at < closes_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_facility_opening_hours_facility_id ON facility_opening_hours(facility_id);
+
+CREATE TABLE IF NOT EXISTS facility_opening_hour_overrides (
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    -- Both NULL when the facility is closed for the whole day
+    opens_at TIME,
+    closes_at TIME,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (facility_id, date),
+    CONSTRAINT facility_opening_hour_overrides_period CHECK (
+        (opens_at IS NULL AND closes_at IS NULL) OR opens_at < closes_at
+    )
+);
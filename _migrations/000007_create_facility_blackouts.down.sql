@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_facility_blackouts_facility_id;
+DROP TABLE IF EXISTS facility_blackouts;
@@ -0,0 +1,22 @@
+-- Facility blackouts
+-- Windows in which a facility cannot be reserved, such as renovation or cleaning, either once or recurring
+
+CREATE TABLE IF NOT EXISTS facility_blackouts (
+    id UUID PRIMARY KEY,
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    reason VARCHAR(200) NOT NULL,
+    -- First occurrence; every occurrence of a recurring blackout lasts as long as this one
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- RFC 5545 rule and the IANA time zone it is expanded in; both NULL for a one-off blackout
+    rrule TEXT,
+    time_zone VARCHAR(64),
+    -- End of the last occurrence, so that blackouts overlapping a range can be found without expanding rules
+    last_ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT facility_blackouts_first_occurrence CHECK (starts_at < ends_at),
+    CONSTRAINT facility_blackouts_last_ends_at CHECK (ends_at <= last_ends_at),
+    CONSTRAINT facility_blackouts_recurrence CHECK ((rrule IS NULL) = (time_zone IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_facility_blackouts_facility_id ON facility_blackouts(facility_id);
@@ -0,0 +1,13 @@
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    DROP CONSTRAINT IF EXISTS reservations_blocked_period_covers,
+    DROP COLUMN IF EXISTS blocked_period,
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        period WITH &&
+    ) WHERE (status = 'confirmed');
+
+ALTER TABLE facilities
+    DROP CONSTRAINT IF EXISTS facilities_buffers_check,
+    DROP COLUMN IF EXISTS teardown_buffer_minutes,
+    DROP COLUMN IF EXISTS setup_buffer_minutes;
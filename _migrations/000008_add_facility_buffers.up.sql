@@ -0,0 +1,23 @@
+-- Setup and teardown buffers around reservations
+-- A reservation blocks its facility for its period widened by the buffers configured when it was made,
+-- so that overlap detection stays with the exclusion constraint
+
+ALTER TABLE facilities
+    ADD COLUMN IF NOT EXISTS setup_buffer_minutes INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS teardown_buffer_minutes INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT facilities_buffers_check CHECK (
+        setup_buffer_minutes BETWEEN 0 AND 1440 AND teardown_buffer_minutes BETWEEN 0 AND 1440
+    );
+
+ALTER TABLE reservations ADD COLUMN IF NOT EXISTS blocked_period TSTZRANGE;
+
+UPDATE reservations SET blocked_period = period;
+
+ALTER TABLE reservations
+    ALTER COLUMN blocked_period SET NOT NULL,
+    ADD CONSTRAINT reservations_blocked_period_covers CHECK (blocked_period @> period),
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status = 'confirmed');
@@ -0,0 +1,21 @@
+-- Booking policies
+-- Rules reservations of a facility must follow; the row without a facility is the organization-wide default
+
+CREATE TABLE IF NOT EXISTS booking_policies (
+    id UUID PRIMARY KEY,
+    -- NULL for the organization-wide default, which facilities inherit unset rules from
+    facility_id INTEGER REFERENCES facilities(id) ON DELETE CASCADE,
+    -- A NULL rule is not enforced, or inherited from the default
+    min_duration_minutes INTEGER,
+    max_duration_minutes INTEGER,
+    granularity_minutes INTEGER,
+    max_advance_days INTEGER,
+    same_day_cutoff TIME,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT booking_policies_facility_id_key UNIQUE NULLS NOT DISTINCT (facility_id),
+    CONSTRAINT booking_policies_min_duration CHECK (min_duration_minutes > 0),
+    CONSTRAINT booking_policies_max_duration CHECK (max_duration_minutes >= COALESCE(min_duration_minutes, 1)),
+    CONSTRAINT booking_policies_granularity CHECK (granularity_minutes > 0 AND 1440 % granularity_minutes = 0),
+    CONSTRAINT booking_policies_max_advance_days CHECK (max_advance_days > 0)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_booking_quotas_user_id;
+DROP TABLE IF EXISTS booking_quotas;
@@ -0,0 +1,20 @@
+-- Booking quotas
+-- Limits on how much a user may reserve, organization-wide or per facility, with per-user overrides
+
+CREATE TABLE IF NOT EXISTS booking_quotas (
+    id UUID PRIMARY KEY,
+    -- NULL for the organization-wide quota, which counts reservations of every facility
+    facility_id INTEGER REFERENCES facilities(id) ON DELETE CASCADE,
+    -- NULL for the quota of every user; a row for a user replaces it for that user
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    -- A NULL limit is not enforced
+    max_hours_per_week INTEGER,
+    max_upcoming_reservations INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT booking_quotas_scope_key UNIQUE NULLS NOT DISTINCT (facility_id, user_id),
+    CONSTRAINT booking_quotas_max_hours_per_week CHECK (max_hours_per_week BETWEEN 0 AND 168),
+    CONSTRAINT booking_quotas_max_upcoming_reservations CHECK (max_upcoming_reservations >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_quotas_user_id ON booking_quotas(user_id);
@@ -0,0 +1,32 @@
+DROP INDEX IF EXISTS idx_reservations_pending;
+
+-- Requests that were never approved no longer hold their period
+UPDATE reservations
+SET status = 'cancelled',
+    cancelled_at = COALESCE(cancelled_at, reviewed_at, updated_at)
+WHERE status IN ('pending', 'rejected', 'expired');
+
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    DROP COLUMN IF EXISTS reviewed_at,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_new;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_new;
+
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status = 'confirmed');
+
+ALTER TABLE facilities DROP COLUMN IF EXISTS requires_approval;
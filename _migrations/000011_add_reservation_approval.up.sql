@@ -0,0 +1,31 @@
+-- Approval workflow for restricted facilities
+-- Reservations of facilities requiring approval start as pending requests that hold their period until staff
+-- approve or reject them, or they expire once they start without a decision
+
+ALTER TABLE facilities ADD COLUMN IF NOT EXISTS requires_approval BOOLEAN NOT NULL DEFAULT false;
+
+-- Values added with ALTER TYPE ... ADD VALUE cannot be used in the same transaction, so the type is recreated
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_old;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled', 'pending', 'rejected', 'expired');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_old;
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status IN ('confirmed', 'pending'));
+
+CREATE INDEX IF NOT EXISTS idx_reservations_pending ON reservations (lower(period)) WHERE status = 'pending';
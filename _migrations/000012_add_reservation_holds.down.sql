@@ -0,0 +1,30 @@
+DROP INDEX IF EXISTS idx_reservations_hold_expires_at;
+DROP INDEX IF EXISTS idx_reservations_pending;
+
+DELETE FROM reservations WHERE status = 'held';
+
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    DROP CONSTRAINT IF EXISTS reservations_hold_expires_at,
+    DROP COLUMN IF EXISTS hold_expires_at,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_new;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled', 'pending', 'rejected', 'expired');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_new;
+
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status IN ('confirmed', 'pending'));
+
+CREATE INDEX IF NOT EXISTS idx_reservations_pending ON reservations (lower(period)) WHERE status = 'pending';
@@ -0,0 +1,32 @@
+-- Tentative holds on reservation periods
+-- A hold is a reservation without details that blocks its period until it is confirmed or its TTL passes,
+-- so that overlap detection stays with the exclusion constraint
+
+-- Values added with ALTER TYPE ... ADD VALUE cannot be used in the same transaction, so the type is recreated
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+DROP INDEX IF EXISTS idx_reservations_pending;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_old;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled', 'pending', 'rejected', 'expired', 'held');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_old;
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS hold_expires_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT reservations_hold_expires_at CHECK ((status = 'held') = (hold_expires_at IS NOT NULL)),
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status IN ('confirmed', 'pending', 'held'));
+
+CREATE INDEX IF NOT EXISTS idx_reservations_hold_expires_at ON reservations (hold_expires_at) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_reservations_pending ON reservations (lower(period)) WHERE status = 'pending';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_waitlist_entries_waiting;
+DROP INDEX IF EXISTS idx_waitlist_entries_user_id;
+DROP TABLE IF EXISTS waitlist_entries;
@@ -0,0 +1,24 @@
+-- Waitlist entries
+-- Requests for a period of a facility that is already reserved, promoted to reservations in the order they were
+-- made once a conflicting reservation is cancelled
+
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY,
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    period TSTZRANGE NOT NULL,
+    -- Both NULL while the entry is waiting
+    reservation_id UUID REFERENCES reservations(id) ON DELETE SET NULL,
+    promoted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT waitlist_entries_period_bounded CHECK (
+        NOT isempty(period) AND NOT lower_inf(period) AND NOT upper_inf(period)
+    ),
+    CONSTRAINT waitlist_entries_promotion CHECK (reservation_id IS NULL OR promoted_at IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_user_id ON waitlist_entries(user_id);
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_waiting ON waitlist_entries USING gist (facility_id, period)
+    WHERE promoted_at IS NULL;
@@ -0,0 +1,43 @@
+DROP INDEX IF EXISTS idx_reservations_awaiting_check_in;
+DROP INDEX IF EXISTS idx_reservations_hold_expires_at;
+DROP INDEX IF EXISTS idx_reservations_pending;
+
+-- No-shows released their period, so they become cancelled reservations
+UPDATE reservations
+SET status = 'cancelled',
+    cancelled_at = COALESCE(cancelled_at, updated_at)
+WHERE status = 'no_show';
+
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    DROP CONSTRAINT IF EXISTS reservations_hold_expires_at,
+    DROP CONSTRAINT IF EXISTS reservations_checked_out_at,
+    DROP COLUMN IF EXISTS checked_out_at,
+    DROP COLUMN IF EXISTS checked_in_at,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_new;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled', 'pending', 'rejected', 'expired', 'held');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_new;
+
+ALTER TABLE reservations
+    ADD CONSTRAINT reservations_hold_expires_at CHECK ((status = 'held') = (hold_expires_at IS NOT NULL)),
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status IN ('confirmed', 'pending', 'held'));
+
+CREATE INDEX IF NOT EXISTS idx_reservations_hold_expires_at ON reservations (hold_expires_at) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_reservations_pending ON reservations (lower(period)) WHERE status = 'pending';
+
+ALTER TABLE facilities
+    DROP CONSTRAINT IF EXISTS facilities_check_in_grace_minutes_check,
+    DROP COLUMN IF EXISTS check_in_grace_minutes;
@@ -0,0 +1,42 @@
+-- Check-in and no-show release
+-- Reservations of facilities with a check-in grace period that are not checked in by its end are released as
+-- no-shows, and checking out early releases the rest of the reserved period
+
+ALTER TABLE facilities
+    ADD COLUMN IF NOT EXISTS check_in_grace_minutes INTEGER,
+    ADD CONSTRAINT facilities_check_in_grace_minutes_check CHECK (check_in_grace_minutes BETWEEN 0 AND 1440);
+
+-- Values added with ALTER TYPE ... ADD VALUE cannot be used in the same transaction, so the type is recreated
+ALTER TABLE reservations
+    DROP CONSTRAINT IF EXISTS reservations_no_overlap,
+    DROP CONSTRAINT IF EXISTS reservations_hold_expires_at,
+    ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE reservation_series ALTER COLUMN status DROP DEFAULT;
+DROP INDEX IF EXISTS idx_reservations_hold_expires_at;
+DROP INDEX IF EXISTS idx_reservations_pending;
+
+ALTER TYPE reservation_status RENAME TO reservation_status_old;
+CREATE TYPE reservation_status AS ENUM ('confirmed', 'cancelled', 'pending', 'rejected', 'expired', 'held', 'no_show');
+
+ALTER TABLE reservations
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+ALTER TABLE reservation_series
+    ALTER COLUMN status TYPE reservation_status USING status::text::reservation_status,
+    ALTER COLUMN status SET DEFAULT 'confirmed';
+DROP TYPE reservation_status_old;
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS checked_out_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT reservations_checked_out_at CHECK (checked_out_at IS NULL OR checked_in_at IS NOT NULL),
+    ADD CONSTRAINT reservations_hold_expires_at CHECK ((status = 'held') = (hold_expires_at IS NOT NULL)),
+    ADD CONSTRAINT reservations_no_overlap EXCLUDE USING gist (
+        facility_id WITH =,
+        blocked_period WITH &&
+    ) WHERE (status IN ('confirmed', 'pending', 'held'));
+
+CREATE INDEX IF NOT EXISTS idx_reservations_hold_expires_at ON reservations (hold_expires_at) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_reservations_pending ON reservations (lower(period)) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_reservations_awaiting_check_in ON reservations (lower(period))
+    WHERE status = 'confirmed' AND checked_in_at IS NULL;
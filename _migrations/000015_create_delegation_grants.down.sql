@@ -0,0 +1,4 @@
+ALTER TABLE reservations DROP COLUMN IF EXISTS booked_by;
+
+DROP INDEX IF EXISTS idx_delegation_grants_delegate_id;
+DROP TABLE IF EXISTS delegation_grants;
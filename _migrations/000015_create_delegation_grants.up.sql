@@ -0,0 +1,19 @@
+-- Delegation grants
+-- A grant lets its delegate book and manage reservations on behalf of the grantor, e.g. an assistant booking
+-- rooms for an executive. Reservations record who booked them in addition to who owns them
+
+CREATE TABLE IF NOT EXISTS delegation_grants (
+    id UUID PRIMARY KEY,
+    grantor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    delegate_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT delegation_grants_distinct_users CHECK (grantor_id <> delegate_id),
+    CONSTRAINT delegation_grants_grantor_id_delegate_id_key UNIQUE (grantor_id, delegate_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_delegation_grants_delegate_id ON delegation_grants(delegate_id);
+
+-- NULL once the user who made the reservation is deleted
+ALTER TABLE reservations ADD COLUMN IF NOT EXISTS booked_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+UPDATE reservations SET booked_by = user_id;
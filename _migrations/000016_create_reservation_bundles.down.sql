@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_reservations_bundle_id;
+ALTER TABLE reservations DROP COLUMN IF EXISTS bundle_id;
+
+DROP INDEX IF EXISTS idx_reservation_bundles_user_id;
+DROP TABLE IF EXISTS reservation_bundles;
@@ -0,0 +1,21 @@
+-- Reservation bundles
+-- A bundle reserves several facilities at once, e.g. a main room, breakout rooms and a projector for a workshop.
+-- Its reservations reference the bundle and are created, cancelled and rescheduled together
+
+CREATE TABLE IF NOT EXISTS reservation_bundles (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT,
+    status reservation_status NOT NULL DEFAULT 'confirmed',
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reservation_bundles_user_id ON reservation_bundles(user_id);
+
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS bundle_id UUID REFERENCES reservation_bundles(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_reservations_bundle_id ON reservations(bundle_id);
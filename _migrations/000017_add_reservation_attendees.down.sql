@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_reservations_attendee_user_ids;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS attendee_emails,
+    DROP COLUMN IF EXISTS attendee_user_ids;
+
+ALTER TABLE facilities
+    DROP CONSTRAINT IF EXISTS facilities_capacity_check,
+    DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,14 @@
+-- Reservation attendees and facility capacity
+-- Reservations list the users and external email addresses attending them besides their owner, and facilities
+-- with a capacity reject reservations whose headcount exceeds it
+
+ALTER TABLE facilities
+    ADD COLUMN IF NOT EXISTS capacity INTEGER,
+    ADD CONSTRAINT facilities_capacity_check CHECK (capacity > 0);
+
+-- Users are removed from the attendees when they are deleted
+ALTER TABLE reservations
+    ADD COLUMN IF NOT EXISTS attendee_user_ids UUID[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS attendee_emails VARCHAR(254)[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_reservations_attendee_user_ids ON reservations USING gin (attendee_user_ids);
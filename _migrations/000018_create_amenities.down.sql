@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_facility_amenities_amenity_id;
+DROP TABLE IF EXISTS facility_amenities;
+DROP TABLE IF EXISTS amenities;
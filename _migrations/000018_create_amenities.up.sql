@@ -0,0 +1,22 @@
+-- Amenities
+-- A managed catalogue of the features facilities can offer, such as a projector or wheelchair access.
+-- Facilities are linked to the amenities they offer and can be searched by them
+
+CREATE TABLE IF NOT EXISTS amenities (
+    id UUID PRIMARY KEY,
+    slug VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT amenities_slug_check CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$')
+);
+
+CREATE TABLE IF NOT EXISTS facility_amenities (
+    facility_id INTEGER NOT NULL REFERENCES facilities(id) ON DELETE CASCADE,
+    amenity_id UUID NOT NULL REFERENCES amenities(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (facility_id, amenity_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_facility_amenities_amenity_id ON facility_amenities(amenity_id);
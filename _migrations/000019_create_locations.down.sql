@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_facilities_location_id;
+ALTER TABLE facilities DROP COLUMN IF EXISTS location_id;
+
+DROP INDEX IF EXISTS idx_locations_parent_id;
+DROP TABLE IF EXISTS locations;
+DROP TYPE IF EXISTS location_kind;
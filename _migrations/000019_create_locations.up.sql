@@ -0,0 +1,39 @@
+-- Location hierarchy
+-- Sites contain buildings and buildings contain floors. Facilities are located in any of them, so that they can be
+-- searched by a site, building or floor together with everything within it
+
+CREATE TYPE location_kind AS ENUM ('site', 'building', 'floor');
+
+-- Locations still containing other locations or facilities cannot be deleted
+CREATE TABLE IF NOT EXISTS locations (
+    id UUID PRIMARY KEY,
+    parent_id UUID REFERENCES locations(id) ON DELETE RESTRICT,
+    kind location_kind NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT locations_parent_id_name_key UNIQUE NULLS NOT DISTINCT (parent_id, name),
+    CONSTRAINT locations_site_is_root CHECK ((kind = 'site') = (parent_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_locations_parent_id ON locations(parent_id);
+
+ALTER TABLE facilities
+    ADD COLUMN IF NOT EXISTS location_id UUID REFERENCES locations(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_facilities_location_id ON facilities(location_id);
+
+-- Existing facilities are linked to a site named after their free-text location, which is kept as is
+INSERT INTO locations (id, kind, name)
+SELECT uuid_generate_v4(), 'site', name
+FROM (
+    SELECT DISTINCT btrim(location) AS name
+    FROM facilities
+    WHERE btrim(location) <> ''
+) AS existing;
+
+UPDATE facilities f
+SET location_id = l.id
+FROM locations l
+WHERE l.parent_id IS NULL
+  AND l.name = btrim(f.location);
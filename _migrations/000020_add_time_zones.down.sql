@@ -0,0 +1,3 @@
+ALTER TABLE facilities DROP COLUMN IF EXISTS time_zone;
+
+ALTER TABLE locations DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,7 @@
+-- Facility and location time zones
+-- Opening hours, booking policies, quotas and recurring rules of a facility are evaluated in its own IANA time zone,
+-- else in that of the nearest location containing it that sets one, else in UTC. Names are validated by the application
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64);
+
+ALTER TABLE facilities ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64);
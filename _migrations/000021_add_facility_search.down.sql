@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_facilities_name_trgm;
+
+DROP INDEX IF EXISTS idx_facilities_search;
+
+DROP EXTENSION IF EXISTS pg_trgm;
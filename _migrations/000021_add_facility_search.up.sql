@@ -0,0 +1,14 @@
+-- Facility search
+-- Facilities are searched by the words of their name, location and description, weighted in that order, and by the
+-- similarity of their name to the query to tolerate typos. The search queries must use the same document expression
+-- as idx_facilities_search for the index to be used
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_facilities_search ON facilities USING gin ((
+    setweight(to_tsvector('english', name), 'A')
+    || setweight(to_tsvector('english', coalesce(location, '')), 'B')
+    || setweight(to_tsvector('english', coalesce(description, '')), 'C')
+));
+
+CREATE INDEX IF NOT EXISTS idx_facilities_name_trgm ON facilities USING gin (name gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_facilities_name_page;
+
+DROP INDEX IF EXISTS idx_facilities_priority_page;
@@ -0,0 +1,8 @@
+-- Facility listing indexes
+-- Each sort of the facility listing has an index in its order, so that a page is read from the index after the
+-- cursor instead of sorting every facility. The priority sort must use the same rank expression as the index
+
+CREATE INDEX IF NOT EXISTS idx_facilities_priority_page
+    ON facilities ((COALESCE(priority, 9223372036854775807)), name, id);
+
+CREATE INDEX IF NOT EXISTS idx_facilities_name_page ON facilities (name, id);
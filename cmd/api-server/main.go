@@ -19,6 +19,7 @@ import (
 const (
 	readHeaderTimeout = 30 * time.Second
 	shutdownTimeout   = 30 * time.Second
+	sweepInterval     = 30 * time.Second
 )
 
 var (
@@ -72,17 +73,20 @@ func run(ctx context.Context) error {
 
 	// Create datastore and service with database dependency
 	ds := internal.NewDataStore(db)
-	svc := internal.NewAPIService(db)
+	svc := internal.NewAPIService(ds)
 
-	handler, err := api.NewServer(svc)
+	// Release expired holds, pending requests and no-shows in the background
+	go internal.NewSweeper(ds, sweepInterval).Run(ctx)
+
+	// Authentication is resolved per operation by the security handler
+	handler, err := api.NewServer(svc, middlewares.NewSecurityHandler(ds))
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
 
-	// Wrap handler with middleware (recovery first, then auth, then logging)
+	// Wrap handler with middleware (recovery first, then logging)
 	recoveredHandler := middlewares.RecoveryMiddleware(handler)
-	authHandler := middlewares.AuthMiddleware(ds)(recoveredHandler)
-	loggedHandler := middlewares.LoggingMiddleware(authHandler)
+	loggedHandler := middlewares.LoggingMiddleware(recoveredHandler)
 
 	server := &http.Server{
 		Addr:              addr,
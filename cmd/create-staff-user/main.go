@@ -73,6 +73,7 @@ func run(ctx context.Context) error {
 	params := internal.CreateUserParams{
 		Username: username,
 		IsStaff:  true,
+		Email:    nil,
 	}
 
 	// Create staff user - system operation, bypassing normal auth
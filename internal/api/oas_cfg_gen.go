@@ -11,7 +11,8 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
-	"^[\\w.@+-]+$": ogenregex.MustCompile("^[\\w.@+-]+$"),
+	"^[\\w.@+-]+$":             ogenregex.MustCompile("^[\\w.@+-]+$"),
+	"^[a-z0-9]+(-[a-z0-9]+)*$": ogenregex.MustCompile("^[a-z0-9]+(-[a-z0-9]+)*$"),
 }
 
 type (
@@ -1,6 +1,18 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AdminReservationsApproveRes interface {
+	adminReservationsApproveRes()
+}
+
+type AdminReservationsPendingListRes interface {
+	adminReservationsPendingListRes()
+}
+
+type AdminReservationsRejectRes interface {
+	adminReservationsRejectRes()
+}
+
 type AdminUsersCreateRes interface {
 	adminUsersCreateRes()
 }
@@ -17,6 +29,18 @@ type AdminUsersPartialUpdateRes interface {
 	adminUsersPartialUpdateRes()
 }
 
+type AdminUsersQuotasDestroyRes interface {
+	adminUsersQuotasDestroyRes()
+}
+
+type AdminUsersQuotasListRes interface {
+	adminUsersQuotasListRes()
+}
+
+type AdminUsersQuotasUpdateRes interface {
+	adminUsersQuotasUpdateRes()
+}
+
 type AdminUsersRetrieveRes interface {
 	adminUsersRetrieveRes()
 }
@@ -25,6 +49,82 @@ type AdminUsersUpdateRes interface {
 	adminUsersUpdateRes()
 }
 
+type AmenitiesCreateRes interface {
+	amenitiesCreateRes()
+}
+
+type AmenitiesDestroyRes interface {
+	amenitiesDestroyRes()
+}
+
+type AmenitiesRetrieveRes interface {
+	amenitiesRetrieveRes()
+}
+
+type AmenitiesUpdateRes interface {
+	amenitiesUpdateRes()
+}
+
+type AvailabilityListRes interface {
+	availabilityListRes()
+}
+
+type BookingPolicyUpdateRes interface {
+	bookingPolicyUpdateRes()
+}
+
+type BookingQuotaUpdateRes interface {
+	bookingQuotaUpdateRes()
+}
+
+type DelegationGrantsCreateRes interface {
+	delegationGrantsCreateRes()
+}
+
+type DelegationGrantsDestroyRes interface {
+	delegationGrantsDestroyRes()
+}
+
+type DelegationGrantsListRes interface {
+	delegationGrantsListRes()
+}
+
+type FacilitiesAmenitiesUpdateRes interface {
+	facilitiesAmenitiesUpdateRes()
+}
+
+type FacilitiesBlackoutsCreateRes interface {
+	facilitiesBlackoutsCreateRes()
+}
+
+type FacilitiesBlackoutsDestroyRes interface {
+	facilitiesBlackoutsDestroyRes()
+}
+
+type FacilitiesBlackoutsListRes interface {
+	facilitiesBlackoutsListRes()
+}
+
+type FacilitiesBlackoutsRetrieveRes interface {
+	facilitiesBlackoutsRetrieveRes()
+}
+
+type FacilitiesBookingPolicyRetrieveRes interface {
+	facilitiesBookingPolicyRetrieveRes()
+}
+
+type FacilitiesBookingPolicyUpdateRes interface {
+	facilitiesBookingPolicyUpdateRes()
+}
+
+type FacilitiesBookingQuotaRetrieveRes interface {
+	facilitiesBookingQuotaRetrieveRes()
+}
+
+type FacilitiesBookingQuotaUpdateRes interface {
+	facilitiesBookingQuotaUpdateRes()
+}
+
 type FacilitiesCreateRes interface {
 	facilitiesCreateRes()
 }
@@ -33,6 +133,30 @@ type FacilitiesDestroyRes interface {
 	facilitiesDestroyRes()
 }
 
+type FacilitiesListRes interface {
+	facilitiesListRes()
+}
+
+type FacilitiesOpeningHoursOverridesDestroyRes interface {
+	facilitiesOpeningHoursOverridesDestroyRes()
+}
+
+type FacilitiesOpeningHoursOverridesListRes interface {
+	facilitiesOpeningHoursOverridesListRes()
+}
+
+type FacilitiesOpeningHoursOverridesUpdateRes interface {
+	facilitiesOpeningHoursOverridesUpdateRes()
+}
+
+type FacilitiesOpeningHoursRetrieveRes interface {
+	facilitiesOpeningHoursRetrieveRes()
+}
+
+type FacilitiesOpeningHoursUpdateRes interface {
+	facilitiesOpeningHoursUpdateRes()
+}
+
 type FacilitiesPartialUpdateRes interface {
 	facilitiesPartialUpdateRes()
 }
@@ -45,6 +169,126 @@ type FacilitiesUpdateRes interface {
 	facilitiesUpdateRes()
 }
 
+type HoldsConfirmRes interface {
+	holdsConfirmRes()
+}
+
+type HoldsCreateRes interface {
+	holdsCreateRes()
+}
+
+type HoldsDestroyRes interface {
+	holdsDestroyRes()
+}
+
+type HoldsRetrieveRes interface {
+	holdsRetrieveRes()
+}
+
+type LocationsCreateRes interface {
+	locationsCreateRes()
+}
+
+type LocationsDestroyRes interface {
+	locationsDestroyRes()
+}
+
+type LocationsRetrieveRes interface {
+	locationsRetrieveRes()
+}
+
+type LocationsUpdateRes interface {
+	locationsUpdateRes()
+}
+
 type MeRetrieveRes interface {
 	meRetrieveRes()
 }
+
+type ReservationBundlesCancelRes interface {
+	reservationBundlesCancelRes()
+}
+
+type ReservationBundlesCreateRes interface {
+	reservationBundlesCreateRes()
+}
+
+type ReservationBundlesListRes interface {
+	reservationBundlesListRes()
+}
+
+type ReservationBundlesRescheduleRes interface {
+	reservationBundlesRescheduleRes()
+}
+
+type ReservationBundlesRetrieveRes interface {
+	reservationBundlesRetrieveRes()
+}
+
+type ReservationSeriesCancelRes interface {
+	reservationSeriesCancelRes()
+}
+
+type ReservationSeriesCreateRes interface {
+	reservationSeriesCreateRes()
+}
+
+type ReservationSeriesListRes interface {
+	reservationSeriesListRes()
+}
+
+type ReservationSeriesOccurrenceSkipRes interface {
+	reservationSeriesOccurrenceSkipRes()
+}
+
+type ReservationSeriesOccurrenceUpdateRes interface {
+	reservationSeriesOccurrenceUpdateRes()
+}
+
+type ReservationSeriesRetrieveRes interface {
+	reservationSeriesRetrieveRes()
+}
+
+type ReservationSeriesUpdateRes interface {
+	reservationSeriesUpdateRes()
+}
+
+type ReservationsCancelRes interface {
+	reservationsCancelRes()
+}
+
+type ReservationsCheckInRes interface {
+	reservationsCheckInRes()
+}
+
+type ReservationsCheckOutRes interface {
+	reservationsCheckOutRes()
+}
+
+type ReservationsCreateRes interface {
+	reservationsCreateRes()
+}
+
+type ReservationsListRes interface {
+	reservationsListRes()
+}
+
+type ReservationsRetrieveRes interface {
+	reservationsRetrieveRes()
+}
+
+type ReservationsUpdateRes interface {
+	reservationsUpdateRes()
+}
+
+type WaitlistCreateRes interface {
+	waitlistCreateRes()
+}
+
+type WaitlistDestroyRes interface {
+	waitlistDestroyRes()
+}
+
+type WaitlistListRes interface {
+	waitlistListRes()
+}
@@ -6,17 +6,83 @@ package api
 type OperationName = string
 
 const (
-	AdminUsersCreateOperation        OperationName = "AdminUsersCreate"
-	AdminUsersDestroyOperation       OperationName = "AdminUsersDestroy"
-	AdminUsersListOperation          OperationName = "AdminUsersList"
-	AdminUsersPartialUpdateOperation OperationName = "AdminUsersPartialUpdate"
-	AdminUsersRetrieveOperation      OperationName = "AdminUsersRetrieve"
-	AdminUsersUpdateOperation        OperationName = "AdminUsersUpdate"
-	FacilitiesCreateOperation        OperationName = "FacilitiesCreate"
-	FacilitiesDestroyOperation       OperationName = "FacilitiesDestroy"
-	FacilitiesListOperation          OperationName = "FacilitiesList"
-	FacilitiesPartialUpdateOperation OperationName = "FacilitiesPartialUpdate"
-	FacilitiesRetrieveOperation      OperationName = "FacilitiesRetrieve"
-	FacilitiesUpdateOperation        OperationName = "FacilitiesUpdate"
-	MeRetrieveOperation              OperationName = "MeRetrieve"
+	AdminReservationsApproveOperation               OperationName = "AdminReservationsApprove"
+	AdminReservationsPendingListOperation           OperationName = "AdminReservationsPendingList"
+	AdminReservationsRejectOperation                OperationName = "AdminReservationsReject"
+	AdminUsersCreateOperation                       OperationName = "AdminUsersCreate"
+	AdminUsersDestroyOperation                      OperationName = "AdminUsersDestroy"
+	AdminUsersListOperation                         OperationName = "AdminUsersList"
+	AdminUsersPartialUpdateOperation                OperationName = "AdminUsersPartialUpdate"
+	AdminUsersQuotasDestroyOperation                OperationName = "AdminUsersQuotasDestroy"
+	AdminUsersQuotasListOperation                   OperationName = "AdminUsersQuotasList"
+	AdminUsersQuotasUpdateOperation                 OperationName = "AdminUsersQuotasUpdate"
+	AdminUsersRetrieveOperation                     OperationName = "AdminUsersRetrieve"
+	AdminUsersUpdateOperation                       OperationName = "AdminUsersUpdate"
+	AmenitiesCreateOperation                        OperationName = "AmenitiesCreate"
+	AmenitiesDestroyOperation                       OperationName = "AmenitiesDestroy"
+	AmenitiesListOperation                          OperationName = "AmenitiesList"
+	AmenitiesRetrieveOperation                      OperationName = "AmenitiesRetrieve"
+	AmenitiesUpdateOperation                        OperationName = "AmenitiesUpdate"
+	AvailabilityListOperation                       OperationName = "AvailabilityList"
+	BookingPolicyRetrieveOperation                  OperationName = "BookingPolicyRetrieve"
+	BookingPolicyUpdateOperation                    OperationName = "BookingPolicyUpdate"
+	BookingQuotaRetrieveOperation                   OperationName = "BookingQuotaRetrieve"
+	BookingQuotaUpdateOperation                     OperationName = "BookingQuotaUpdate"
+	DelegationGrantsCreateOperation                 OperationName = "DelegationGrantsCreate"
+	DelegationGrantsDestroyOperation                OperationName = "DelegationGrantsDestroy"
+	DelegationGrantsListOperation                   OperationName = "DelegationGrantsList"
+	FacilitiesAmenitiesUpdateOperation              OperationName = "FacilitiesAmenitiesUpdate"
+	FacilitiesBlackoutsCreateOperation              OperationName = "FacilitiesBlackoutsCreate"
+	FacilitiesBlackoutsDestroyOperation             OperationName = "FacilitiesBlackoutsDestroy"
+	FacilitiesBlackoutsListOperation                OperationName = "FacilitiesBlackoutsList"
+	FacilitiesBlackoutsRetrieveOperation            OperationName = "FacilitiesBlackoutsRetrieve"
+	FacilitiesBookingPolicyRetrieveOperation        OperationName = "FacilitiesBookingPolicyRetrieve"
+	FacilitiesBookingPolicyUpdateOperation          OperationName = "FacilitiesBookingPolicyUpdate"
+	FacilitiesBookingQuotaRetrieveOperation         OperationName = "FacilitiesBookingQuotaRetrieve"
+	FacilitiesBookingQuotaUpdateOperation           OperationName = "FacilitiesBookingQuotaUpdate"
+	FacilitiesCreateOperation                       OperationName = "FacilitiesCreate"
+	FacilitiesDestroyOperation                      OperationName = "FacilitiesDestroy"
+	FacilitiesListOperation                         OperationName = "FacilitiesList"
+	FacilitiesOpeningHoursOverridesDestroyOperation OperationName = "FacilitiesOpeningHoursOverridesDestroy"
+	FacilitiesOpeningHoursOverridesListOperation    OperationName = "FacilitiesOpeningHoursOverridesList"
+	FacilitiesOpeningHoursOverridesUpdateOperation  OperationName = "FacilitiesOpeningHoursOverridesUpdate"
+	FacilitiesOpeningHoursRetrieveOperation         OperationName = "FacilitiesOpeningHoursRetrieve"
+	FacilitiesOpeningHoursUpdateOperation           OperationName = "FacilitiesOpeningHoursUpdate"
+	FacilitiesPartialUpdateOperation                OperationName = "FacilitiesPartialUpdate"
+	FacilitiesRetrieveOperation                     OperationName = "FacilitiesRetrieve"
+	FacilitiesSearchListOperation                   OperationName = "FacilitiesSearchList"
+	FacilitiesUpdateOperation                       OperationName = "FacilitiesUpdate"
+	HoldsConfirmOperation                           OperationName = "HoldsConfirm"
+	HoldsCreateOperation                            OperationName = "HoldsCreate"
+	HoldsDestroyOperation                           OperationName = "HoldsDestroy"
+	HoldsRetrieveOperation                          OperationName = "HoldsRetrieve"
+	LocationsCreateOperation                        OperationName = "LocationsCreate"
+	LocationsDestroyOperation                       OperationName = "LocationsDestroy"
+	LocationsListOperation                          OperationName = "LocationsList"
+	LocationsRetrieveOperation                      OperationName = "LocationsRetrieve"
+	LocationsTreeListOperation                      OperationName = "LocationsTreeList"
+	LocationsUpdateOperation                        OperationName = "LocationsUpdate"
+	MeRetrieveOperation                             OperationName = "MeRetrieve"
+	ReservationBundlesCancelOperation               OperationName = "ReservationBundlesCancel"
+	ReservationBundlesCreateOperation               OperationName = "ReservationBundlesCreate"
+	ReservationBundlesListOperation                 OperationName = "ReservationBundlesList"
+	ReservationBundlesRescheduleOperation           OperationName = "ReservationBundlesReschedule"
+	ReservationBundlesRetrieveOperation             OperationName = "ReservationBundlesRetrieve"
+	ReservationSeriesCancelOperation                OperationName = "ReservationSeriesCancel"
+	ReservationSeriesCreateOperation                OperationName = "ReservationSeriesCreate"
+	ReservationSeriesListOperation                  OperationName = "ReservationSeriesList"
+	ReservationSeriesOccurrenceSkipOperation        OperationName = "ReservationSeriesOccurrenceSkip"
+	ReservationSeriesOccurrenceUpdateOperation      OperationName = "ReservationSeriesOccurrenceUpdate"
+	ReservationSeriesRetrieveOperation              OperationName = "ReservationSeriesRetrieve"
+	ReservationSeriesUpdateOperation                OperationName = "ReservationSeriesUpdate"
+	ReservationsCancelOperation                     OperationName = "ReservationsCancel"
+	ReservationsCheckInOperation                    OperationName = "ReservationsCheckIn"
+	ReservationsCheckOutOperation                   OperationName = "ReservationsCheckOut"
+	ReservationsCreateOperation                     OperationName = "ReservationsCreate"
+	ReservationsListOperation                       OperationName = "ReservationsList"
+	ReservationsRetrieveOperation                   OperationName = "ReservationsRetrieve"
+	ReservationsUpdateOperation                     OperationName = "ReservationsUpdate"
+	WaitlistCreateOperation                         OperationName = "WaitlistCreate"
+	WaitlistDestroyOperation                        OperationName = "WaitlistDestroy"
+	WaitlistListOperation                           OperationName = "WaitlistList"
 )
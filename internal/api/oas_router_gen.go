@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,9 +61,387 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "admin/users/"
+			case 'a': // Prefix: "a"
 
-				if l := len("admin/users/"); len(elem) >= l && elem[0:l] == "admin/users/" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/"
+
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "reservations/"
+
+						if l := len("reservations/"); len(elem) >= l && elem[0:l] == "reservations/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "pending/"
+							origElem := elem
+							if l := len("pending/"); len(elem) >= l && elem[0:l] == "pending/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleAdminReservationsPendingListRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "approve/"
+
+								if l := len("approve/"); len(elem) >= l && elem[0:l] == "approve/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAdminReservationsApproveRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "reject/"
+
+								if l := len("reject/"); len(elem) >= l && elem[0:l] == "reject/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAdminReservationsRejectRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "users/"
+
+						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleAdminUsersListRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleAdminUsersCreateRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleAdminUsersDestroyRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleAdminUsersRetrieveRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleAdminUsersPartialUpdateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleAdminUsersUpdateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case 'q': // Prefix: "quotas/"
+
+								if l := len("quotas/"); len(elem) >= l && elem[0:l] == "quotas/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleAdminUsersQuotasDestroyRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleAdminUsersQuotasListRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleAdminUsersQuotasUpdateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "menities/"
+
+					if l := len("menities/"); len(elem) >= l && elem[0:l] == "menities/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleAmenitiesListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleAmenitiesCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleAmenitiesDestroyRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleAmenitiesRetrieveRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleAmenitiesUpdateRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+
+					}
+
+				case 'v': // Prefix: "vailability/"
+
+					if l := len("vailability/"); len(elem) >= l && elem[0:l] == "vailability/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleAvailabilityListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'b': // Prefix: "booking-"
+
+				if l := len("booking-"); len(elem) >= l && elem[0:l] == "booking-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "policy/"
+
+					if l := len("policy/"); len(elem) >= l && elem[0:l] == "policy/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleBookingPolicyRetrieveRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleBookingPolicyUpdateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,PUT")
+						}
+
+						return
+					}
+
+				case 'q': // Prefix: "quota/"
+
+					if l := len("quota/"); len(elem) >= l && elem[0:l] == "quota/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleBookingQuotaRetrieveRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleBookingQuotaUpdateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,PUT")
+						}
+
+						return
+					}
+
+				}
+
+			case 'd': // Prefix: "delegation-grants/"
+
+				if l := len("delegation-grants/"); len(elem) >= l && elem[0:l] == "delegation-grants/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,9 +450,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleAdminUsersListRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleDelegationGrantsListRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleAdminUsersCreateRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleDelegationGrantsCreateRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET,POST")
 					}
@@ -106,23 +484,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "DELETE":
-							s.handleAdminUsersDestroyRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "GET":
-							s.handleAdminUsersRetrieveRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PATCH":
-							s.handleAdminUsersPartialUpdateRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "PUT":
-							s.handleAdminUsersUpdateRequest([1]string{
+							s.handleDelegationGrantsDestroyRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+							s.notAllowed(w, r, "DELETE")
 						}
 
 						return
@@ -150,6 +516,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
+				switch elem[0] {
+				case 's': // Prefix: "search/"
+					origElem := elem
+					if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleFacilitiesSearchListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 				// Param: "id"
 				// Match until "/"
 				idx := strings.IndexByte(elem, '/')
@@ -172,7 +561,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "DELETE":
 							s.handleFacilitiesDestroyRequest([1]string{
@@ -196,126 +584,2006 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case 'a': // Prefix: "amenities/"
+
+						if l := len("amenities/"); len(elem) >= l && elem[0:l] == "amenities/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "PUT":
+								s.handleFacilitiesAmenitiesUpdateRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "PUT")
+							}
+
+							return
+						}
+
+					case 'b': // Prefix: "b"
+
+						if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "lackouts/"
+
+							if l := len("lackouts/"); len(elem) >= l && elem[0:l] == "lackouts/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleFacilitiesBlackoutsListRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleFacilitiesBlackoutsCreateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							// Param: "blackout_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleFacilitiesBlackoutsDestroyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleFacilitiesBlackoutsRetrieveRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET")
+									}
+
+									return
+								}
+
+							}
+
+						case 'o': // Prefix: "ooking-"
+
+							if l := len("ooking-"); len(elem) >= l && elem[0:l] == "ooking-" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'p': // Prefix: "policy/"
+
+								if l := len("policy/"); len(elem) >= l && elem[0:l] == "policy/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleFacilitiesBookingPolicyRetrieveRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleFacilitiesBookingPolicyUpdateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+							case 'q': // Prefix: "quota/"
+
+								if l := len("quota/"); len(elem) >= l && elem[0:l] == "quota/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleFacilitiesBookingQuotaRetrieveRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleFacilitiesBookingQuotaUpdateRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "opening-hours/"
+
+						if l := len("opening-hours/"); len(elem) >= l && elem[0:l] == "opening-hours/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleFacilitiesOpeningHoursRetrieveRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleFacilitiesOpeningHoursUpdateRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "overrides/"
+
+							if l := len("overrides/"); len(elem) >= l && elem[0:l] == "overrides/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleFacilitiesOpeningHoursOverridesListRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							// Param: "date"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleFacilitiesOpeningHoursOverridesDestroyRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleFacilitiesOpeningHoursOverridesUpdateRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
 
 				}
 
-			case 'm': // Prefix: "me/"
+			case 'h': // Prefix: "holds/"
 
-				if l := len("me/"); len(elem) >= l && elem[0:l] == "me/" {
+				if l := len("holds/"); len(elem) >= l && elem[0:l] == "holds/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
-					case "GET":
-						s.handleMeRetrieveRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleHoldsCreateRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "POST")
 					}
 
 					return
 				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
-			}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleHoldsDestroyRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleHoldsRetrieveRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "confirm/"
+
+						if l := len("confirm/"); len(elem) >= l && elem[0:l] == "confirm/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleHoldsConfirmRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'l': // Prefix: "locations/"
+
+				if l := len("locations/"); len(elem) >= l && elem[0:l] == "locations/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleLocationsListRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleLocationsCreateRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case 't': // Prefix: "tree/"
+					origElem := elem
+					if l := len("tree/"); len(elem) >= l && elem[0:l] == "tree/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleLocationsTreeListRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleLocationsDestroyRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleLocationsRetrieveRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleLocationsUpdateRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+
+				}
+
+			case 'm': // Prefix: "me/"
+
+				if l := len("me/"); len(elem) >= l && elem[0:l] == "me/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleMeRetrieveRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'r': // Prefix: "reservation"
+
+				if l := len("reservation"); len(elem) >= l && elem[0:l] == "reservation" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '-': // Prefix: "-"
+
+					if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "bundles/"
+
+						if l := len("bundles/"); len(elem) >= l && elem[0:l] == "bundles/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleReservationBundlesListRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleReservationBundlesCreateRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleReservationBundlesRetrieveRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel/"
+
+								if l := len("cancel/"); len(elem) >= l && elem[0:l] == "cancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReservationBundlesCancelRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "reschedule/"
+
+								if l := len("reschedule/"); len(elem) >= l && elem[0:l] == "reschedule/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReservationBundlesRescheduleRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "series/"
+
+						if l := len("series/"); len(elem) >= l && elem[0:l] == "series/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleReservationSeriesListRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleReservationSeriesCreateRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleReservationSeriesRetrieveRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleReservationSeriesUpdateRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PUT")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel/"
+
+								if l := len("cancel/"); len(elem) >= l && elem[0:l] == "cancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReservationSeriesCancelRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "occurrences/"
+
+								if l := len("occurrences/"); len(elem) >= l && elem[0:l] == "occurrences/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								// Param: "occurrence_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "PUT":
+											s.handleReservationSeriesOccurrenceUpdateRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PUT")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case 's': // Prefix: "skip/"
+
+										if l := len("skip/"); len(elem) >= l && elem[0:l] == "skip/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleReservationSeriesOccurrenceSkipRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "s/"
+
+					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleReservationsListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleReservationsCreateRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleReservationsRetrieveRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleReservationsUpdateRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel/"
+
+								if l := len("ancel/"); len(elem) >= l && elem[0:l] == "ancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReservationsCancelRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'h': // Prefix: "heck-"
+
+								if l := len("heck-"); len(elem) >= l && elem[0:l] == "heck-" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "in/"
+
+									if l := len("in/"); len(elem) >= l && elem[0:l] == "in/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReservationsCheckInRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'o': // Prefix: "out/"
+
+									if l := len("out/"); len(elem) >= l && elem[0:l] == "out/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReservationsCheckOutRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'w': // Prefix: "waitlist/"
+
+				if l := len("waitlist/"); len(elem) >= l && elem[0:l] == "waitlist/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleWaitlistListRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleWaitlistCreateRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleWaitlistDestroyRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE")
+						}
+
+						return
+					}
+
+				}
+
+			}
 
 		}
 	}
 	s.notFound(w, r)
 }
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [1]string
-}
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/api/v1/"
+
+			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/"
+
+					if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "reservations/"
+
+						if l := len("reservations/"); len(elem) >= l && elem[0:l] == "reservations/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'p': // Prefix: "pending/"
+							origElem := elem
+							if l := len("pending/"); len(elem) >= l && elem[0:l] == "pending/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = AdminReservationsPendingListOperation
+									r.summary = "List pending reservation requests"
+									r.operationID = "admin_reservations_pending_list"
+									r.pathPattern = "/api/v1/admin/reservations/pending/"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "approve/"
+
+								if l := len("approve/"); len(elem) >= l && elem[0:l] == "approve/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AdminReservationsApproveOperation
+										r.summary = "Approve a reservation request"
+										r.operationID = "admin_reservations_approve"
+										r.pathPattern = "/api/v1/admin/reservations/{id}/approve/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "reject/"
+
+								if l := len("reject/"); len(elem) >= l && elem[0:l] == "reject/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AdminReservationsRejectOperation
+										r.summary = "Reject a reservation request"
+										r.operationID = "admin_reservations_reject"
+										r.pathPattern = "/api/v1/admin/reservations/{id}/reject/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "users/"
+
+						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = AdminUsersListOperation
+								r.summary = "List all users"
+								r.operationID = "admin_users_list"
+								r.pathPattern = "/api/v1/admin/users/"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = AdminUsersCreateOperation
+								r.summary = "Create a new user"
+								r.operationID = "admin_users_create"
+								r.pathPattern = "/api/v1/admin/users/"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = AdminUsersDestroyOperation
+									r.summary = "Delete a user (admin only)"
+									r.operationID = "admin_users_destroy"
+									r.pathPattern = "/api/v1/admin/users/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = AdminUsersRetrieveOperation
+									r.summary = "Retrieve a user by ID"
+									r.operationID = "admin_users_retrieve"
+									r.pathPattern = "/api/v1/admin/users/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = AdminUsersPartialUpdateOperation
+									r.summary = "Partially update a user"
+									r.operationID = "admin_users_partial_update"
+									r.pathPattern = "/api/v1/admin/users/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = AdminUsersUpdateOperation
+									r.summary = "Update a user"
+									r.operationID = "admin_users_update"
+									r.pathPattern = "/api/v1/admin/users/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case 'q': // Prefix: "quotas/"
+
+								if l := len("quotas/"); len(elem) >= l && elem[0:l] == "quotas/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = AdminUsersQuotasDestroyOperation
+										r.summary = "Remove a booking quota override of a user"
+										r.operationID = "admin_users_quotas_destroy"
+										r.pathPattern = "/api/v1/admin/users/{id}/quotas/"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = AdminUsersQuotasListOperation
+										r.summary = "List booking quota usage of a user"
+										r.operationID = "admin_users_quotas_list"
+										r.pathPattern = "/api/v1/admin/users/{id}/quotas/"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = AdminUsersQuotasUpdateOperation
+										r.summary = "Override a booking quota of a user"
+										r.operationID = "admin_users_quotas_update"
+										r.pathPattern = "/api/v1/admin/users/{id}/quotas/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "menities/"
+
+					if l := len("menities/"); len(elem) >= l && elem[0:l] == "menities/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = AmenitiesListOperation
+							r.summary = "List amenities"
+							r.operationID = "amenities_list"
+							r.pathPattern = "/api/v1/amenities/"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = AmenitiesCreateOperation
+							r.summary = "Create an amenity (admin only)"
+							r.operationID = "amenities_create"
+							r.pathPattern = "/api/v1/amenities/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = AmenitiesDestroyOperation
+								r.summary = "Delete an amenity (admin only)"
+								r.operationID = "amenities_destroy"
+								r.pathPattern = "/api/v1/amenities/{id}/"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = AmenitiesRetrieveOperation
+								r.summary = "Retrieve an amenity"
+								r.operationID = "amenities_retrieve"
+								r.pathPattern = "/api/v1/amenities/{id}/"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = AmenitiesUpdateOperation
+								r.summary = "Update an amenity (admin only)"
+								r.operationID = "amenities_update"
+								r.pathPattern = "/api/v1/amenities/{id}/"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'v': // Prefix: "vailability/"
+
+					if l := len("vailability/"); len(elem) >= l && elem[0:l] == "vailability/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = AvailabilityListOperation
+							r.summary = "Search facility availability"
+							r.operationID = "availability_list"
+							r.pathPattern = "/api/v1/availability/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'b': // Prefix: "booking-"
+
+				if l := len("booking-"); len(elem) >= l && elem[0:l] == "booking-" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "policy/"
+
+					if l := len("policy/"); len(elem) >= l && elem[0:l] == "policy/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = BookingPolicyRetrieveOperation
+							r.summary = "Retrieve the default booking policy"
+							r.operationID = "booking_policy_retrieve"
+							r.pathPattern = "/api/v1/booking-policy/"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = BookingPolicyUpdateOperation
+							r.summary = "Update the default booking policy (admin only)"
+							r.operationID = "booking_policy_update"
+							r.pathPattern = "/api/v1/booking-policy/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'q': // Prefix: "quota/"
+
+					if l := len("quota/"); len(elem) >= l && elem[0:l] == "quota/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = BookingQuotaRetrieveOperation
+							r.summary = "Retrieve the organization-wide booking quota"
+							r.operationID = "booking_quota_retrieve"
+							r.pathPattern = "/api/v1/booking-quota/"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = BookingQuotaUpdateOperation
+							r.summary = "Update the organization-wide booking quota (admin only)"
+							r.operationID = "booking_quota_update"
+							r.pathPattern = "/api/v1/booking-quota/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+			case 'd': // Prefix: "delegation-grants/"
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+				if l := len("delegation-grants/"); len(elem) >= l && elem[0:l] == "delegation-grants/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = DelegationGrantsListOperation
+						r.summary = "List delegation grants"
+						r.operationID = "delegation_grants_list"
+						r.pathPattern = "/api/v1/delegation-grants/"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = DelegationGrantsCreateOperation
+						r.summary = "Grant delegation"
+						r.operationID = "delegation_grants_create"
+						r.pathPattern = "/api/v1/delegation-grants/"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DelegationGrantsDestroyOperation
+							r.summary = "Revoke delegation"
+							r.operationID = "delegation_grants_destroy"
+							r.pathPattern = "/api/v1/delegation-grants/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
 				}
-			}
-		}()
-	}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+			case 'f': // Prefix: "facilities/"
+
+				if l := len("facilities/"); len(elem) >= l && elem[0:l] == "facilities/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = FacilitiesListOperation
+						r.summary = "List facilities"
+						r.operationID = "facilities_list"
+						r.pathPattern = "/api/v1/facilities/"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = FacilitiesCreateOperation
+						r.summary = "Create a facility (admin only)"
+						r.operationID = "facilities_create"
+						r.pathPattern = "/api/v1/facilities/"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case 's': // Prefix: "search/"
+					origElem := elem
+					if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = FacilitiesSearchListOperation
+							r.summary = "Search facilities"
+							r.operationID = "facilities_search_list"
+							r.pathPattern = "/api/v1/facilities/search/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = FacilitiesDestroyOperation
+							r.summary = "Delete a facility (admin only)"
+							r.operationID = "facilities_destroy"
+							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = FacilitiesRetrieveOperation
+							r.summary = "Retrieve facility details"
+							r.operationID = "facilities_retrieve"
+							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = FacilitiesPartialUpdateOperation
+							r.summary = "Partially update a facility (admin only)"
+							r.operationID = "facilities_partial_update"
+							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = FacilitiesUpdateOperation
+							r.summary = "Update a facility (admin only)"
+							r.operationID = "facilities_update"
+							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "amenities/"
+
+						if l := len("amenities/"); len(elem) >= l && elem[0:l] == "amenities/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PUT":
+								r.name = FacilitiesAmenitiesUpdateOperation
+								r.summary = "Update facility amenities (admin only)"
+								r.operationID = "facilities_amenities_update"
+								r.pathPattern = "/api/v1/facilities/{id}/amenities/"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'b': // Prefix: "b"
+
+						if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'l': // Prefix: "lackouts/"
+
+							if l := len("lackouts/"); len(elem) >= l && elem[0:l] == "lackouts/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = FacilitiesBlackoutsListOperation
+									r.summary = "List facility blackouts"
+									r.operationID = "facilities_blackouts_list"
+									r.pathPattern = "/api/v1/facilities/{id}/blackouts/"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = FacilitiesBlackoutsCreateOperation
+									r.summary = "Create a facility blackout (admin only)"
+									r.operationID = "facilities_blackouts_create"
+									r.pathPattern = "/api/v1/facilities/{id}/blackouts/"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							// Param: "blackout_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = FacilitiesBlackoutsDestroyOperation
+										r.summary = "Delete a facility blackout (admin only)"
+										r.operationID = "facilities_blackouts_destroy"
+										r.pathPattern = "/api/v1/facilities/{id}/blackouts/{blackout_id}/"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "GET":
+										r.name = FacilitiesBlackoutsRetrieveOperation
+										r.summary = "Retrieve a facility blackout"
+										r.operationID = "facilities_blackouts_retrieve"
+										r.pathPattern = "/api/v1/facilities/{id}/blackouts/{blackout_id}/"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'o': // Prefix: "ooking-"
+
+							if l := len("ooking-"); len(elem) >= l && elem[0:l] == "ooking-" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'p': // Prefix: "policy/"
+
+								if l := len("policy/"); len(elem) >= l && elem[0:l] == "policy/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = FacilitiesBookingPolicyRetrieveOperation
+										r.summary = "Retrieve facility booking policy"
+										r.operationID = "facilities_booking_policy_retrieve"
+										r.pathPattern = "/api/v1/facilities/{id}/booking-policy/"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = FacilitiesBookingPolicyUpdateOperation
+										r.summary = "Update facility booking policy (admin only)"
+										r.operationID = "facilities_booking_policy_update"
+										r.pathPattern = "/api/v1/facilities/{id}/booking-policy/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'q': // Prefix: "quota/"
+
+								if l := len("quota/"); len(elem) >= l && elem[0:l] == "quota/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = FacilitiesBookingQuotaRetrieveOperation
+										r.summary = "Retrieve facility booking quota"
+										r.operationID = "facilities_booking_quota_retrieve"
+										r.pathPattern = "/api/v1/facilities/{id}/booking-quota/"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = FacilitiesBookingQuotaUpdateOperation
+										r.summary = "Update facility booking quota (admin only)"
+										r.operationID = "facilities_booking_quota_update"
+										r.pathPattern = "/api/v1/facilities/{id}/booking-quota/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "opening-hours/"
+
+						if l := len("opening-hours/"); len(elem) >= l && elem[0:l] == "opening-hours/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = FacilitiesOpeningHoursRetrieveOperation
+								r.summary = "Retrieve facility opening hours"
+								r.operationID = "facilities_opening_hours_retrieve"
+								r.pathPattern = "/api/v1/facilities/{id}/opening-hours/"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = FacilitiesOpeningHoursUpdateOperation
+								r.summary = "Update facility opening hours (admin only)"
+								r.operationID = "facilities_opening_hours_update"
+								r.pathPattern = "/api/v1/facilities/{id}/opening-hours/"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case 'o': // Prefix: "overrides/"
+
+							if l := len("overrides/"); len(elem) >= l && elem[0:l] == "overrides/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = FacilitiesOpeningHoursOverridesListOperation
+									r.summary = "List facility opening hours overrides"
+									r.operationID = "facilities_opening_hours_overrides_list"
+									r.pathPattern = "/api/v1/facilities/{id}/opening-hours/overrides/"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							// Param: "date"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = FacilitiesOpeningHoursOverridesDestroyOperation
+										r.summary = "Delete a facility opening hours override (admin only)"
+										r.operationID = "facilities_opening_hours_overrides_destroy"
+										r.pathPattern = "/api/v1/facilities/{id}/opening-hours/overrides/{date}/"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "PUT":
+										r.name = FacilitiesOpeningHoursOverridesUpdateOperation
+										r.summary = "Set a facility opening hours override (admin only)"
+										r.operationID = "facilities_opening_hours_overrides_update"
+										r.pathPattern = "/api/v1/facilities/{id}/opening-hours/overrides/{date}/"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/api/v1/"
+					}
 
-			if l := len("/api/v1/"); len(elem) >= l && elem[0:l] == "/api/v1/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+				}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "admin/users/"
+			case 'h': // Prefix: "holds/"
 
-				if l := len("admin/users/"); len(elem) >= l && elem[0:l] == "admin/users/" {
+				if l := len("holds/"); len(elem) >= l && elem[0:l] == "holds/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -323,19 +2591,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				if len(elem) == 0 {
 					switch method {
-					case "GET":
-						r.name = AdminUsersListOperation
-						r.summary = "List all users"
-						r.operationID = "admin_users_list"
-						r.pathPattern = "/api/v1/admin/users/"
-						r.args = args
-						r.count = 0
-						return r, true
 					case "POST":
-						r.name = AdminUsersCreateOperation
-						r.summary = "Create a new user"
-						r.operationID = "admin_users_create"
-						r.pathPattern = "/api/v1/admin/users/"
+						r.name = HoldsCreateOperation
+						r.summary = "Place a hold"
+						r.operationID = "holds_create"
+						r.pathPattern = "/api/v1/holds/"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -365,37 +2625,20 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
 						case "DELETE":
-							r.name = AdminUsersDestroyOperation
-							r.summary = "Delete a user (admin only)"
-							r.operationID = "admin_users_destroy"
-							r.pathPattern = "/api/v1/admin/users/{id}/"
+							r.name = HoldsDestroyOperation
+							r.summary = "Drop a hold"
+							r.operationID = "holds_destroy"
+							r.pathPattern = "/api/v1/holds/{id}/"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "GET":
-							r.name = AdminUsersRetrieveOperation
-							r.summary = "Retrieve a user by ID"
-							r.operationID = "admin_users_retrieve"
-							r.pathPattern = "/api/v1/admin/users/{id}/"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PATCH":
-							r.name = AdminUsersPartialUpdateOperation
-							r.summary = "Partially update a user"
-							r.operationID = "admin_users_partial_update"
-							r.pathPattern = "/api/v1/admin/users/{id}/"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = AdminUsersUpdateOperation
-							r.summary = "Update a user"
-							r.operationID = "admin_users_update"
-							r.pathPattern = "/api/v1/admin/users/{id}/"
+							r.name = HoldsRetrieveOperation
+							r.summary = "Retrieve a hold"
+							r.operationID = "holds_retrieve"
+							r.pathPattern = "/api/v1/holds/{id}/"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -403,12 +2646,38 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							return
 						}
 					}
+					switch elem[0] {
+					case 'c': // Prefix: "confirm/"
+
+						if l := len("confirm/"); len(elem) >= l && elem[0:l] == "confirm/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = HoldsConfirmOperation
+								r.summary = "Confirm a hold"
+								r.operationID = "holds_confirm"
+								r.pathPattern = "/api/v1/holds/{id}/confirm/"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
 
 				}
 
-			case 'f': // Prefix: "facilities/"
+			case 'l': // Prefix: "locations/"
 
-				if l := len("facilities/"); len(elem) >= l && elem[0:l] == "facilities/" {
+				if l := len("locations/"); len(elem) >= l && elem[0:l] == "locations/" {
 					elem = elem[l:]
 				} else {
 					break
@@ -417,18 +2686,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = FacilitiesListOperation
-						r.summary = "List all public facilities"
-						r.operationID = "facilities_list"
-						r.pathPattern = "/api/v1/facilities/"
+						r.name = LocationsListOperation
+						r.summary = "List locations"
+						r.operationID = "locations_list"
+						r.pathPattern = "/api/v1/locations/"
 						r.args = args
 						r.count = 0
 						return r, true
 					case "POST":
-						r.name = FacilitiesCreateOperation
-						r.summary = "Create a facility (admin only)"
-						r.operationID = "facilities_create"
-						r.pathPattern = "/api/v1/facilities/"
+						r.name = LocationsCreateOperation
+						r.summary = "Create a location (admin only)"
+						r.operationID = "locations_create"
+						r.pathPattern = "/api/v1/locations/"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -436,6 +2705,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case 't': // Prefix: "tree/"
+					origElem := elem
+					if l := len("tree/"); len(elem) >= l && elem[0:l] == "tree/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = LocationsTreeListOperation
+							r.summary = "Retrieve the location tree"
+							r.operationID = "locations_tree_list"
+							r.pathPattern = "/api/v1/locations/tree/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 				// Param: "id"
 				// Match until "/"
 				idx := strings.IndexByte(elem, '/')
@@ -461,34 +2757,26 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "DELETE":
-							r.name = FacilitiesDestroyOperation
-							r.summary = "Delete a facility (admin only)"
-							r.operationID = "facilities_destroy"
-							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.name = LocationsDestroyOperation
+							r.summary = "Delete a location (admin only)"
+							r.operationID = "locations_destroy"
+							r.pathPattern = "/api/v1/locations/{id}/"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "GET":
-							r.name = FacilitiesRetrieveOperation
-							r.summary = "Retrieve facility details"
-							r.operationID = "facilities_retrieve"
-							r.pathPattern = "/api/v1/facilities/{id}/"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PATCH":
-							r.name = FacilitiesPartialUpdateOperation
-							r.summary = "Partially update a facility (admin only)"
-							r.operationID = "facilities_partial_update"
-							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.name = LocationsRetrieveOperation
+							r.summary = "Retrieve a location"
+							r.operationID = "locations_retrieve"
+							r.pathPattern = "/api/v1/locations/{id}/"
 							r.args = args
 							r.count = 1
 							return r, true
 						case "PUT":
-							r.name = FacilitiesUpdateOperation
-							r.summary = "Update a facility (admin only)"
-							r.operationID = "facilities_update"
-							r.pathPattern = "/api/v1/facilities/{id}/"
+							r.name = LocationsUpdateOperation
+							r.summary = "Update a location (admin only)"
+							r.operationID = "locations_update"
+							r.pathPattern = "/api/v1/locations/{id}/"
 							r.args = args
 							r.count = 1
 							return r, true
@@ -523,6 +2811,576 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
+			case 'r': // Prefix: "reservation"
+
+				if l := len("reservation"); len(elem) >= l && elem[0:l] == "reservation" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '-': // Prefix: "-"
+
+					if l := len("-"); len(elem) >= l && elem[0:l] == "-" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'b': // Prefix: "bundles/"
+
+						if l := len("bundles/"); len(elem) >= l && elem[0:l] == "bundles/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ReservationBundlesListOperation
+								r.summary = "List reservation bundles"
+								r.operationID = "reservation_bundles_list"
+								r.pathPattern = "/api/v1/reservation-bundles/"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = ReservationBundlesCreateOperation
+								r.summary = "Create a reservation bundle"
+								r.operationID = "reservation_bundles_create"
+								r.pathPattern = "/api/v1/reservation-bundles/"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ReservationBundlesRetrieveOperation
+									r.summary = "Retrieve a reservation bundle"
+									r.operationID = "reservation_bundles_retrieve"
+									r.pathPattern = "/api/v1/reservation-bundles/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel/"
+
+								if l := len("cancel/"); len(elem) >= l && elem[0:l] == "cancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReservationBundlesCancelOperation
+										r.summary = "Cancel a reservation bundle"
+										r.operationID = "reservation_bundles_cancel"
+										r.pathPattern = "/api/v1/reservation-bundles/{id}/cancel/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "reschedule/"
+
+								if l := len("reschedule/"); len(elem) >= l && elem[0:l] == "reschedule/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReservationBundlesRescheduleOperation
+										r.summary = "Reschedule a reservation bundle"
+										r.operationID = "reservation_bundles_reschedule"
+										r.pathPattern = "/api/v1/reservation-bundles/{id}/reschedule/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "series/"
+
+						if l := len("series/"); len(elem) >= l && elem[0:l] == "series/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ReservationSeriesListOperation
+								r.summary = "List reservation series"
+								r.operationID = "reservation_series_list"
+								r.pathPattern = "/api/v1/reservation-series/"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = ReservationSeriesCreateOperation
+								r.summary = "Create a reservation series"
+								r.operationID = "reservation_series_create"
+								r.pathPattern = "/api/v1/reservation-series/"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ReservationSeriesRetrieveOperation
+									r.summary = "Retrieve a reservation series"
+									r.operationID = "reservation_series_retrieve"
+									r.pathPattern = "/api/v1/reservation-series/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = ReservationSeriesUpdateOperation
+									r.summary = "Update a reservation series"
+									r.operationID = "reservation_series_update"
+									r.pathPattern = "/api/v1/reservation-series/{id}/"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cancel/"
+
+								if l := len("cancel/"); len(elem) >= l && elem[0:l] == "cancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReservationSeriesCancelOperation
+										r.summary = "Cancel a reservation series"
+										r.operationID = "reservation_series_cancel"
+										r.pathPattern = "/api/v1/reservation-series/{id}/cancel/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'o': // Prefix: "occurrences/"
+
+								if l := len("occurrences/"); len(elem) >= l && elem[0:l] == "occurrences/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								// Param: "occurrence_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "PUT":
+											r.name = ReservationSeriesOccurrenceUpdateOperation
+											r.summary = "Update a series occurrence"
+											r.operationID = "reservation_series_occurrence_update"
+											r.pathPattern = "/api/v1/reservation-series/{id}/occurrences/{occurrence_id}/"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case 's': // Prefix: "skip/"
+
+										if l := len("skip/"); len(elem) >= l && elem[0:l] == "skip/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ReservationSeriesOccurrenceSkipOperation
+												r.summary = "Skip a series occurrence"
+												r.operationID = "reservation_series_occurrence_skip"
+												r.pathPattern = "/api/v1/reservation-series/{id}/occurrences/{occurrence_id}/skip/"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "s/"
+
+					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ReservationsListOperation
+							r.summary = "List reservations"
+							r.operationID = "reservations_list"
+							r.pathPattern = "/api/v1/reservations/"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = ReservationsCreateOperation
+							r.summary = "Create a reservation"
+							r.operationID = "reservations_create"
+							r.pathPattern = "/api/v1/reservations/"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ReservationsRetrieveOperation
+								r.summary = "Retrieve a reservation"
+								r.operationID = "reservations_retrieve"
+								r.pathPattern = "/api/v1/reservations/{id}/"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = ReservationsUpdateOperation
+								r.summary = "Update a reservation"
+								r.operationID = "reservations_update"
+								r.pathPattern = "/api/v1/reservations/{id}/"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "c"
+
+							if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "ancel/"
+
+								if l := len("ancel/"); len(elem) >= l && elem[0:l] == "ancel/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ReservationsCancelOperation
+										r.summary = "Cancel a reservation"
+										r.operationID = "reservations_cancel"
+										r.pathPattern = "/api/v1/reservations/{id}/cancel/"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'h': // Prefix: "heck-"
+
+								if l := len("heck-"); len(elem) >= l && elem[0:l] == "heck-" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "in/"
+
+									if l := len("in/"); len(elem) >= l && elem[0:l] == "in/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ReservationsCheckInOperation
+											r.summary = "Check in to a reservation"
+											r.operationID = "reservations_check_in"
+											r.pathPattern = "/api/v1/reservations/{id}/check-in/"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "out/"
+
+									if l := len("out/"); len(elem) >= l && elem[0:l] == "out/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ReservationsCheckOutOperation
+											r.summary = "Check out of a reservation"
+											r.operationID = "reservations_check_out"
+											r.pathPattern = "/api/v1/reservations/{id}/check-out/"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'w': // Prefix: "waitlist/"
+
+				if l := len("waitlist/"); len(elem) >= l && elem[0:l] == "waitlist/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = WaitlistListOperation
+						r.summary = "List waitlist entries"
+						r.operationID = "waitlist_list"
+						r.pathPattern = "/api/v1/waitlist/"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = WaitlistCreateOperation
+						r.summary = "Join a waitlist"
+						r.operationID = "waitlist_create"
+						r.pathPattern = "/api/v1/waitlist/"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				// Param: "id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = WaitlistDestroyOperation
+							r.summary = "Withdraw from a waitlist"
+							r.operationID = "waitlist_destroy"
+							r.pathPattern = "/api/v1/waitlist/{id}/"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
 			}
 
 		}
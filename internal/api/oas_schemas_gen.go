@@ -8,19 +8,68 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/google/uuid"
 )
 
 func (s *UnexpectedErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type AdminReservationsApproveConflict ProblemDetails
+
+func (*AdminReservationsApproveConflict) adminReservationsApproveRes() {}
+
+type AdminReservationsApproveForbidden ProblemDetails
+
+func (*AdminReservationsApproveForbidden) adminReservationsApproveRes() {}
+
+type AdminReservationsApproveNotFound ProblemDetails
+
+func (*AdminReservationsApproveNotFound) adminReservationsApproveRes() {}
+
+type AdminReservationsApproveUnauthorized ProblemDetails
+
+func (*AdminReservationsApproveUnauthorized) adminReservationsApproveRes() {}
+
+type AdminReservationsPendingListBadRequest ProblemDetails
+
+func (*AdminReservationsPendingListBadRequest) adminReservationsPendingListRes() {}
+
+type AdminReservationsPendingListForbidden ProblemDetails
+
+func (*AdminReservationsPendingListForbidden) adminReservationsPendingListRes() {}
+
+type AdminReservationsPendingListOKApplicationJSON []Reservation
+
+func (*AdminReservationsPendingListOKApplicationJSON) adminReservationsPendingListRes() {}
+
+type AdminReservationsPendingListUnauthorized ProblemDetails
+
+func (*AdminReservationsPendingListUnauthorized) adminReservationsPendingListRes() {}
+
+type AdminReservationsRejectConflict ProblemDetails
+
+func (*AdminReservationsRejectConflict) adminReservationsRejectRes() {}
+
+type AdminReservationsRejectForbidden ProblemDetails
+
+func (*AdminReservationsRejectForbidden) adminReservationsRejectRes() {}
+
+type AdminReservationsRejectNotFound ProblemDetails
+
+func (*AdminReservationsRejectNotFound) adminReservationsRejectRes() {}
+
+type AdminReservationsRejectUnauthorized ProblemDetails
+
+func (*AdminReservationsRejectUnauthorized) adminReservationsRejectRes() {}
+
 // Serializer for admin-level access to user objects, including staff status and hyperlinked
 // self-reference.
 // Ref: #/components/schemas/AdminUser
 type AdminUser struct {
-	ID  int     `json:"id"`
-	URL url.URL `json:"url"`
-	// Required. 150 characters or fewer. Letters, digits and @/./+/-/_ only.
+	ID  uuid.UUID `json:"id"`
+	URL url.URL   `json:"url"`
+	// Required. 100 characters or fewer. Letters, digits and @/./+/-/_ only.
 	Username string         `json:"username"`
 	Email    OptEmailString `json:"email"`
 	// Designates whether the user can log into this admin site.
@@ -28,7 +77,7 @@ type AdminUser struct {
 }
 
 // GetID returns the value of ID.
-func (s *AdminUser) GetID() int {
+func (s *AdminUser) GetID() uuid.UUID {
 	return s.ID
 }
 
@@ -53,7 +102,7 @@ func (s *AdminUser) GetIsStaff() OptBool {
 }
 
 // SetID sets the value of ID.
-func (s *AdminUser) SetID(val int) {
+func (s *AdminUser) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
@@ -77,14 +126,13 @@ func (s *AdminUser) SetIsStaff(val OptBool) {
 	s.IsStaff = val
 }
 
-func (*AdminUser) adminUsersCreateRes()        {}
 func (*AdminUser) adminUsersPartialUpdateRes() {}
 func (*AdminUser) adminUsersRetrieveRes()      {}
 func (*AdminUser) adminUsersUpdateRes()        {}
 
 // Ref: #/components/schemas/AdminUserMergePatchUpdate
 type AdminUserMergePatchUpdate struct {
-	// Required. 150 characters or fewer. Letters, digits and @/./+/-/_ only.
+	// Required. 100 characters or fewer. Letters, digits and @/./+/-/_ only.
 	Username OptString                         `json:"username"`
 	Email    OptAdminUserMergePatchUpdateEmail `json:"email"`
 	// Designates whether the user can log into this admin site.
@@ -258,14 +306,98 @@ func NewNullAdminUserMergePatchUpdateIsStaff(v struct{}) AdminUserMergePatchUpda
 	return s
 }
 
+// Newly created user together with its initial API token. The token is only returned once.
+// Ref: #/components/schemas/AdminUserWithToken
+type AdminUserWithToken struct {
+	ID  uuid.UUID `json:"id"`
+	URL url.URL   `json:"url"`
+	// Required. 100 characters or fewer. Letters, digits and @/./+/-/_ only.
+	Username string         `json:"username"`
+	Email    OptEmailString `json:"email"`
+	// Designates whether the user can log into this admin site.
+	IsStaff OptBool `json:"is_staff"`
+	// Initial API token issued to the new user.
+	Token string `json:"token"`
+}
+
+// GetID returns the value of ID.
+func (s *AdminUserWithToken) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *AdminUserWithToken) GetURL() url.URL {
+	return s.URL
+}
+
+// GetUsername returns the value of Username.
+func (s *AdminUserWithToken) GetUsername() string {
+	return s.Username
+}
+
+// GetEmail returns the value of Email.
+func (s *AdminUserWithToken) GetEmail() OptEmailString {
+	return s.Email
+}
+
+// GetIsStaff returns the value of IsStaff.
+func (s *AdminUserWithToken) GetIsStaff() OptBool {
+	return s.IsStaff
+}
+
+// GetToken returns the value of Token.
+func (s *AdminUserWithToken) GetToken() string {
+	return s.Token
+}
+
+// SetID sets the value of ID.
+func (s *AdminUserWithToken) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *AdminUserWithToken) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetUsername sets the value of Username.
+func (s *AdminUserWithToken) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetEmail sets the value of Email.
+func (s *AdminUserWithToken) SetEmail(val OptEmailString) {
+	s.Email = val
+}
+
+// SetIsStaff sets the value of IsStaff.
+func (s *AdminUserWithToken) SetIsStaff(val OptBool) {
+	s.IsStaff = val
+}
+
+// SetToken sets the value of Token.
+func (s *AdminUserWithToken) SetToken(val string) {
+	s.Token = val
+}
+
+func (*AdminUserWithToken) adminUsersCreateRes() {}
+
 type AdminUsersCreateBadRequest ProblemDetails
 
 func (*AdminUsersCreateBadRequest) adminUsersCreateRes() {}
 
+type AdminUsersCreateForbidden ProblemDetails
+
+func (*AdminUsersCreateForbidden) adminUsersCreateRes() {}
+
 type AdminUsersCreateNotFound ProblemDetails
 
 func (*AdminUsersCreateNotFound) adminUsersCreateRes() {}
 
+type AdminUsersCreateUnauthorized ProblemDetails
+
+func (*AdminUsersCreateUnauthorized) adminUsersCreateRes() {}
+
 type AdminUsersDestroyBadRequest ProblemDetails
 
 func (*AdminUsersDestroyBadRequest) adminUsersDestroyRes() {}
@@ -315,6 +447,55 @@ type AdminUsersPartialUpdateUnauthorized ProblemDetails
 
 func (*AdminUsersPartialUpdateUnauthorized) adminUsersPartialUpdateRes() {}
 
+type AdminUsersQuotasDestroyForbidden ProblemDetails
+
+func (*AdminUsersQuotasDestroyForbidden) adminUsersQuotasDestroyRes() {}
+
+// AdminUsersQuotasDestroyNoContent is response for AdminUsersQuotasDestroy operation.
+type AdminUsersQuotasDestroyNoContent struct{}
+
+func (*AdminUsersQuotasDestroyNoContent) adminUsersQuotasDestroyRes() {}
+
+type AdminUsersQuotasDestroyNotFound ProblemDetails
+
+func (*AdminUsersQuotasDestroyNotFound) adminUsersQuotasDestroyRes() {}
+
+type AdminUsersQuotasDestroyUnauthorized ProblemDetails
+
+func (*AdminUsersQuotasDestroyUnauthorized) adminUsersQuotasDestroyRes() {}
+
+type AdminUsersQuotasListForbidden ProblemDetails
+
+func (*AdminUsersQuotasListForbidden) adminUsersQuotasListRes() {}
+
+type AdminUsersQuotasListNotFound ProblemDetails
+
+func (*AdminUsersQuotasListNotFound) adminUsersQuotasListRes() {}
+
+type AdminUsersQuotasListOKApplicationJSON []BookingQuotaUsage
+
+func (*AdminUsersQuotasListOKApplicationJSON) adminUsersQuotasListRes() {}
+
+type AdminUsersQuotasListUnauthorized ProblemDetails
+
+func (*AdminUsersQuotasListUnauthorized) adminUsersQuotasListRes() {}
+
+type AdminUsersQuotasUpdateBadRequest ProblemDetails
+
+func (*AdminUsersQuotasUpdateBadRequest) adminUsersQuotasUpdateRes() {}
+
+type AdminUsersQuotasUpdateForbidden ProblemDetails
+
+func (*AdminUsersQuotasUpdateForbidden) adminUsersQuotasUpdateRes() {}
+
+type AdminUsersQuotasUpdateNotFound ProblemDetails
+
+func (*AdminUsersQuotasUpdateNotFound) adminUsersQuotasUpdateRes() {}
+
+type AdminUsersQuotasUpdateUnauthorized ProblemDetails
+
+func (*AdminUsersQuotasUpdateUnauthorized) adminUsersQuotasUpdateRes() {}
+
 type AdminUsersRetrieveForbidden ProblemDetails
 
 func (*AdminUsersRetrieveForbidden) adminUsersRetrieveRes() {}
@@ -331,833 +512,3952 @@ type AdminUsersUpdateBadRequest ProblemDetails
 
 func (*AdminUsersUpdateBadRequest) adminUsersUpdateRes() {}
 
+type AdminUsersUpdateForbidden ProblemDetails
+
+func (*AdminUsersUpdateForbidden) adminUsersUpdateRes() {}
+
 type AdminUsersUpdateNotFound ProblemDetails
 
 func (*AdminUsersUpdateNotFound) adminUsersUpdateRes() {}
 
-// Serializer for representing the currently authenticated user.
-// Ref: #/components/schemas/CurrentUser
-type CurrentUser struct {
-	ID int `json:"id"`
-	// Required. 150 characters or fewer. Letters, digits and @/./+/-/_ only.
-	Username string    `json:"username"`
-	Email    OptString `json:"email"`
-}
+type AdminUsersUpdateUnauthorized ProblemDetails
 
-// GetID returns the value of ID.
-func (s *CurrentUser) GetID() int {
-	return s.ID
-}
+func (*AdminUsersUpdateUnauthorized) adminUsersUpdateRes() {}
 
-// GetUsername returns the value of Username.
-func (s *CurrentUser) GetUsername() string {
-	return s.Username
-}
+type AmenitiesCreateBadRequest ProblemDetails
 
-// GetEmail returns the value of Email.
-func (s *CurrentUser) GetEmail() OptString {
-	return s.Email
-}
+func (*AmenitiesCreateBadRequest) amenitiesCreateRes() {}
 
-// SetID sets the value of ID.
-func (s *CurrentUser) SetID(val int) {
-	s.ID = val
-}
+type AmenitiesCreateConflict ProblemDetails
 
-// SetUsername sets the value of Username.
-func (s *CurrentUser) SetUsername(val string) {
-	s.Username = val
-}
+func (*AmenitiesCreateConflict) amenitiesCreateRes() {}
 
-// SetEmail sets the value of Email.
-func (s *CurrentUser) SetEmail(val OptString) {
-	s.Email = val
-}
+type AmenitiesCreateForbidden ProblemDetails
 
-func (*CurrentUser) meRetrieveRes() {}
+func (*AmenitiesCreateForbidden) amenitiesCreateRes() {}
 
-type EmailString string
+type AmenitiesCreateUnauthorized ProblemDetails
 
-type FacilitiesCreateBadRequest ProblemDetails
+func (*AmenitiesCreateUnauthorized) amenitiesCreateRes() {}
 
-func (*FacilitiesCreateBadRequest) facilitiesCreateRes() {}
+type AmenitiesDestroyForbidden ProblemDetails
 
-type FacilitiesCreateForbidden ProblemDetails
+func (*AmenitiesDestroyForbidden) amenitiesDestroyRes() {}
 
-func (*FacilitiesCreateForbidden) facilitiesCreateRes() {}
+// AmenitiesDestroyNoContent is response for AmenitiesDestroy operation.
+type AmenitiesDestroyNoContent struct{}
 
-type FacilitiesDestroyBadRequest ProblemDetails
+func (*AmenitiesDestroyNoContent) amenitiesDestroyRes() {}
 
-func (*FacilitiesDestroyBadRequest) facilitiesDestroyRes() {}
+type AmenitiesDestroyNotFound ProblemDetails
 
-// FacilitiesDestroyNoContent is response for FacilitiesDestroy operation.
-type FacilitiesDestroyNoContent struct{}
+func (*AmenitiesDestroyNotFound) amenitiesDestroyRes() {}
 
-func (*FacilitiesDestroyNoContent) facilitiesDestroyRes() {}
+type AmenitiesDestroyUnauthorized ProblemDetails
 
-type FacilitiesDestroyNotFound ProblemDetails
+func (*AmenitiesDestroyUnauthorized) amenitiesDestroyRes() {}
 
-func (*FacilitiesDestroyNotFound) facilitiesDestroyRes() {}
+type AmenitiesUpdateBadRequest ProblemDetails
 
-type FacilitiesPartialUpdateBadRequest ProblemDetails
+func (*AmenitiesUpdateBadRequest) amenitiesUpdateRes() {}
 
-func (*FacilitiesPartialUpdateBadRequest) facilitiesPartialUpdateRes() {}
+type AmenitiesUpdateConflict ProblemDetails
 
-type FacilitiesPartialUpdateNotFound ProblemDetails
+func (*AmenitiesUpdateConflict) amenitiesUpdateRes() {}
 
-func (*FacilitiesPartialUpdateNotFound) facilitiesPartialUpdateRes() {}
+type AmenitiesUpdateForbidden ProblemDetails
 
-type FacilitiesUpdateBadRequest ProblemDetails
+func (*AmenitiesUpdateForbidden) amenitiesUpdateRes() {}
 
-func (*FacilitiesUpdateBadRequest) facilitiesUpdateRes() {}
+type AmenitiesUpdateNotFound ProblemDetails
 
-type FacilitiesUpdateNotFound ProblemDetails
+func (*AmenitiesUpdateNotFound) amenitiesUpdateRes() {}
 
-func (*FacilitiesUpdateNotFound) facilitiesUpdateRes() {}
+type AmenitiesUpdateUnauthorized ProblemDetails
 
-// NewOptAdminUserMergePatchUpdateEmail returns new OptAdminUserMergePatchUpdateEmail with value set to v.
-func NewOptAdminUserMergePatchUpdateEmail(v AdminUserMergePatchUpdateEmail) OptAdminUserMergePatchUpdateEmail {
-	return OptAdminUserMergePatchUpdateEmail{
-		Value: v,
-		Set:   true,
-	}
+func (*AmenitiesUpdateUnauthorized) amenitiesUpdateRes() {}
+
+// A feature a facility can offer, such as a projector or wheelchair access.
+// Ref: #/components/schemas/Amenity
+type Amenity struct {
+	ID uuid.UUID `json:"id"`
+	// Unique identifier of the amenity used to filter facilities, e.g. `video-conferencing`.
+	// Lowercase letters and digits separated by single hyphens.
+	Slug string `json:"slug"`
+	// Display name of the amenity.
+	Name string `json:"name"`
+	// Optional description of the amenity.
+	Description OptString `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
-// OptAdminUserMergePatchUpdateEmail is optional AdminUserMergePatchUpdateEmail.
-type OptAdminUserMergePatchUpdateEmail struct {
-	Value AdminUserMergePatchUpdateEmail
-	Set   bool
+// GetID returns the value of ID.
+func (s *Amenity) GetID() uuid.UUID {
+	return s.ID
 }
 
-// IsSet returns true if OptAdminUserMergePatchUpdateEmail was set.
-func (o OptAdminUserMergePatchUpdateEmail) IsSet() bool { return o.Set }
+// GetSlug returns the value of Slug.
+func (s *Amenity) GetSlug() string {
+	return s.Slug
+}
 
-// Reset unsets value.
-func (o *OptAdminUserMergePatchUpdateEmail) Reset() {
-	var v AdminUserMergePatchUpdateEmail
-	o.Value = v
-	o.Set = false
+// GetName returns the value of Name.
+func (s *Amenity) GetName() string {
+	return s.Name
 }
 
-// SetTo sets value to v.
-func (o *OptAdminUserMergePatchUpdateEmail) SetTo(v AdminUserMergePatchUpdateEmail) {
-	o.Set = true
-	o.Value = v
+// GetDescription returns the value of Description.
+func (s *Amenity) GetDescription() OptString {
+	return s.Description
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptAdminUserMergePatchUpdateEmail) Get() (v AdminUserMergePatchUpdateEmail, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Amenity) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptAdminUserMergePatchUpdateEmail) Or(d AdminUserMergePatchUpdateEmail) AdminUserMergePatchUpdateEmail {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Amenity) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// NewOptAdminUserMergePatchUpdateIsStaff returns new OptAdminUserMergePatchUpdateIsStaff with value set to v.
-func NewOptAdminUserMergePatchUpdateIsStaff(v AdminUserMergePatchUpdateIsStaff) OptAdminUserMergePatchUpdateIsStaff {
-	return OptAdminUserMergePatchUpdateIsStaff{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *Amenity) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// OptAdminUserMergePatchUpdateIsStaff is optional AdminUserMergePatchUpdateIsStaff.
-type OptAdminUserMergePatchUpdateIsStaff struct {
-	Value AdminUserMergePatchUpdateIsStaff
-	Set   bool
+// SetSlug sets the value of Slug.
+func (s *Amenity) SetSlug(val string) {
+	s.Slug = val
 }
 
-// IsSet returns true if OptAdminUserMergePatchUpdateIsStaff was set.
-func (o OptAdminUserMergePatchUpdateIsStaff) IsSet() bool { return o.Set }
+// SetName sets the value of Name.
+func (s *Amenity) SetName(val string) {
+	s.Name = val
+}
 
-// Reset unsets value.
-func (o *OptAdminUserMergePatchUpdateIsStaff) Reset() {
-	var v AdminUserMergePatchUpdateIsStaff
-	o.Value = v
-	o.Set = false
+// SetDescription sets the value of Description.
+func (s *Amenity) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// SetTo sets value to v.
-func (o *OptAdminUserMergePatchUpdateIsStaff) SetTo(v AdminUserMergePatchUpdateIsStaff) {
-	o.Set = true
-	o.Value = v
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Amenity) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptAdminUserMergePatchUpdateIsStaff) Get() (v AdminUserMergePatchUpdateIsStaff, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Amenity) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptAdminUserMergePatchUpdateIsStaff) Or(d AdminUserMergePatchUpdateIsStaff) AdminUserMergePatchUpdateIsStaff {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*Amenity) amenitiesCreateRes()   {}
+func (*Amenity) amenitiesRetrieveRes() {}
+func (*Amenity) amenitiesUpdateRes()   {}
+
+type AvailabilityListOKApplicationJSON []FacilityAvailability
+
+func (*AvailabilityListOKApplicationJSON) availabilityListRes() {}
+
+// A free period of a facility.
+// Ref: #/components/schemas/AvailabilitySlot
+type AvailabilitySlot struct {
+	// Start of the free period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the free period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// starts_at in the time zone of the facility.
+	LocalStartsAt time.Time `json:"local_starts_at"`
+	// ends_at in the time zone of the facility.
+	LocalEndsAt time.Time `json:"local_ends_at"`
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// GetStartsAt returns the value of StartsAt.
+func (s *AvailabilitySlot) GetStartsAt() time.Time {
+	return s.StartsAt
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// GetEndsAt returns the value of EndsAt.
+func (s *AvailabilitySlot) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+// GetLocalStartsAt returns the value of LocalStartsAt.
+func (s *AvailabilitySlot) GetLocalStartsAt() time.Time {
+	return s.LocalStartsAt
+}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetLocalEndsAt returns the value of LocalEndsAt.
+func (s *AvailabilitySlot) GetLocalEndsAt() time.Time {
+	return s.LocalEndsAt
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// SetStartsAt sets the value of StartsAt.
+func (s *AvailabilitySlot) SetStartsAt(val time.Time) {
+	s.StartsAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetEndsAt sets the value of EndsAt.
+func (s *AvailabilitySlot) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLocalStartsAt sets the value of LocalStartsAt.
+func (s *AvailabilitySlot) SetLocalStartsAt(val time.Time) {
+	s.LocalStartsAt = val
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// SetLocalEndsAt sets the value of LocalEndsAt.
+func (s *AvailabilitySlot) SetLocalEndsAt(val time.Time) {
+	s.LocalEndsAt = val
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+type BearerAuth struct {
+	Token string
+	Roles []string
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
 
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// A window, one-off or recurring, in which a facility cannot be reserved.
+// Ref: #/components/schemas/Blackout
+type Blackout struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the blocked facility.
+	FacilityID int `json:"facility_id"`
+	// Start of the blackout (inclusive). For a recurring blackout, the start of its first occurrence.
+	StartsAt time.Time `json:"starts_at"`
+	// End of the blackout (exclusive). Every occurrence of a recurring blackout lasts as long as the first
+	// one.
+	EndsAt time.Time `json:"ends_at"`
+	// Why the facility cannot be reserved, e.g. renovation or cleaning. Visible to users.
+	Reason string `json:"reason"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	// Omit for a one-off blackout.
+	Rrule OptString `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Only allowed together with rrule.
+	// Defaults to the time zone of the facility.
+	TimeZone  OptString `json:"time_zone"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// NewOptEmailString returns new OptEmailString with value set to v.
-func NewOptEmailString(v EmailString) OptEmailString {
-	return OptEmailString{
-		Value: v,
-		Set:   true,
-	}
+// GetID returns the value of ID.
+func (s *Blackout) GetID() uuid.UUID {
+	return s.ID
 }
 
-// OptEmailString is optional EmailString.
-type OptEmailString struct {
-	Value EmailString
-	Set   bool
+// GetFacilityID returns the value of FacilityID.
+func (s *Blackout) GetFacilityID() int {
+	return s.FacilityID
 }
 
-// IsSet returns true if OptEmailString was set.
-func (o OptEmailString) IsSet() bool { return o.Set }
+// GetStartsAt returns the value of StartsAt.
+func (s *Blackout) GetStartsAt() time.Time {
+	return s.StartsAt
+}
 
-// Reset unsets value.
-func (o *OptEmailString) Reset() {
-	var v EmailString
-	o.Value = v
-	o.Set = false
+// GetEndsAt returns the value of EndsAt.
+func (s *Blackout) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// SetTo sets value to v.
-func (o *OptEmailString) SetTo(v EmailString) {
-	o.Set = true
-	o.Value = v
+// GetReason returns the value of Reason.
+func (s *Blackout) GetReason() string {
+	return s.Reason
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEmailString) Get() (v EmailString, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetRrule returns the value of Rrule.
+func (s *Blackout) GetRrule() OptString {
+	return s.Rrule
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEmailString) Or(d EmailString) EmailString {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetTimeZone returns the value of TimeZone.
+func (s *Blackout) GetTimeZone() OptString {
+	return s.TimeZone
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Blackout) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetID sets the value of ID.
+func (s *Blackout) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// SetFacilityID sets the value of FacilityID.
+func (s *Blackout) SetFacilityID(val int) {
+	s.FacilityID = val
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetStartsAt sets the value of StartsAt.
+func (s *Blackout) SetStartsAt(val time.Time) {
+	s.StartsAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// SetEndsAt sets the value of EndsAt.
+func (s *Blackout) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetReason sets the value of Reason.
+func (s *Blackout) SetReason(val string) {
+	s.Reason = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetRrule sets the value of Rrule.
+func (s *Blackout) SetRrule(val OptString) {
+	s.Rrule = val
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
-		Value: v,
-		Set:   true,
-	}
+// SetTimeZone sets the value of TimeZone.
+func (s *Blackout) SetTimeZone(val OptString) {
+	s.TimeZone = val
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
-	Set   bool
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Blackout) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
+func (*Blackout) facilitiesBlackoutsRetrieveRes() {}
 
-// Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
+// Fields of a blackout window that can be set by administrators.
+// Ref: #/components/schemas/BlackoutInput
+type BlackoutInput struct {
+	// Start of the blackout (inclusive). For a recurring blackout, the start of its first occurrence.
+	StartsAt time.Time `json:"starts_at"`
+	// End of the blackout (exclusive). Every occurrence of a recurring blackout lasts as long as the first
+	// one.
+	EndsAt time.Time `json:"ends_at"`
+	// Why the facility cannot be reserved, e.g. renovation or cleaning. Visible to users.
+	Reason string `json:"reason"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	// Omit for a one-off blackout.
+	Rrule OptString `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Only allowed together with rrule.
+	// Defaults to the time zone of the facility.
+	TimeZone OptString `json:"time_zone"`
 }
 
-// SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
-	o.Set = true
-	o.Value = v
+// GetStartsAt returns the value of StartsAt.
+func (s *BlackoutInput) GetStartsAt() time.Time {
+	return s.StartsAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetEndsAt returns the value of EndsAt.
+func (s *BlackoutInput) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetReason returns the value of Reason.
+func (s *BlackoutInput) GetReason() string {
+	return s.Reason
 }
 
-// NewOptPublicFacilityMergePatchUpdateDescription returns new OptPublicFacilityMergePatchUpdateDescription with value set to v.
-func NewOptPublicFacilityMergePatchUpdateDescription(v PublicFacilityMergePatchUpdateDescription) OptPublicFacilityMergePatchUpdateDescription {
-	return OptPublicFacilityMergePatchUpdateDescription{
-		Value: v,
-		Set:   true,
-	}
+// GetRrule returns the value of Rrule.
+func (s *BlackoutInput) GetRrule() OptString {
+	return s.Rrule
 }
 
-// OptPublicFacilityMergePatchUpdateDescription is optional PublicFacilityMergePatchUpdateDescription.
-type OptPublicFacilityMergePatchUpdateDescription struct {
-	Value PublicFacilityMergePatchUpdateDescription
-	Set   bool
+// GetTimeZone returns the value of TimeZone.
+func (s *BlackoutInput) GetTimeZone() OptString {
+	return s.TimeZone
 }
 
-// IsSet returns true if OptPublicFacilityMergePatchUpdateDescription was set.
-func (o OptPublicFacilityMergePatchUpdateDescription) IsSet() bool { return o.Set }
+// SetStartsAt sets the value of StartsAt.
+func (s *BlackoutInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
 
-// Reset unsets value.
-func (o *OptPublicFacilityMergePatchUpdateDescription) Reset() {
-	var v PublicFacilityMergePatchUpdateDescription
-	o.Value = v
-	o.Set = false
+// SetEndsAt sets the value of EndsAt.
+func (s *BlackoutInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptPublicFacilityMergePatchUpdateDescription) SetTo(v PublicFacilityMergePatchUpdateDescription) {
-	o.Set = true
-	o.Value = v
+// SetReason sets the value of Reason.
+func (s *BlackoutInput) SetReason(val string) {
+	s.Reason = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPublicFacilityMergePatchUpdateDescription) Get() (v PublicFacilityMergePatchUpdateDescription, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRrule sets the value of Rrule.
+func (s *BlackoutInput) SetRrule(val OptString) {
+	s.Rrule = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPublicFacilityMergePatchUpdateDescription) Or(d PublicFacilityMergePatchUpdateDescription) PublicFacilityMergePatchUpdateDescription {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetTimeZone sets the value of TimeZone.
+func (s *BlackoutInput) SetTimeZone(val OptString) {
+	s.TimeZone = val
 }
 
-// NewOptPublicFacilityMergePatchUpdateIsActive returns new OptPublicFacilityMergePatchUpdateIsActive with value set to v.
-func NewOptPublicFacilityMergePatchUpdateIsActive(v PublicFacilityMergePatchUpdateIsActive) OptPublicFacilityMergePatchUpdateIsActive {
-	return OptPublicFacilityMergePatchUpdateIsActive{
-		Value: v,
-		Set:   true,
-	}
+// An occurrence of a blackout window.
+// Ref: #/components/schemas/BlackoutPeriod
+type BlackoutPeriod struct {
+	// ID of the blackout.
+	BlackoutID uuid.UUID `json:"blackout_id"`
+	// Start of the occurrence (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the occurrence (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// starts_at in the time zone of the facility.
+	LocalStartsAt time.Time `json:"local_starts_at"`
+	// ends_at in the time zone of the facility.
+	LocalEndsAt time.Time `json:"local_ends_at"`
+	// Why the facility cannot be reserved.
+	Reason string `json:"reason"`
 }
 
-// OptPublicFacilityMergePatchUpdateIsActive is optional PublicFacilityMergePatchUpdateIsActive.
-type OptPublicFacilityMergePatchUpdateIsActive struct {
-	Value PublicFacilityMergePatchUpdateIsActive
-	Set   bool
+// GetBlackoutID returns the value of BlackoutID.
+func (s *BlackoutPeriod) GetBlackoutID() uuid.UUID {
+	return s.BlackoutID
 }
 
-// IsSet returns true if OptPublicFacilityMergePatchUpdateIsActive was set.
-func (o OptPublicFacilityMergePatchUpdateIsActive) IsSet() bool { return o.Set }
+// GetStartsAt returns the value of StartsAt.
+func (s *BlackoutPeriod) GetStartsAt() time.Time {
+	return s.StartsAt
+}
 
-// Reset unsets value.
-func (o *OptPublicFacilityMergePatchUpdateIsActive) Reset() {
-	var v PublicFacilityMergePatchUpdateIsActive
-	o.Value = v
-	o.Set = false
+// GetEndsAt returns the value of EndsAt.
+func (s *BlackoutPeriod) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// SetTo sets value to v.
-func (o *OptPublicFacilityMergePatchUpdateIsActive) SetTo(v PublicFacilityMergePatchUpdateIsActive) {
-	o.Set = true
-	o.Value = v
+// GetLocalStartsAt returns the value of LocalStartsAt.
+func (s *BlackoutPeriod) GetLocalStartsAt() time.Time {
+	return s.LocalStartsAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPublicFacilityMergePatchUpdateIsActive) Get() (v PublicFacilityMergePatchUpdateIsActive, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetLocalEndsAt returns the value of LocalEndsAt.
+func (s *BlackoutPeriod) GetLocalEndsAt() time.Time {
+	return s.LocalEndsAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPublicFacilityMergePatchUpdateIsActive) Or(d PublicFacilityMergePatchUpdateIsActive) PublicFacilityMergePatchUpdateIsActive {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetReason returns the value of Reason.
+func (s *BlackoutPeriod) GetReason() string {
+	return s.Reason
 }
 
-// NewOptPublicFacilityMergePatchUpdateLocation returns new OptPublicFacilityMergePatchUpdateLocation with value set to v.
-func NewOptPublicFacilityMergePatchUpdateLocation(v PublicFacilityMergePatchUpdateLocation) OptPublicFacilityMergePatchUpdateLocation {
-	return OptPublicFacilityMergePatchUpdateLocation{
-		Value: v,
-		Set:   true,
-	}
+// SetBlackoutID sets the value of BlackoutID.
+func (s *BlackoutPeriod) SetBlackoutID(val uuid.UUID) {
+	s.BlackoutID = val
 }
 
-// OptPublicFacilityMergePatchUpdateLocation is optional PublicFacilityMergePatchUpdateLocation.
-type OptPublicFacilityMergePatchUpdateLocation struct {
-	Value PublicFacilityMergePatchUpdateLocation
-	Set   bool
+// SetStartsAt sets the value of StartsAt.
+func (s *BlackoutPeriod) SetStartsAt(val time.Time) {
+	s.StartsAt = val
 }
 
-// IsSet returns true if OptPublicFacilityMergePatchUpdateLocation was set.
-func (o OptPublicFacilityMergePatchUpdateLocation) IsSet() bool { return o.Set }
+// SetEndsAt sets the value of EndsAt.
+func (s *BlackoutPeriod) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
 
-// Reset unsets value.
-func (o *OptPublicFacilityMergePatchUpdateLocation) Reset() {
-	var v PublicFacilityMergePatchUpdateLocation
-	o.Value = v
-	o.Set = false
+// SetLocalStartsAt sets the value of LocalStartsAt.
+func (s *BlackoutPeriod) SetLocalStartsAt(val time.Time) {
+	s.LocalStartsAt = val
 }
 
-// SetTo sets value to v.
-func (o *OptPublicFacilityMergePatchUpdateLocation) SetTo(v PublicFacilityMergePatchUpdateLocation) {
-	o.Set = true
-	o.Value = v
+// SetLocalEndsAt sets the value of LocalEndsAt.
+func (s *BlackoutPeriod) SetLocalEndsAt(val time.Time) {
+	s.LocalEndsAt = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPublicFacilityMergePatchUpdateLocation) Get() (v PublicFacilityMergePatchUpdateLocation, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetReason sets the value of Reason.
+func (s *BlackoutPeriod) SetReason(val string) {
+	s.Reason = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPublicFacilityMergePatchUpdateLocation) Or(d PublicFacilityMergePatchUpdateLocation) PublicFacilityMergePatchUpdateLocation {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// A created blackout together with the reservations it conflicts with.
+// Ref: #/components/schemas/BlackoutWithConflicts
+type BlackoutWithConflicts struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the blocked facility.
+	FacilityID int `json:"facility_id"`
+	// Start of the blackout (inclusive). For a recurring blackout, the start of its first occurrence.
+	StartsAt time.Time `json:"starts_at"`
+	// End of the blackout (exclusive). Every occurrence of a recurring blackout lasts as long as the first
+	// one.
+	EndsAt time.Time `json:"ends_at"`
+	// Why the facility cannot be reserved, e.g. renovation or cleaning. Visible to users.
+	Reason string `json:"reason"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	// Omit for a one-off blackout.
+	Rrule OptString `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Only allowed together with rrule.
+	// Defaults to the time zone of the facility.
+	TimeZone  OptString `json:"time_zone"`
+	CreatedAt time.Time `json:"created_at"`
+	// Confirmed reservations overlapping an occurrence of the blackout, ordered by start time.
+	// Only returned when include_conflicts is set. The reservations are kept.
+	Conflicts []Reservation `json:"conflicts"`
 }
 
-// NewOptPublicFacilityMergePatchUpdatePriority returns new OptPublicFacilityMergePatchUpdatePriority with value set to v.
-func NewOptPublicFacilityMergePatchUpdatePriority(v PublicFacilityMergePatchUpdatePriority) OptPublicFacilityMergePatchUpdatePriority {
-	return OptPublicFacilityMergePatchUpdatePriority{
-		Value: v,
-		Set:   true,
-	}
+// GetID returns the value of ID.
+func (s *BlackoutWithConflicts) GetID() uuid.UUID {
+	return s.ID
 }
 
-// OptPublicFacilityMergePatchUpdatePriority is optional PublicFacilityMergePatchUpdatePriority.
-type OptPublicFacilityMergePatchUpdatePriority struct {
-	Value PublicFacilityMergePatchUpdatePriority
-	Set   bool
+// GetFacilityID returns the value of FacilityID.
+func (s *BlackoutWithConflicts) GetFacilityID() int {
+	return s.FacilityID
 }
 
-// IsSet returns true if OptPublicFacilityMergePatchUpdatePriority was set.
-func (o OptPublicFacilityMergePatchUpdatePriority) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptPublicFacilityMergePatchUpdatePriority) Reset() {
-	var v PublicFacilityMergePatchUpdatePriority
-	o.Value = v
-	o.Set = false
+// GetStartsAt returns the value of StartsAt.
+func (s *BlackoutWithConflicts) GetStartsAt() time.Time {
+	return s.StartsAt
 }
 
-// SetTo sets value to v.
-func (o *OptPublicFacilityMergePatchUpdatePriority) SetTo(v PublicFacilityMergePatchUpdatePriority) {
-	o.Set = true
-	o.Value = v
+// GetEndsAt returns the value of EndsAt.
+func (s *BlackoutWithConflicts) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptPublicFacilityMergePatchUpdatePriority) Get() (v PublicFacilityMergePatchUpdatePriority, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetReason returns the value of Reason.
+func (s *BlackoutWithConflicts) GetReason() string {
+	return s.Reason
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptPublicFacilityMergePatchUpdatePriority) Or(d PublicFacilityMergePatchUpdatePriority) PublicFacilityMergePatchUpdatePriority {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRrule returns the value of Rrule.
+func (s *BlackoutWithConflicts) GetRrule() OptString {
+	return s.Rrule
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetTimeZone returns the value of TimeZone.
+func (s *BlackoutWithConflicts) GetTimeZone() OptString {
+	return s.TimeZone
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BlackoutWithConflicts) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetConflicts returns the value of Conflicts.
+func (s *BlackoutWithConflicts) GetConflicts() []Reservation {
+	return s.Conflicts
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetID sets the value of ID.
+func (s *BlackoutWithConflicts) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetFacilityID sets the value of FacilityID.
+func (s *BlackoutWithConflicts) SetFacilityID(val int) {
+	s.FacilityID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStartsAt sets the value of StartsAt.
+func (s *BlackoutWithConflicts) SetStartsAt(val time.Time) {
+	s.StartsAt = val
 }
 
-// Ref: #/components/schemas/ProblemDetails
-type ProblemDetails struct {
-	// A URI reference [RFC3986] that identifies the problem type.
-	Type OptString `json:"type"`
-	// A short, human-readable summary of the problem type.
-	Title OptString `json:"title"`
-	// The HTTP status code for this occurrence of the problem.
-	Status OptInt `json:"status"`
-	// A human-readable explanation specific to this occurrence of the problem.
-	Detail OptString `json:"detail"`
-	// A URI reference that identifies the specific occurrence of the problem.
-	Instance OptString `json:"instance"`
+// SetEndsAt sets the value of EndsAt.
+func (s *BlackoutWithConflicts) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// GetType returns the value of Type.
-func (s *ProblemDetails) GetType() OptString {
-	return s.Type
+// SetReason sets the value of Reason.
+func (s *BlackoutWithConflicts) SetReason(val string) {
+	s.Reason = val
 }
 
-// GetTitle returns the value of Title.
-func (s *ProblemDetails) GetTitle() OptString {
-	return s.Title
+// SetRrule sets the value of Rrule.
+func (s *BlackoutWithConflicts) SetRrule(val OptString) {
+	s.Rrule = val
 }
 
-// GetStatus returns the value of Status.
-func (s *ProblemDetails) GetStatus() OptInt {
-	return s.Status
+// SetTimeZone sets the value of TimeZone.
+func (s *BlackoutWithConflicts) SetTimeZone(val OptString) {
+	s.TimeZone = val
 }
 
-// GetDetail returns the value of Detail.
-func (s *ProblemDetails) GetDetail() OptString {
-	return s.Detail
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BlackoutWithConflicts) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetInstance returns the value of Instance.
-func (s *ProblemDetails) GetInstance() OptString {
-	return s.Instance
+// SetConflicts sets the value of Conflicts.
+func (s *BlackoutWithConflicts) SetConflicts(val []Reservation) {
+	s.Conflicts = val
 }
 
-// SetType sets the value of Type.
-func (s *ProblemDetails) SetType(val OptString) {
-	s.Type = val
-}
+func (*BlackoutWithConflicts) facilitiesBlackoutsCreateRes() {}
 
-// SetTitle sets the value of Title.
-func (s *ProblemDetails) SetTitle(val OptString) {
-	s.Title = val
+// Rules reservations of a facility must follow. An omitted rule is not enforced, or inherited from the
+// organization-wide default when set on a facility.
+// Ref: #/components/schemas/BookingPolicy
+type BookingPolicy struct {
+	// Minimum length of a reservation in minutes.
+	MinDurationMinutes OptInt32 `json:"min_duration_minutes"`
+	// Maximum length of a reservation in minutes.
+	MaxDurationMinutes OptInt32 `json:"max_duration_minutes"`
+	// Reservations must start and end on multiples of this many minutes from midnight. Must divide a day
+	// evenly, e.g. 15.
+	GranularityMinutes OptInt32 `json:"granularity_minutes"`
+	// How many days ahead of its start a reservation may be made at most.
+	MaxAdvanceDays OptInt32 `json:"max_advance_days"`
+	// Time of day after which the facility can no longer be reserved for the same day.
+	SameDayCutoff OptTime `json:"same_day_cutoff"`
 }
 
-// SetStatus sets the value of Status.
-func (s *ProblemDetails) SetStatus(val OptInt) {
-	s.Status = val
+// GetMinDurationMinutes returns the value of MinDurationMinutes.
+func (s *BookingPolicy) GetMinDurationMinutes() OptInt32 {
+	return s.MinDurationMinutes
 }
 
-// SetDetail sets the value of Detail.
-func (s *ProblemDetails) SetDetail(val OptString) {
-	s.Detail = val
+// GetMaxDurationMinutes returns the value of MaxDurationMinutes.
+func (s *BookingPolicy) GetMaxDurationMinutes() OptInt32 {
+	return s.MaxDurationMinutes
 }
 
-// SetInstance sets the value of Instance.
-func (s *ProblemDetails) SetInstance(val OptString) {
-	s.Instance = val
+// GetGranularityMinutes returns the value of GranularityMinutes.
+func (s *BookingPolicy) GetGranularityMinutes() OptInt32 {
+	return s.GranularityMinutes
 }
 
-func (*ProblemDetails) facilitiesRetrieveRes() {}
-func (*ProblemDetails) meRetrieveRes()         {}
-
-// Ref: #/components/schemas/PublicFacility
-type PublicFacility struct {
-	ID int `json:"id"`
-	// Display name of the facility. Visible to users.
-	Name string `json:"name"`
-	// Optional description of the facility, including usage rules or details.
-	Description OptString `json:"description"`
-	// Physical location or address of the facility.
-	Location OptString `json:"location"`
-	// Display priority. Lower numbers appear earlier in sorted lists.
-	Priority OptInt64 `json:"priority"`
-	// Set to false to disable this facility from public listing or reservation.
-	IsActive  OptBool     `json:"is_active"`
-	CreatedAt OptDateTime `json:"created_at"`
-	UpdatedAt OptDateTime `json:"updated_at"`
+// GetMaxAdvanceDays returns the value of MaxAdvanceDays.
+func (s *BookingPolicy) GetMaxAdvanceDays() OptInt32 {
+	return s.MaxAdvanceDays
 }
 
-// GetID returns the value of ID.
-func (s *PublicFacility) GetID() int {
-	return s.ID
+// GetSameDayCutoff returns the value of SameDayCutoff.
+func (s *BookingPolicy) GetSameDayCutoff() OptTime {
+	return s.SameDayCutoff
 }
 
-// GetName returns the value of Name.
-func (s *PublicFacility) GetName() string {
-	return s.Name
+// SetMinDurationMinutes sets the value of MinDurationMinutes.
+func (s *BookingPolicy) SetMinDurationMinutes(val OptInt32) {
+	s.MinDurationMinutes = val
 }
 
-// GetDescription returns the value of Description.
-func (s *PublicFacility) GetDescription() OptString {
-	return s.Description
+// SetMaxDurationMinutes sets the value of MaxDurationMinutes.
+func (s *BookingPolicy) SetMaxDurationMinutes(val OptInt32) {
+	s.MaxDurationMinutes = val
 }
 
-// GetLocation returns the value of Location.
-func (s *PublicFacility) GetLocation() OptString {
-	return s.Location
+// SetGranularityMinutes sets the value of GranularityMinutes.
+func (s *BookingPolicy) SetGranularityMinutes(val OptInt32) {
+	s.GranularityMinutes = val
 }
 
-// GetPriority returns the value of Priority.
-func (s *PublicFacility) GetPriority() OptInt64 {
-	return s.Priority
+// SetMaxAdvanceDays sets the value of MaxAdvanceDays.
+func (s *BookingPolicy) SetMaxAdvanceDays(val OptInt32) {
+	s.MaxAdvanceDays = val
 }
 
-// GetIsActive returns the value of IsActive.
-func (s *PublicFacility) GetIsActive() OptBool {
-	return s.IsActive
+// SetSameDayCutoff sets the value of SameDayCutoff.
+func (s *BookingPolicy) SetSameDayCutoff(val OptTime) {
+	s.SameDayCutoff = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *PublicFacility) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
-}
+func (*BookingPolicy) bookingPolicyUpdateRes() {}
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *PublicFacility) GetUpdatedAt() OptDateTime {
-	return s.UpdatedAt
+// A rule of a booking policy.
+// Ref: #/components/schemas/BookingPolicyRule
+type BookingPolicyRule string
+
+const (
+	BookingPolicyRuleMinDuration   BookingPolicyRule = "min_duration"
+	BookingPolicyRuleMaxDuration   BookingPolicyRule = "max_duration"
+	BookingPolicyRuleGranularity   BookingPolicyRule = "granularity"
+	BookingPolicyRuleAdvanceWindow BookingPolicyRule = "advance_window"
+	BookingPolicyRuleSameDayCutoff BookingPolicyRule = "same_day_cutoff"
+)
+
+// AllValues returns all BookingPolicyRule values.
+func (BookingPolicyRule) AllValues() []BookingPolicyRule {
+	return []BookingPolicyRule{
+		BookingPolicyRuleMinDuration,
+		BookingPolicyRuleMaxDuration,
+		BookingPolicyRuleGranularity,
+		BookingPolicyRuleAdvanceWindow,
+		BookingPolicyRuleSameDayCutoff,
+	}
 }
 
-// SetID sets the value of ID.
-func (s *PublicFacility) SetID(val int) {
-	s.ID = val
+// MarshalText implements encoding.TextMarshaler.
+func (s BookingPolicyRule) MarshalText() ([]byte, error) {
+	switch s {
+	case BookingPolicyRuleMinDuration:
+		return []byte(s), nil
+	case BookingPolicyRuleMaxDuration:
+		return []byte(s), nil
+	case BookingPolicyRuleGranularity:
+		return []byte(s), nil
+	case BookingPolicyRuleAdvanceWindow:
+		return []byte(s), nil
+	case BookingPolicyRuleSameDayCutoff:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetName sets the value of Name.
-func (s *PublicFacility) SetName(val string) {
-	s.Name = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BookingPolicyRule) UnmarshalText(data []byte) error {
+	switch BookingPolicyRule(data) {
+	case BookingPolicyRuleMinDuration:
+		*s = BookingPolicyRuleMinDuration
+		return nil
+	case BookingPolicyRuleMaxDuration:
+		*s = BookingPolicyRuleMaxDuration
+		return nil
+	case BookingPolicyRuleGranularity:
+		*s = BookingPolicyRuleGranularity
+		return nil
+	case BookingPolicyRuleAdvanceWindow:
+		*s = BookingPolicyRuleAdvanceWindow
+		return nil
+	case BookingPolicyRuleSameDayCutoff:
+		*s = BookingPolicyRuleSameDayCutoff
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetDescription sets the value of Description.
-func (s *PublicFacility) SetDescription(val OptString) {
-	s.Description = val
+type BookingPolicyUpdateBadRequest ProblemDetails
+
+func (*BookingPolicyUpdateBadRequest) bookingPolicyUpdateRes() {}
+
+type BookingPolicyUpdateForbidden ProblemDetails
+
+func (*BookingPolicyUpdateForbidden) bookingPolicyUpdateRes() {}
+
+type BookingPolicyUpdateUnauthorized ProblemDetails
+
+func (*BookingPolicyUpdateUnauthorized) bookingPolicyUpdateRes() {}
+
+// A booking policy rule violated by a reservation.
+// Ref: #/components/schemas/BookingPolicyViolation
+type BookingPolicyViolation struct {
+	// The violated rule.
+	Rule BookingPolicyRule `json:"rule"`
+	// A human-readable explanation of the violation.
+	Detail string `json:"detail"`
 }
 
-// SetLocation sets the value of Location.
-func (s *PublicFacility) SetLocation(val OptString) {
-	s.Location = val
+// GetRule returns the value of Rule.
+func (s *BookingPolicyViolation) GetRule() BookingPolicyRule {
+	return s.Rule
 }
 
-// SetPriority sets the value of Priority.
-func (s *PublicFacility) SetPriority(val OptInt64) {
-	s.Priority = val
+// GetDetail returns the value of Detail.
+func (s *BookingPolicyViolation) GetDetail() string {
+	return s.Detail
 }
 
-// SetIsActive sets the value of IsActive.
-func (s *PublicFacility) SetIsActive(val OptBool) {
-	s.IsActive = val
+// SetRule sets the value of Rule.
+func (s *BookingPolicyViolation) SetRule(val BookingPolicyRule) {
+	s.Rule = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *PublicFacility) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// SetDetail sets the value of Detail.
+func (s *BookingPolicyViolation) SetDetail(val string) {
+	s.Detail = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *PublicFacility) SetUpdatedAt(val OptDateTime) {
-	s.UpdatedAt = val
+// Limits on how much a single user may reserve. An omitted limit is not enforced.
+// Ref: #/components/schemas/BookingQuota
+type BookingQuota struct {
+	// Maximum hours of reservations a user may have starting in a calendar week, from Monday to Sunday in
+	// the
+	// time zone of the reserved facility.
+	MaxHoursPerWeek OptInt32 `json:"max_hours_per_week"`
+	// Maximum number of reservations a user may have that have not ended yet.
+	MaxUpcomingReservations OptInt32 `json:"max_upcoming_reservations"`
 }
 
-func (*PublicFacility) facilitiesCreateRes()        {}
-func (*PublicFacility) facilitiesPartialUpdateRes() {}
-func (*PublicFacility) facilitiesRetrieveRes()      {}
-func (*PublicFacility) facilitiesUpdateRes()        {}
+// GetMaxHoursPerWeek returns the value of MaxHoursPerWeek.
+func (s *BookingQuota) GetMaxHoursPerWeek() OptInt32 {
+	return s.MaxHoursPerWeek
+}
 
-// Ref: #/components/schemas/PublicFacilityMergePatchUpdate
-type PublicFacilityMergePatchUpdate struct {
-	// Display name of the facility. Visible to users.
-	Name OptString `json:"name"`
-	// Optional description of the facility, including usage rules or details.
-	Description OptPublicFacilityMergePatchUpdateDescription `json:"description"`
-	// Physical location or address of the facility.
-	Location OptPublicFacilityMergePatchUpdateLocation `json:"location"`
-	// Display priority. Lower numbers appear earlier in sorted lists.
-	Priority OptPublicFacilityMergePatchUpdatePriority `json:"priority"`
-	// Set to false to disable this facility from public listing or reservation.
-	IsActive OptPublicFacilityMergePatchUpdateIsActive `json:"is_active"`
+// GetMaxUpcomingReservations returns the value of MaxUpcomingReservations.
+func (s *BookingQuota) GetMaxUpcomingReservations() OptInt32 {
+	return s.MaxUpcomingReservations
 }
 
-// GetName returns the value of Name.
-func (s *PublicFacilityMergePatchUpdate) GetName() OptString {
-	return s.Name
+// SetMaxHoursPerWeek sets the value of MaxHoursPerWeek.
+func (s *BookingQuota) SetMaxHoursPerWeek(val OptInt32) {
+	s.MaxHoursPerWeek = val
 }
 
-// GetDescription returns the value of Description.
-func (s *PublicFacilityMergePatchUpdate) GetDescription() OptPublicFacilityMergePatchUpdateDescription {
-	return s.Description
+// SetMaxUpcomingReservations sets the value of MaxUpcomingReservations.
+func (s *BookingQuota) SetMaxUpcomingReservations(val OptInt32) {
+	s.MaxUpcomingReservations = val
+}
+
+func (*BookingQuota) bookingQuotaUpdateRes()             {}
+func (*BookingQuota) facilitiesBookingQuotaRetrieveRes() {}
+func (*BookingQuota) facilitiesBookingQuotaUpdateRes()   {}
+
+type BookingQuotaUpdateBadRequest ProblemDetails
+
+func (*BookingQuotaUpdateBadRequest) bookingQuotaUpdateRes() {}
+
+type BookingQuotaUpdateForbidden ProblemDetails
+
+func (*BookingQuotaUpdateForbidden) bookingQuotaUpdateRes() {}
+
+type BookingQuotaUpdateUnauthorized ProblemDetails
+
+func (*BookingQuotaUpdateUnauthorized) bookingQuotaUpdateRes() {}
+
+// Usage of a user counted against a booking quota.
+// Ref: #/components/schemas/BookingQuotaUsage
+type BookingQuotaUsage struct {
+	// ID of the facility the quota applies to. Omitted for the organization-wide quota, which counts
+	// reservations of every facility.
+	FacilityID OptInt `json:"facility_id"`
+	// Limits the user is subject to.
+	Quota BookingQuota `json:"quota"`
+	// Whether the limits were set for this user, replacing those of every user.
+	IsOverride bool `json:"is_override"`
+	// Start of the current calendar week in the time zone of the facility, or in UTC for the
+	// organization-wide
+	// quota.
+	WeekStartsAt time.Time `json:"week_starts_at"`
+	// Minutes of confirmed, pending and held reservations of the user starting in the current week, and
+	// of no-shows until their release.
+	BookedMinutes int64 `json:"booked_minutes"`
+	// Number of confirmed reservations of the user that have not ended yet.
+	UpcomingReservations int64 `json:"upcoming_reservations"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *BookingQuotaUsage) GetFacilityID() OptInt {
+	return s.FacilityID
+}
+
+// GetQuota returns the value of Quota.
+func (s *BookingQuotaUsage) GetQuota() BookingQuota {
+	return s.Quota
+}
+
+// GetIsOverride returns the value of IsOverride.
+func (s *BookingQuotaUsage) GetIsOverride() bool {
+	return s.IsOverride
+}
+
+// GetWeekStartsAt returns the value of WeekStartsAt.
+func (s *BookingQuotaUsage) GetWeekStartsAt() time.Time {
+	return s.WeekStartsAt
+}
+
+// GetBookedMinutes returns the value of BookedMinutes.
+func (s *BookingQuotaUsage) GetBookedMinutes() int64 {
+	return s.BookedMinutes
+}
+
+// GetUpcomingReservations returns the value of UpcomingReservations.
+func (s *BookingQuotaUsage) GetUpcomingReservations() int64 {
+	return s.UpcomingReservations
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *BookingQuotaUsage) SetFacilityID(val OptInt) {
+	s.FacilityID = val
+}
+
+// SetQuota sets the value of Quota.
+func (s *BookingQuotaUsage) SetQuota(val BookingQuota) {
+	s.Quota = val
+}
+
+// SetIsOverride sets the value of IsOverride.
+func (s *BookingQuotaUsage) SetIsOverride(val bool) {
+	s.IsOverride = val
+}
+
+// SetWeekStartsAt sets the value of WeekStartsAt.
+func (s *BookingQuotaUsage) SetWeekStartsAt(val time.Time) {
+	s.WeekStartsAt = val
+}
+
+// SetBookedMinutes sets the value of BookedMinutes.
+func (s *BookingQuotaUsage) SetBookedMinutes(val int64) {
+	s.BookedMinutes = val
+}
+
+// SetUpcomingReservations sets the value of UpcomingReservations.
+func (s *BookingQuotaUsage) SetUpcomingReservations(val int64) {
+	s.UpcomingReservations = val
+}
+
+func (*BookingQuotaUsage) adminUsersQuotasUpdateRes() {}
+
+// How conflicting occurrences of a series are handled.
+// `reject` rejects the whole request, `skip` creates only the non-conflicting occurrences.
+// Ref: #/components/schemas/ConflictMode
+type ConflictMode string
+
+const (
+	ConflictModeReject ConflictMode = "reject"
+	ConflictModeSkip   ConflictMode = "skip"
+)
+
+// AllValues returns all ConflictMode values.
+func (ConflictMode) AllValues() []ConflictMode {
+	return []ConflictMode{
+		ConflictModeReject,
+		ConflictModeSkip,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ConflictMode) MarshalText() ([]byte, error) {
+	switch s {
+	case ConflictModeReject:
+		return []byte(s), nil
+	case ConflictModeSkip:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ConflictMode) UnmarshalText(data []byte) error {
+	switch ConflictMode(data) {
+	case ConflictModeReject:
+		*s = ConflictModeReject
+		return nil
+	case ConflictModeSkip:
+		*s = ConflictModeSkip
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Serializer for representing the currently authenticated user.
+// Ref: #/components/schemas/CurrentUser
+type CurrentUser struct {
+	ID uuid.UUID `json:"id"`
+	// Required. 100 characters or fewer. Letters, digits and @/./+/-/_ only.
+	Username string    `json:"username"`
+	Email    OptString `json:"email"`
+	// Designates whether the user can log into this admin site.
+	IsStaff   bool      `json:"is_staff"`
+	CreatedAt time.Time `json:"created_at"`
+	// API tokens issued to the user, newest first.
+	Tokens []TokenMetadata `json:"tokens"`
+}
+
+// GetID returns the value of ID.
+func (s *CurrentUser) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *CurrentUser) GetUsername() string {
+	return s.Username
+}
+
+// GetEmail returns the value of Email.
+func (s *CurrentUser) GetEmail() OptString {
+	return s.Email
+}
+
+// GetIsStaff returns the value of IsStaff.
+func (s *CurrentUser) GetIsStaff() bool {
+	return s.IsStaff
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CurrentUser) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetTokens returns the value of Tokens.
+func (s *CurrentUser) GetTokens() []TokenMetadata {
+	return s.Tokens
+}
+
+// SetID sets the value of ID.
+func (s *CurrentUser) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *CurrentUser) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetEmail sets the value of Email.
+func (s *CurrentUser) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetIsStaff sets the value of IsStaff.
+func (s *CurrentUser) SetIsStaff(val bool) {
+	s.IsStaff = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CurrentUser) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetTokens sets the value of Tokens.
+func (s *CurrentUser) SetTokens(val []TokenMetadata) {
+	s.Tokens = val
+}
+
+func (*CurrentUser) meRetrieveRes() {}
+
+// A grant letting its delegate book and manage reservations and series on behalf of the grantor.
+// Ref: #/components/schemas/DelegationGrant
+type DelegationGrant struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who granted delegation.
+	GrantorID uuid.UUID `json:"grantor_id"`
+	// ID of the user allowed to book and manage reservations on behalf of the grantor.
+	DelegateID uuid.UUID `json:"delegate_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *DelegationGrant) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetGrantorID returns the value of GrantorID.
+func (s *DelegationGrant) GetGrantorID() uuid.UUID {
+	return s.GrantorID
+}
+
+// GetDelegateID returns the value of DelegateID.
+func (s *DelegationGrant) GetDelegateID() uuid.UUID {
+	return s.DelegateID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DelegationGrant) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *DelegationGrant) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetGrantorID sets the value of GrantorID.
+func (s *DelegationGrant) SetGrantorID(val uuid.UUID) {
+	s.GrantorID = val
+}
+
+// SetDelegateID sets the value of DelegateID.
+func (s *DelegationGrant) SetDelegateID(val uuid.UUID) {
+	s.DelegateID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DelegationGrant) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*DelegationGrant) delegationGrantsCreateRes() {}
+
+// User to grant delegation to.
+// Ref: #/components/schemas/DelegationGrantInput
+type DelegationGrantInput struct {
+	// ID of the user allowed to book and manage reservations on behalf of the grantor.
+	DelegateID uuid.UUID `json:"delegate_id"`
+}
+
+// GetDelegateID returns the value of DelegateID.
+func (s *DelegationGrantInput) GetDelegateID() uuid.UUID {
+	return s.DelegateID
+}
+
+// SetDelegateID sets the value of DelegateID.
+func (s *DelegationGrantInput) SetDelegateID(val uuid.UUID) {
+	s.DelegateID = val
+}
+
+type DelegationGrantsCreateBadRequest ProblemDetails
+
+func (*DelegationGrantsCreateBadRequest) delegationGrantsCreateRes() {}
+
+type DelegationGrantsCreateConflict ProblemDetails
+
+func (*DelegationGrantsCreateConflict) delegationGrantsCreateRes() {}
+
+type DelegationGrantsCreateUnauthorized ProblemDetails
+
+func (*DelegationGrantsCreateUnauthorized) delegationGrantsCreateRes() {}
+
+// DelegationGrantsDestroyNoContent is response for DelegationGrantsDestroy operation.
+type DelegationGrantsDestroyNoContent struct{}
+
+func (*DelegationGrantsDestroyNoContent) delegationGrantsDestroyRes() {}
+
+type DelegationGrantsDestroyNotFound ProblemDetails
+
+func (*DelegationGrantsDestroyNotFound) delegationGrantsDestroyRes() {}
+
+type DelegationGrantsDestroyUnauthorized ProblemDetails
+
+func (*DelegationGrantsDestroyUnauthorized) delegationGrantsDestroyRes() {}
+
+type DelegationGrantsListOKApplicationJSON []DelegationGrant
+
+func (*DelegationGrantsListOKApplicationJSON) delegationGrantsListRes() {}
+
+// Which occurrences of a series an occurrence-level change applies to.
+// `this` changes only the selected occurrence, `this_and_following` splits the series at it and
+// `all` changes every upcoming occurrence.
+// Ref: #/components/schemas/EditScope
+type EditScope string
+
+const (
+	EditScopeThis             EditScope = "this"
+	EditScopeThisAndFollowing EditScope = "this_and_following"
+	EditScopeAll              EditScope = "all"
+)
+
+// AllValues returns all EditScope values.
+func (EditScope) AllValues() []EditScope {
+	return []EditScope{
+		EditScopeThis,
+		EditScopeThisAndFollowing,
+		EditScopeAll,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s EditScope) MarshalText() ([]byte, error) {
+	switch s {
+	case EditScopeThis:
+		return []byte(s), nil
+	case EditScopeThisAndFollowing:
+		return []byte(s), nil
+	case EditScopeAll:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *EditScope) UnmarshalText(data []byte) error {
+	switch EditScope(data) {
+	case EditScopeThis:
+		*s = EditScopeThis
+		return nil
+	case EditScopeThisAndFollowing:
+		*s = EditScopeThisAndFollowing
+		return nil
+	case EditScopeAll:
+		*s = EditScopeAll
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type EmailString string
+
+type FacilitiesAmenitiesUpdateBadRequest ProblemDetails
+
+func (*FacilitiesAmenitiesUpdateBadRequest) facilitiesAmenitiesUpdateRes() {}
+
+type FacilitiesAmenitiesUpdateForbidden ProblemDetails
+
+func (*FacilitiesAmenitiesUpdateForbidden) facilitiesAmenitiesUpdateRes() {}
+
+type FacilitiesAmenitiesUpdateNotFound ProblemDetails
+
+func (*FacilitiesAmenitiesUpdateNotFound) facilitiesAmenitiesUpdateRes() {}
+
+type FacilitiesAmenitiesUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesAmenitiesUpdateUnauthorized) facilitiesAmenitiesUpdateRes() {}
+
+type FacilitiesBlackoutsCreateBadRequest ProblemDetails
+
+func (*FacilitiesBlackoutsCreateBadRequest) facilitiesBlackoutsCreateRes() {}
+
+type FacilitiesBlackoutsCreateForbidden ProblemDetails
+
+func (*FacilitiesBlackoutsCreateForbidden) facilitiesBlackoutsCreateRes() {}
+
+type FacilitiesBlackoutsCreateNotFound ProblemDetails
+
+func (*FacilitiesBlackoutsCreateNotFound) facilitiesBlackoutsCreateRes() {}
+
+type FacilitiesBlackoutsCreateUnauthorized ProblemDetails
+
+func (*FacilitiesBlackoutsCreateUnauthorized) facilitiesBlackoutsCreateRes() {}
+
+type FacilitiesBlackoutsDestroyForbidden ProblemDetails
+
+func (*FacilitiesBlackoutsDestroyForbidden) facilitiesBlackoutsDestroyRes() {}
+
+// FacilitiesBlackoutsDestroyNoContent is response for FacilitiesBlackoutsDestroy operation.
+type FacilitiesBlackoutsDestroyNoContent struct{}
+
+func (*FacilitiesBlackoutsDestroyNoContent) facilitiesBlackoutsDestroyRes() {}
+
+type FacilitiesBlackoutsDestroyNotFound ProblemDetails
+
+func (*FacilitiesBlackoutsDestroyNotFound) facilitiesBlackoutsDestroyRes() {}
+
+type FacilitiesBlackoutsDestroyUnauthorized ProblemDetails
+
+func (*FacilitiesBlackoutsDestroyUnauthorized) facilitiesBlackoutsDestroyRes() {}
+
+type FacilitiesBlackoutsListOKApplicationJSON []Blackout
+
+func (*FacilitiesBlackoutsListOKApplicationJSON) facilitiesBlackoutsListRes() {}
+
+type FacilitiesBookingPolicyUpdateBadRequest ProblemDetails
+
+func (*FacilitiesBookingPolicyUpdateBadRequest) facilitiesBookingPolicyUpdateRes() {}
+
+type FacilitiesBookingPolicyUpdateForbidden ProblemDetails
+
+func (*FacilitiesBookingPolicyUpdateForbidden) facilitiesBookingPolicyUpdateRes() {}
+
+type FacilitiesBookingPolicyUpdateNotFound ProblemDetails
+
+func (*FacilitiesBookingPolicyUpdateNotFound) facilitiesBookingPolicyUpdateRes() {}
+
+type FacilitiesBookingPolicyUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesBookingPolicyUpdateUnauthorized) facilitiesBookingPolicyUpdateRes() {}
+
+type FacilitiesBookingQuotaUpdateBadRequest ProblemDetails
+
+func (*FacilitiesBookingQuotaUpdateBadRequest) facilitiesBookingQuotaUpdateRes() {}
+
+type FacilitiesBookingQuotaUpdateForbidden ProblemDetails
+
+func (*FacilitiesBookingQuotaUpdateForbidden) facilitiesBookingQuotaUpdateRes() {}
+
+type FacilitiesBookingQuotaUpdateNotFound ProblemDetails
+
+func (*FacilitiesBookingQuotaUpdateNotFound) facilitiesBookingQuotaUpdateRes() {}
+
+type FacilitiesBookingQuotaUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesBookingQuotaUpdateUnauthorized) facilitiesBookingQuotaUpdateRes() {}
+
+type FacilitiesCreateBadRequest ProblemDetails
+
+func (*FacilitiesCreateBadRequest) facilitiesCreateRes() {}
+
+type FacilitiesCreateForbidden ProblemDetails
+
+func (*FacilitiesCreateForbidden) facilitiesCreateRes() {}
+
+type FacilitiesCreateUnauthorized ProblemDetails
+
+func (*FacilitiesCreateUnauthorized) facilitiesCreateRes() {}
+
+type FacilitiesDestroyBadRequest ProblemDetails
+
+func (*FacilitiesDestroyBadRequest) facilitiesDestroyRes() {}
+
+type FacilitiesDestroyForbidden ProblemDetails
+
+func (*FacilitiesDestroyForbidden) facilitiesDestroyRes() {}
+
+// FacilitiesDestroyNoContent is response for FacilitiesDestroy operation.
+type FacilitiesDestroyNoContent struct{}
+
+func (*FacilitiesDestroyNoContent) facilitiesDestroyRes() {}
+
+type FacilitiesDestroyNotFound ProblemDetails
+
+func (*FacilitiesDestroyNotFound) facilitiesDestroyRes() {}
+
+type FacilitiesDestroyUnauthorized ProblemDetails
+
+func (*FacilitiesDestroyUnauthorized) facilitiesDestroyRes() {}
+
+type FacilitiesOpeningHoursOverridesDestroyForbidden ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesDestroyForbidden) facilitiesOpeningHoursOverridesDestroyRes() {}
+
+// FacilitiesOpeningHoursOverridesDestroyNoContent is response for FacilitiesOpeningHoursOverridesDestroy operation.
+type FacilitiesOpeningHoursOverridesDestroyNoContent struct{}
+
+func (*FacilitiesOpeningHoursOverridesDestroyNoContent) facilitiesOpeningHoursOverridesDestroyRes() {}
+
+type FacilitiesOpeningHoursOverridesDestroyNotFound ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesDestroyNotFound) facilitiesOpeningHoursOverridesDestroyRes() {}
+
+type FacilitiesOpeningHoursOverridesDestroyUnauthorized ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesDestroyUnauthorized) facilitiesOpeningHoursOverridesDestroyRes() {
+}
+
+type FacilitiesOpeningHoursOverridesListBadRequest ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesListBadRequest) facilitiesOpeningHoursOverridesListRes() {}
+
+type FacilitiesOpeningHoursOverridesListNotFound ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesListNotFound) facilitiesOpeningHoursOverridesListRes() {}
+
+type FacilitiesOpeningHoursOverridesListOKApplicationJSON []OpeningHoursOverride
+
+func (*FacilitiesOpeningHoursOverridesListOKApplicationJSON) facilitiesOpeningHoursOverridesListRes() {
+}
+
+type FacilitiesOpeningHoursOverridesUpdateBadRequest ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesUpdateBadRequest) facilitiesOpeningHoursOverridesUpdateRes() {}
+
+type FacilitiesOpeningHoursOverridesUpdateForbidden ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesUpdateForbidden) facilitiesOpeningHoursOverridesUpdateRes() {}
+
+type FacilitiesOpeningHoursOverridesUpdateNotFound ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesUpdateNotFound) facilitiesOpeningHoursOverridesUpdateRes() {}
+
+type FacilitiesOpeningHoursOverridesUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesOpeningHoursOverridesUpdateUnauthorized) facilitiesOpeningHoursOverridesUpdateRes() {
+}
+
+type FacilitiesOpeningHoursUpdateBadRequest ProblemDetails
+
+func (*FacilitiesOpeningHoursUpdateBadRequest) facilitiesOpeningHoursUpdateRes() {}
+
+type FacilitiesOpeningHoursUpdateForbidden ProblemDetails
+
+func (*FacilitiesOpeningHoursUpdateForbidden) facilitiesOpeningHoursUpdateRes() {}
+
+type FacilitiesOpeningHoursUpdateNotFound ProblemDetails
+
+func (*FacilitiesOpeningHoursUpdateNotFound) facilitiesOpeningHoursUpdateRes() {}
+
+type FacilitiesOpeningHoursUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesOpeningHoursUpdateUnauthorized) facilitiesOpeningHoursUpdateRes() {}
+
+type FacilitiesPartialUpdateBadRequest ProblemDetails
+
+func (*FacilitiesPartialUpdateBadRequest) facilitiesPartialUpdateRes() {}
+
+type FacilitiesPartialUpdateForbidden ProblemDetails
+
+func (*FacilitiesPartialUpdateForbidden) facilitiesPartialUpdateRes() {}
+
+type FacilitiesPartialUpdateNotFound ProblemDetails
+
+func (*FacilitiesPartialUpdateNotFound) facilitiesPartialUpdateRes() {}
+
+type FacilitiesPartialUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesPartialUpdateUnauthorized) facilitiesPartialUpdateRes() {}
+
+type FacilitiesUpdateBadRequest ProblemDetails
+
+func (*FacilitiesUpdateBadRequest) facilitiesUpdateRes() {}
+
+type FacilitiesUpdateForbidden ProblemDetails
+
+func (*FacilitiesUpdateForbidden) facilitiesUpdateRes() {}
+
+type FacilitiesUpdateNotFound ProblemDetails
+
+func (*FacilitiesUpdateNotFound) facilitiesUpdateRes() {}
+
+type FacilitiesUpdateUnauthorized ProblemDetails
+
+func (*FacilitiesUpdateUnauthorized) facilitiesUpdateRes() {}
+
+// Amenities a facility offers.
+// Ref: #/components/schemas/FacilityAmenities
+type FacilityAmenities struct {
+	// Slugs of the amenities the facility offers, ordered by slug in responses.
+	Amenities []string `json:"amenities"`
+}
+
+// GetAmenities returns the value of Amenities.
+func (s *FacilityAmenities) GetAmenities() []string {
+	return s.Amenities
+}
+
+// SetAmenities sets the value of Amenities.
+func (s *FacilityAmenities) SetAmenities(val []string) {
+	s.Amenities = val
+}
+
+func (*FacilityAmenities) facilitiesAmenitiesUpdateRes() {}
+
+// Free periods of an active facility within the searched range.
+// Ref: #/components/schemas/FacilityAvailability
+type FacilityAvailability struct {
+	// ID of the facility.
+	FacilityID int `json:"facility_id"`
+	// Display name of the facility.
+	Name string `json:"name"`
+	// Time zone in which the opening hours of the facility are evaluated.
+	TimeZone string `json:"time_zone"`
+	// Free periods ordered by start time.
+	Slots []AvailabilitySlot `json:"slots"`
+	// Blackout occurrences overlapping the searched range, ordered by start time.
+	Blackouts []BlackoutPeriod `json:"blackouts"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *FacilityAvailability) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetName returns the value of Name.
+func (s *FacilityAvailability) GetName() string {
+	return s.Name
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *FacilityAvailability) GetTimeZone() string {
+	return s.TimeZone
+}
+
+// GetSlots returns the value of Slots.
+func (s *FacilityAvailability) GetSlots() []AvailabilitySlot {
+	return s.Slots
+}
+
+// GetBlackouts returns the value of Blackouts.
+func (s *FacilityAvailability) GetBlackouts() []BlackoutPeriod {
+	return s.Blackouts
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *FacilityAvailability) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetName sets the value of Name.
+func (s *FacilityAvailability) SetName(val string) {
+	s.Name = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *FacilityAvailability) SetTimeZone(val string) {
+	s.TimeZone = val
+}
+
+// SetSlots sets the value of Slots.
+func (s *FacilityAvailability) SetSlots(val []AvailabilitySlot) {
+	s.Slots = val
+}
+
+// SetBlackouts sets the value of Blackouts.
+func (s *FacilityAvailability) SetBlackouts(val []BlackoutPeriod) {
+	s.Blackouts = val
+}
+
+// Booking policy of a facility.
+// Ref: #/components/schemas/FacilityBookingPolicy
+type FacilityBookingPolicy struct {
+	// Rules set on the facility itself.
+	Policy BookingPolicy `json:"policy"`
+	// Rules enforced on reservations of the facility, including those inherited from the organization-wide
+	// default.
+	Effective BookingPolicy `json:"effective"`
+}
+
+// GetPolicy returns the value of Policy.
+func (s *FacilityBookingPolicy) GetPolicy() BookingPolicy {
+	return s.Policy
+}
+
+// GetEffective returns the value of Effective.
+func (s *FacilityBookingPolicy) GetEffective() BookingPolicy {
+	return s.Effective
+}
+
+// SetPolicy sets the value of Policy.
+func (s *FacilityBookingPolicy) SetPolicy(val BookingPolicy) {
+	s.Policy = val
+}
+
+// SetEffective sets the value of Effective.
+func (s *FacilityBookingPolicy) SetEffective(val BookingPolicy) {
+	s.Effective = val
+}
+
+func (*FacilityBookingPolicy) facilitiesBookingPolicyRetrieveRes() {}
+func (*FacilityBookingPolicy) facilitiesBookingPolicyUpdateRes()   {}
+
+// A page of listed facilities.
+// Ref: #/components/schemas/FacilityPage
+type FacilityPage struct {
+	// Facilities of the page in the requested order.
+	Items []PublicFacility `json:"items"`
+	// Opaque cursor of the next page, passed as cursor to continue the listing. Omitted on the last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *FacilityPage) GetItems() []PublicFacility {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *FacilityPage) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *FacilityPage) SetItems(val []PublicFacility) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *FacilityPage) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*FacilityPage) facilitiesListRes() {}
+
+// Fields of a facility with the words matching the search query enclosed in <mark> and </mark>.
+// The text is not HTML-escaped.
+// Ref: #/components/schemas/FacilitySearchHighlights
+type FacilitySearchHighlights struct {
+	// Name of the facility.
+	Name string `json:"name"`
+	// Location of the facility. Omitted when it has none.
+	Location OptString `json:"location"`
+	// Up to two fragments of the description of the facility. Omitted when it has none.
+	Description OptString `json:"description"`
+}
+
+// GetName returns the value of Name.
+func (s *FacilitySearchHighlights) GetName() string {
+	return s.Name
+}
+
+// GetLocation returns the value of Location.
+func (s *FacilitySearchHighlights) GetLocation() OptString {
+	return s.Location
+}
+
+// GetDescription returns the value of Description.
+func (s *FacilitySearchHighlights) GetDescription() OptString {
+	return s.Description
+}
+
+// SetName sets the value of Name.
+func (s *FacilitySearchHighlights) SetName(val string) {
+	s.Name = val
+}
+
+// SetLocation sets the value of Location.
+func (s *FacilitySearchHighlights) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetDescription sets the value of Description.
+func (s *FacilitySearchHighlights) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// A facility matching a search query.
+// Ref: #/components/schemas/FacilitySearchResult
+type FacilitySearchResult struct {
+	// The matching facility.
+	Facility PublicFacility `json:"facility"`
+	// Relevance of the facility to the query. Higher is more relevant.
+	Rank float64 `json:"rank"`
+	// Fields of the facility matching the query.
+	Highlights FacilitySearchHighlights `json:"highlights"`
+}
+
+// GetFacility returns the value of Facility.
+func (s *FacilitySearchResult) GetFacility() PublicFacility {
+	return s.Facility
+}
+
+// GetRank returns the value of Rank.
+func (s *FacilitySearchResult) GetRank() float64 {
+	return s.Rank
+}
+
+// GetHighlights returns the value of Highlights.
+func (s *FacilitySearchResult) GetHighlights() FacilitySearchHighlights {
+	return s.Highlights
+}
+
+// SetFacility sets the value of Facility.
+func (s *FacilitySearchResult) SetFacility(val PublicFacility) {
+	s.Facility = val
+}
+
+// SetRank sets the value of Rank.
+func (s *FacilitySearchResult) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetHighlights sets the value of Highlights.
+func (s *FacilitySearchResult) SetHighlights(val FacilitySearchHighlights) {
+	s.Highlights = val
+}
+
+// Order of listed facilities.
+// `priority` orders them by priority, then by name, `name` by name alone. Facilities without a
+// priority come last.
+// Ref: #/components/schemas/FacilitySort
+type FacilitySort string
+
+const (
+	FacilitySortPriority FacilitySort = "priority"
+	FacilitySortName     FacilitySort = "name"
+)
+
+// AllValues returns all FacilitySort values.
+func (FacilitySort) AllValues() []FacilitySort {
+	return []FacilitySort{
+		FacilitySortPriority,
+		FacilitySortName,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FacilitySort) MarshalText() ([]byte, error) {
+	switch s {
+	case FacilitySortPriority:
+		return []byte(s), nil
+	case FacilitySortName:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FacilitySort) UnmarshalText(data []byte) error {
+	switch FacilitySort(data) {
+	case FacilitySortPriority:
+		*s = FacilitySortPriority
+		return nil
+	case FacilitySortName:
+		*s = FacilitySortName
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// A short-lived hold blocking a period of a facility for other reservations.
+// Ref: #/components/schemas/Hold
+type Hold struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who placed the hold.
+	UserID uuid.UUID `json:"user_id"`
+	// ID of the held facility.
+	FacilityID int `json:"facility_id"`
+	// Start of the held period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the held period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// Time zone of the facility.
+	TimeZone string `json:"time_zone"`
+	// starts_at in the time zone of the facility.
+	LocalStartsAt time.Time `json:"local_starts_at"`
+	// ends_at in the time zone of the facility.
+	LocalEndsAt time.Time `json:"local_ends_at"`
+	// Time the hold is released unless it is confirmed before.
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Hold) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Hold) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *Hold) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *Hold) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *Hold) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *Hold) GetTimeZone() string {
+	return s.TimeZone
+}
+
+// GetLocalStartsAt returns the value of LocalStartsAt.
+func (s *Hold) GetLocalStartsAt() time.Time {
+	return s.LocalStartsAt
+}
+
+// GetLocalEndsAt returns the value of LocalEndsAt.
+func (s *Hold) GetLocalEndsAt() time.Time {
+	return s.LocalEndsAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *Hold) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Hold) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Hold) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Hold) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *Hold) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *Hold) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *Hold) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *Hold) SetTimeZone(val string) {
+	s.TimeZone = val
+}
+
+// SetLocalStartsAt sets the value of LocalStartsAt.
+func (s *Hold) SetLocalStartsAt(val time.Time) {
+	s.LocalStartsAt = val
+}
+
+// SetLocalEndsAt sets the value of LocalEndsAt.
+func (s *Hold) SetLocalEndsAt(val time.Time) {
+	s.LocalEndsAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *Hold) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Hold) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*Hold) holdsCreateRes()   {}
+func (*Hold) holdsRetrieveRes() {}
+
+// Details of the reservation a hold is confirmed as.
+// Ref: #/components/schemas/HoldConfirmation
+type HoldConfirmation struct {
+	// Short summary of the purpose of the reservation.
+	Title string `json:"title"`
+	// Optional details of the reservation.
+	Description OptString `json:"description"`
+}
+
+// GetTitle returns the value of Title.
+func (s *HoldConfirmation) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *HoldConfirmation) GetDescription() OptString {
+	return s.Description
+}
+
+// SetTitle sets the value of Title.
+func (s *HoldConfirmation) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *HoldConfirmation) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// Period of a facility to hold while the details of the reservation are filled out.
+// Ref: #/components/schemas/HoldInput
+type HoldInput struct {
+	// ID of the held facility.
+	FacilityID int `json:"facility_id"`
+	// Start of the held period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the held period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// Seconds the hold blocks the period unless confirmed. Defaults to 300.
+	TTLSeconds OptInt32 `json:"ttl_seconds"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *HoldInput) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *HoldInput) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *HoldInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetTTLSeconds returns the value of TTLSeconds.
+func (s *HoldInput) GetTTLSeconds() OptInt32 {
+	return s.TTLSeconds
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *HoldInput) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *HoldInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *HoldInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetTTLSeconds sets the value of TTLSeconds.
+func (s *HoldInput) SetTTLSeconds(val OptInt32) {
+	s.TTLSeconds = val
+}
+
+type HoldsConfirmConflict ProblemDetails
+
+func (*HoldsConfirmConflict) holdsConfirmRes() {}
+
+type HoldsConfirmNotFound ProblemDetails
+
+func (*HoldsConfirmNotFound) holdsConfirmRes() {}
+
+type HoldsConfirmUnauthorized ProblemDetails
+
+func (*HoldsConfirmUnauthorized) holdsConfirmRes() {}
+
+type HoldsCreateBadRequest ProblemDetails
+
+func (*HoldsCreateBadRequest) holdsCreateRes() {}
+
+type HoldsCreateConflict ProblemDetails
+
+func (*HoldsCreateConflict) holdsCreateRes() {}
+
+type HoldsCreateUnauthorized ProblemDetails
+
+func (*HoldsCreateUnauthorized) holdsCreateRes() {}
+
+// HoldsDestroyNoContent is response for HoldsDestroy operation.
+type HoldsDestroyNoContent struct{}
+
+func (*HoldsDestroyNoContent) holdsDestroyRes() {}
+
+type HoldsDestroyNotFound ProblemDetails
+
+func (*HoldsDestroyNotFound) holdsDestroyRes() {}
+
+type HoldsDestroyUnauthorized ProblemDetails
+
+func (*HoldsDestroyUnauthorized) holdsDestroyRes() {}
+
+type HoldsRetrieveNotFound ProblemDetails
+
+func (*HoldsRetrieveNotFound) holdsRetrieveRes() {}
+
+type HoldsRetrieveUnauthorized ProblemDetails
+
+func (*HoldsRetrieveUnauthorized) holdsRetrieveRes() {}
+
+// A site, building or floor facilities are located in.
+// Ref: #/components/schemas/Location
+type Location struct {
+	ID uuid.UUID `json:"id"`
+	// Level of the location. Cannot be changed once created.
+	Kind LocationKind `json:"kind"`
+	// Display name of the location, unique within its parent.
+	Name string `json:"name"`
+	// ID of the location containing this one. Sites have no parent, the parent of a building is a site and
+	// the
+	// parent of a floor is a building.
+	ParentID OptUUID `json:"parent_id"`
+	// IANA time zone of the location, e.g. Asia/Tokyo. Inherited by the locations and facilities within it
+	// that
+	// set none.
+	TimeZone  OptString `json:"time_zone"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Location) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *Location) GetKind() LocationKind {
+	return s.Kind
+}
+
+// GetName returns the value of Name.
+func (s *Location) GetName() string {
+	return s.Name
+}
+
+// GetParentID returns the value of ParentID.
+func (s *Location) GetParentID() OptUUID {
+	return s.ParentID
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *Location) GetTimeZone() OptString {
+	return s.TimeZone
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Location) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Location) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Location) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *Location) SetKind(val LocationKind) {
+	s.Kind = val
+}
+
+// SetName sets the value of Name.
+func (s *Location) SetName(val string) {
+	s.Name = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *Location) SetParentID(val OptUUID) {
+	s.ParentID = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *Location) SetTimeZone(val OptString) {
+	s.TimeZone = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Location) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Location) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Location) locationsCreateRes()   {}
+func (*Location) locationsRetrieveRes() {}
+func (*Location) locationsUpdateRes()   {}
+
+// Level of a location in the hierarchy. Sites contain buildings and buildings contain floors.
+// Ref: #/components/schemas/LocationKind
+type LocationKind string
+
+const (
+	LocationKindSite     LocationKind = "site"
+	LocationKindBuilding LocationKind = "building"
+	LocationKindFloor    LocationKind = "floor"
+)
+
+// AllValues returns all LocationKind values.
+func (LocationKind) AllValues() []LocationKind {
+	return []LocationKind{
+		LocationKindSite,
+		LocationKindBuilding,
+		LocationKindFloor,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LocationKind) MarshalText() ([]byte, error) {
+	switch s {
+	case LocationKindSite:
+		return []byte(s), nil
+	case LocationKindBuilding:
+		return []byte(s), nil
+	case LocationKindFloor:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LocationKind) UnmarshalText(data []byte) error {
+	switch LocationKind(data) {
+	case LocationKindSite:
+		*s = LocationKindSite
+		return nil
+	case LocationKindBuilding:
+		*s = LocationKindBuilding
+		return nil
+	case LocationKindFloor:
+		*s = LocationKindFloor
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// A location together with the locations and facilities it contains.
+// Ref: #/components/schemas/LocationTreeNode
+type LocationTreeNode struct {
+	ID   uuid.UUID    `json:"id"`
+	Kind LocationKind `json:"kind"`
+	Name string       `json:"name"`
+	// Locations contained in this one, ordered by name.
+	Children []LocationTreeNode `json:"children"`
+	// IDs of the active facilities located directly in this location, ordered by priority and name.
+	FacilityIds []int `json:"facility_ids"`
+}
+
+// GetID returns the value of ID.
+func (s *LocationTreeNode) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *LocationTreeNode) GetKind() LocationKind {
+	return s.Kind
+}
+
+// GetName returns the value of Name.
+func (s *LocationTreeNode) GetName() string {
+	return s.Name
+}
+
+// GetChildren returns the value of Children.
+func (s *LocationTreeNode) GetChildren() []LocationTreeNode {
+	return s.Children
+}
+
+// GetFacilityIds returns the value of FacilityIds.
+func (s *LocationTreeNode) GetFacilityIds() []int {
+	return s.FacilityIds
+}
+
+// SetID sets the value of ID.
+func (s *LocationTreeNode) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *LocationTreeNode) SetKind(val LocationKind) {
+	s.Kind = val
+}
+
+// SetName sets the value of Name.
+func (s *LocationTreeNode) SetName(val string) {
+	s.Name = val
+}
+
+// SetChildren sets the value of Children.
+func (s *LocationTreeNode) SetChildren(val []LocationTreeNode) {
+	s.Children = val
+}
+
+// SetFacilityIds sets the value of FacilityIds.
+func (s *LocationTreeNode) SetFacilityIds(val []int) {
+	s.FacilityIds = val
+}
+
+type LocationsCreateBadRequest ProblemDetails
+
+func (*LocationsCreateBadRequest) locationsCreateRes() {}
+
+type LocationsCreateConflict ProblemDetails
+
+func (*LocationsCreateConflict) locationsCreateRes() {}
+
+type LocationsCreateForbidden ProblemDetails
+
+func (*LocationsCreateForbidden) locationsCreateRes() {}
+
+type LocationsCreateUnauthorized ProblemDetails
+
+func (*LocationsCreateUnauthorized) locationsCreateRes() {}
+
+type LocationsDestroyConflict ProblemDetails
+
+func (*LocationsDestroyConflict) locationsDestroyRes() {}
+
+type LocationsDestroyForbidden ProblemDetails
+
+func (*LocationsDestroyForbidden) locationsDestroyRes() {}
+
+// LocationsDestroyNoContent is response for LocationsDestroy operation.
+type LocationsDestroyNoContent struct{}
+
+func (*LocationsDestroyNoContent) locationsDestroyRes() {}
+
+type LocationsDestroyNotFound ProblemDetails
+
+func (*LocationsDestroyNotFound) locationsDestroyRes() {}
+
+type LocationsDestroyUnauthorized ProblemDetails
+
+func (*LocationsDestroyUnauthorized) locationsDestroyRes() {}
+
+type LocationsUpdateBadRequest ProblemDetails
+
+func (*LocationsUpdateBadRequest) locationsUpdateRes() {}
+
+type LocationsUpdateConflict ProblemDetails
+
+func (*LocationsUpdateConflict) locationsUpdateRes() {}
+
+type LocationsUpdateForbidden ProblemDetails
+
+func (*LocationsUpdateForbidden) locationsUpdateRes() {}
+
+type LocationsUpdateNotFound ProblemDetails
+
+func (*LocationsUpdateNotFound) locationsUpdateRes() {}
+
+type LocationsUpdateUnauthorized ProblemDetails
+
+func (*LocationsUpdateUnauthorized) locationsUpdateRes() {}
+
+// Period of a series occurrence.
+// Ref: #/components/schemas/OccurrencePeriod
+type OccurrencePeriod struct {
+	// Start of the occurrence (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the occurrence (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *OccurrencePeriod) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *OccurrencePeriod) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *OccurrencePeriod) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *OccurrencePeriod) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// Weekly opening hours of a facility. A facility without rules can be reserved at any time.
+// Ref: #/components/schemas/OpeningHours
+type OpeningHours struct {
+	// Opening periods ordered by weekday and opening time. A day without rules is closed.
+	Rules []OpeningHoursRule `json:"rules"`
+}
+
+// GetRules returns the value of Rules.
+func (s *OpeningHours) GetRules() []OpeningHoursRule {
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *OpeningHours) SetRules(val []OpeningHoursRule) {
+	s.Rules = val
+}
+
+func (*OpeningHours) facilitiesOpeningHoursRetrieveRes() {}
+func (*OpeningHours) facilitiesOpeningHoursUpdateRes()   {}
+
+// Opening hours of a facility on a specific date.
+// Ref: #/components/schemas/OpeningHoursOverride
+type OpeningHoursOverride struct {
+	// Date the override applies to.
+	Date time.Time `json:"date"`
+	// Opening time (inclusive). Omit together with closes_at to close the facility for the whole day.
+	OpensAt OptTime `json:"opens_at"`
+	// Closing time (exclusive). 00:00:00 closes at the end of the day.
+	ClosesAt OptTime `json:"closes_at"`
+	// Optional explanation shown to users, e.g. a public holiday.
+	Reason    OptString `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetDate returns the value of Date.
+func (s *OpeningHoursOverride) GetDate() time.Time {
+	return s.Date
+}
+
+// GetOpensAt returns the value of OpensAt.
+func (s *OpeningHoursOverride) GetOpensAt() OptTime {
+	return s.OpensAt
+}
+
+// GetClosesAt returns the value of ClosesAt.
+func (s *OpeningHoursOverride) GetClosesAt() OptTime {
+	return s.ClosesAt
+}
+
+// GetReason returns the value of Reason.
+func (s *OpeningHoursOverride) GetReason() OptString {
+	return s.Reason
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OpeningHoursOverride) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OpeningHoursOverride) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetDate sets the value of Date.
+func (s *OpeningHoursOverride) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetOpensAt sets the value of OpensAt.
+func (s *OpeningHoursOverride) SetOpensAt(val OptTime) {
+	s.OpensAt = val
+}
+
+// SetClosesAt sets the value of ClosesAt.
+func (s *OpeningHoursOverride) SetClosesAt(val OptTime) {
+	s.ClosesAt = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OpeningHoursOverride) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OpeningHoursOverride) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OpeningHoursOverride) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*OpeningHoursOverride) facilitiesOpeningHoursOverridesUpdateRes() {}
+
+// Opening hours of a facility on a specific date, replacing its weekly rules for that day.
+// Ref: #/components/schemas/OpeningHoursOverrideInput
+type OpeningHoursOverrideInput struct {
+	// Opening time (inclusive). Omit together with closes_at to close the facility for the whole day.
+	OpensAt OptTime `json:"opens_at"`
+	// Closing time (exclusive). 00:00:00 closes at the end of the day.
+	ClosesAt OptTime `json:"closes_at"`
+	// Optional explanation shown to users, e.g. a public holiday.
+	Reason OptString `json:"reason"`
+}
+
+// GetOpensAt returns the value of OpensAt.
+func (s *OpeningHoursOverrideInput) GetOpensAt() OptTime {
+	return s.OpensAt
+}
+
+// GetClosesAt returns the value of ClosesAt.
+func (s *OpeningHoursOverrideInput) GetClosesAt() OptTime {
+	return s.ClosesAt
+}
+
+// GetReason returns the value of Reason.
+func (s *OpeningHoursOverrideInput) GetReason() OptString {
+	return s.Reason
+}
+
+// SetOpensAt sets the value of OpensAt.
+func (s *OpeningHoursOverrideInput) SetOpensAt(val OptTime) {
+	s.OpensAt = val
+}
+
+// SetClosesAt sets the value of ClosesAt.
+func (s *OpeningHoursOverrideInput) SetClosesAt(val OptTime) {
+	s.ClosesAt = val
+}
+
+// SetReason sets the value of Reason.
+func (s *OpeningHoursOverrideInput) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// Period in which a facility can be reserved on a day of the week.
+// Ref: #/components/schemas/OpeningHoursRule
+type OpeningHoursRule struct {
+	// Day of the week, from 0 (Sunday) to 6 (Saturday).
+	Weekday int32 `json:"weekday"`
+	// Opening time (inclusive).
+	OpensAt time.Time `json:"opens_at"`
+	// Closing time (exclusive). 00:00:00 closes at the end of the day.
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// GetWeekday returns the value of Weekday.
+func (s *OpeningHoursRule) GetWeekday() int32 {
+	return s.Weekday
+}
+
+// GetOpensAt returns the value of OpensAt.
+func (s *OpeningHoursRule) GetOpensAt() time.Time {
+	return s.OpensAt
+}
+
+// GetClosesAt returns the value of ClosesAt.
+func (s *OpeningHoursRule) GetClosesAt() time.Time {
+	return s.ClosesAt
+}
+
+// SetWeekday sets the value of Weekday.
+func (s *OpeningHoursRule) SetWeekday(val int32) {
+	s.Weekday = val
+}
+
+// SetOpensAt sets the value of OpensAt.
+func (s *OpeningHoursRule) SetOpensAt(val time.Time) {
+	s.OpensAt = val
+}
+
+// SetClosesAt sets the value of ClosesAt.
+func (s *OpeningHoursRule) SetClosesAt(val time.Time) {
+	s.ClosesAt = val
+}
+
+// NewOptAdminUserMergePatchUpdateEmail returns new OptAdminUserMergePatchUpdateEmail with value set to v.
+func NewOptAdminUserMergePatchUpdateEmail(v AdminUserMergePatchUpdateEmail) OptAdminUserMergePatchUpdateEmail {
+	return OptAdminUserMergePatchUpdateEmail{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAdminUserMergePatchUpdateEmail is optional AdminUserMergePatchUpdateEmail.
+type OptAdminUserMergePatchUpdateEmail struct {
+	Value AdminUserMergePatchUpdateEmail
+	Set   bool
+}
+
+// IsSet returns true if OptAdminUserMergePatchUpdateEmail was set.
+func (o OptAdminUserMergePatchUpdateEmail) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAdminUserMergePatchUpdateEmail) Reset() {
+	var v AdminUserMergePatchUpdateEmail
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAdminUserMergePatchUpdateEmail) SetTo(v AdminUserMergePatchUpdateEmail) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAdminUserMergePatchUpdateEmail) Get() (v AdminUserMergePatchUpdateEmail, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAdminUserMergePatchUpdateEmail) Or(d AdminUserMergePatchUpdateEmail) AdminUserMergePatchUpdateEmail {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAdminUserMergePatchUpdateIsStaff returns new OptAdminUserMergePatchUpdateIsStaff with value set to v.
+func NewOptAdminUserMergePatchUpdateIsStaff(v AdminUserMergePatchUpdateIsStaff) OptAdminUserMergePatchUpdateIsStaff {
+	return OptAdminUserMergePatchUpdateIsStaff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAdminUserMergePatchUpdateIsStaff is optional AdminUserMergePatchUpdateIsStaff.
+type OptAdminUserMergePatchUpdateIsStaff struct {
+	Value AdminUserMergePatchUpdateIsStaff
+	Set   bool
+}
+
+// IsSet returns true if OptAdminUserMergePatchUpdateIsStaff was set.
+func (o OptAdminUserMergePatchUpdateIsStaff) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAdminUserMergePatchUpdateIsStaff) Reset() {
+	var v AdminUserMergePatchUpdateIsStaff
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAdminUserMergePatchUpdateIsStaff) SetTo(v AdminUserMergePatchUpdateIsStaff) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAdminUserMergePatchUpdateIsStaff) Get() (v AdminUserMergePatchUpdateIsStaff, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAdminUserMergePatchUpdateIsStaff) Or(d AdminUserMergePatchUpdateIsStaff) AdminUserMergePatchUpdateIsStaff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptConflictMode returns new OptConflictMode with value set to v.
+func NewOptConflictMode(v ConflictMode) OptConflictMode {
+	return OptConflictMode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptConflictMode is optional ConflictMode.
+type OptConflictMode struct {
+	Value ConflictMode
+	Set   bool
+}
+
+// IsSet returns true if OptConflictMode was set.
+func (o OptConflictMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptConflictMode) Reset() {
+	var v ConflictMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptConflictMode) SetTo(v ConflictMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptConflictMode) Get() (v ConflictMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptConflictMode) Or(d ConflictMode) ConflictMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEditScope returns new OptEditScope with value set to v.
+func NewOptEditScope(v EditScope) OptEditScope {
+	return OptEditScope{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEditScope is optional EditScope.
+type OptEditScope struct {
+	Value EditScope
+	Set   bool
+}
+
+// IsSet returns true if OptEditScope was set.
+func (o OptEditScope) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEditScope) Reset() {
+	var v EditScope
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEditScope) SetTo(v EditScope) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEditScope) Get() (v EditScope, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEditScope) Or(d EditScope) EditScope {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEmailString returns new OptEmailString with value set to v.
+func NewOptEmailString(v EmailString) OptEmailString {
+	return OptEmailString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEmailString is optional EmailString.
+type OptEmailString struct {
+	Value EmailString
+	Set   bool
+}
+
+// IsSet returns true if OptEmailString was set.
+func (o OptEmailString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEmailString) Reset() {
+	var v EmailString
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEmailString) SetTo(v EmailString) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEmailString) Get() (v EmailString, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEmailString) Or(d EmailString) EmailString {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFacilitySort returns new OptFacilitySort with value set to v.
+func NewOptFacilitySort(v FacilitySort) OptFacilitySort {
+	return OptFacilitySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFacilitySort is optional FacilitySort.
+type OptFacilitySort struct {
+	Value FacilitySort
+	Set   bool
+}
+
+// IsSet returns true if OptFacilitySort was set.
+func (o OptFacilitySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFacilitySort) Reset() {
+	var v FacilitySort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFacilitySort) SetTo(v FacilitySort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFacilitySort) Get() (v FacilitySort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFacilitySort) Or(d FacilitySort) FacilitySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateCapacity returns new OptPublicFacilityMergePatchUpdateCapacity with value set to v.
+func NewOptPublicFacilityMergePatchUpdateCapacity(v PublicFacilityMergePatchUpdateCapacity) OptPublicFacilityMergePatchUpdateCapacity {
+	return OptPublicFacilityMergePatchUpdateCapacity{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateCapacity is optional PublicFacilityMergePatchUpdateCapacity.
+type OptPublicFacilityMergePatchUpdateCapacity struct {
+	Value PublicFacilityMergePatchUpdateCapacity
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateCapacity was set.
+func (o OptPublicFacilityMergePatchUpdateCapacity) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateCapacity) Reset() {
+	var v PublicFacilityMergePatchUpdateCapacity
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateCapacity) SetTo(v PublicFacilityMergePatchUpdateCapacity) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateCapacity) Get() (v PublicFacilityMergePatchUpdateCapacity, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateCapacity) Or(d PublicFacilityMergePatchUpdateCapacity) PublicFacilityMergePatchUpdateCapacity {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateCheckInGraceMinutes returns new OptPublicFacilityMergePatchUpdateCheckInGraceMinutes with value set to v.
+func NewOptPublicFacilityMergePatchUpdateCheckInGraceMinutes(v PublicFacilityMergePatchUpdateCheckInGraceMinutes) OptPublicFacilityMergePatchUpdateCheckInGraceMinutes {
+	return OptPublicFacilityMergePatchUpdateCheckInGraceMinutes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateCheckInGraceMinutes is optional PublicFacilityMergePatchUpdateCheckInGraceMinutes.
+type OptPublicFacilityMergePatchUpdateCheckInGraceMinutes struct {
+	Value PublicFacilityMergePatchUpdateCheckInGraceMinutes
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateCheckInGraceMinutes was set.
+func (o OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) Reset() {
+	var v PublicFacilityMergePatchUpdateCheckInGraceMinutes
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) SetTo(v PublicFacilityMergePatchUpdateCheckInGraceMinutes) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) Get() (v PublicFacilityMergePatchUpdateCheckInGraceMinutes, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) Or(d PublicFacilityMergePatchUpdateCheckInGraceMinutes) PublicFacilityMergePatchUpdateCheckInGraceMinutes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateDescription returns new OptPublicFacilityMergePatchUpdateDescription with value set to v.
+func NewOptPublicFacilityMergePatchUpdateDescription(v PublicFacilityMergePatchUpdateDescription) OptPublicFacilityMergePatchUpdateDescription {
+	return OptPublicFacilityMergePatchUpdateDescription{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateDescription is optional PublicFacilityMergePatchUpdateDescription.
+type OptPublicFacilityMergePatchUpdateDescription struct {
+	Value PublicFacilityMergePatchUpdateDescription
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateDescription was set.
+func (o OptPublicFacilityMergePatchUpdateDescription) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateDescription) Reset() {
+	var v PublicFacilityMergePatchUpdateDescription
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateDescription) SetTo(v PublicFacilityMergePatchUpdateDescription) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateDescription) Get() (v PublicFacilityMergePatchUpdateDescription, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateDescription) Or(d PublicFacilityMergePatchUpdateDescription) PublicFacilityMergePatchUpdateDescription {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateIsActive returns new OptPublicFacilityMergePatchUpdateIsActive with value set to v.
+func NewOptPublicFacilityMergePatchUpdateIsActive(v PublicFacilityMergePatchUpdateIsActive) OptPublicFacilityMergePatchUpdateIsActive {
+	return OptPublicFacilityMergePatchUpdateIsActive{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateIsActive is optional PublicFacilityMergePatchUpdateIsActive.
+type OptPublicFacilityMergePatchUpdateIsActive struct {
+	Value PublicFacilityMergePatchUpdateIsActive
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateIsActive was set.
+func (o OptPublicFacilityMergePatchUpdateIsActive) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateIsActive) Reset() {
+	var v PublicFacilityMergePatchUpdateIsActive
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateIsActive) SetTo(v PublicFacilityMergePatchUpdateIsActive) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateIsActive) Get() (v PublicFacilityMergePatchUpdateIsActive, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateIsActive) Or(d PublicFacilityMergePatchUpdateIsActive) PublicFacilityMergePatchUpdateIsActive {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateLocation returns new OptPublicFacilityMergePatchUpdateLocation with value set to v.
+func NewOptPublicFacilityMergePatchUpdateLocation(v PublicFacilityMergePatchUpdateLocation) OptPublicFacilityMergePatchUpdateLocation {
+	return OptPublicFacilityMergePatchUpdateLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateLocation is optional PublicFacilityMergePatchUpdateLocation.
+type OptPublicFacilityMergePatchUpdateLocation struct {
+	Value PublicFacilityMergePatchUpdateLocation
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateLocation was set.
+func (o OptPublicFacilityMergePatchUpdateLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateLocation) Reset() {
+	var v PublicFacilityMergePatchUpdateLocation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateLocation) SetTo(v PublicFacilityMergePatchUpdateLocation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateLocation) Get() (v PublicFacilityMergePatchUpdateLocation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateLocation) Or(d PublicFacilityMergePatchUpdateLocation) PublicFacilityMergePatchUpdateLocation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateLocationID returns new OptPublicFacilityMergePatchUpdateLocationID with value set to v.
+func NewOptPublicFacilityMergePatchUpdateLocationID(v PublicFacilityMergePatchUpdateLocationID) OptPublicFacilityMergePatchUpdateLocationID {
+	return OptPublicFacilityMergePatchUpdateLocationID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateLocationID is optional PublicFacilityMergePatchUpdateLocationID.
+type OptPublicFacilityMergePatchUpdateLocationID struct {
+	Value PublicFacilityMergePatchUpdateLocationID
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateLocationID was set.
+func (o OptPublicFacilityMergePatchUpdateLocationID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateLocationID) Reset() {
+	var v PublicFacilityMergePatchUpdateLocationID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateLocationID) SetTo(v PublicFacilityMergePatchUpdateLocationID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateLocationID) Get() (v PublicFacilityMergePatchUpdateLocationID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateLocationID) Or(d PublicFacilityMergePatchUpdateLocationID) PublicFacilityMergePatchUpdateLocationID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdatePriority returns new OptPublicFacilityMergePatchUpdatePriority with value set to v.
+func NewOptPublicFacilityMergePatchUpdatePriority(v PublicFacilityMergePatchUpdatePriority) OptPublicFacilityMergePatchUpdatePriority {
+	return OptPublicFacilityMergePatchUpdatePriority{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdatePriority is optional PublicFacilityMergePatchUpdatePriority.
+type OptPublicFacilityMergePatchUpdatePriority struct {
+	Value PublicFacilityMergePatchUpdatePriority
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdatePriority was set.
+func (o OptPublicFacilityMergePatchUpdatePriority) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdatePriority) Reset() {
+	var v PublicFacilityMergePatchUpdatePriority
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdatePriority) SetTo(v PublicFacilityMergePatchUpdatePriority) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdatePriority) Get() (v PublicFacilityMergePatchUpdatePriority, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdatePriority) Or(d PublicFacilityMergePatchUpdatePriority) PublicFacilityMergePatchUpdatePriority {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateRequiresApproval returns new OptPublicFacilityMergePatchUpdateRequiresApproval with value set to v.
+func NewOptPublicFacilityMergePatchUpdateRequiresApproval(v PublicFacilityMergePatchUpdateRequiresApproval) OptPublicFacilityMergePatchUpdateRequiresApproval {
+	return OptPublicFacilityMergePatchUpdateRequiresApproval{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateRequiresApproval is optional PublicFacilityMergePatchUpdateRequiresApproval.
+type OptPublicFacilityMergePatchUpdateRequiresApproval struct {
+	Value PublicFacilityMergePatchUpdateRequiresApproval
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateRequiresApproval was set.
+func (o OptPublicFacilityMergePatchUpdateRequiresApproval) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateRequiresApproval) Reset() {
+	var v PublicFacilityMergePatchUpdateRequiresApproval
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateRequiresApproval) SetTo(v PublicFacilityMergePatchUpdateRequiresApproval) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateRequiresApproval) Get() (v PublicFacilityMergePatchUpdateRequiresApproval, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateRequiresApproval) Or(d PublicFacilityMergePatchUpdateRequiresApproval) PublicFacilityMergePatchUpdateRequiresApproval {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateSetupBufferMinutes returns new OptPublicFacilityMergePatchUpdateSetupBufferMinutes with value set to v.
+func NewOptPublicFacilityMergePatchUpdateSetupBufferMinutes(v PublicFacilityMergePatchUpdateSetupBufferMinutes) OptPublicFacilityMergePatchUpdateSetupBufferMinutes {
+	return OptPublicFacilityMergePatchUpdateSetupBufferMinutes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateSetupBufferMinutes is optional PublicFacilityMergePatchUpdateSetupBufferMinutes.
+type OptPublicFacilityMergePatchUpdateSetupBufferMinutes struct {
+	Value PublicFacilityMergePatchUpdateSetupBufferMinutes
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateSetupBufferMinutes was set.
+func (o OptPublicFacilityMergePatchUpdateSetupBufferMinutes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateSetupBufferMinutes) Reset() {
+	var v PublicFacilityMergePatchUpdateSetupBufferMinutes
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateSetupBufferMinutes) SetTo(v PublicFacilityMergePatchUpdateSetupBufferMinutes) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateSetupBufferMinutes) Get() (v PublicFacilityMergePatchUpdateSetupBufferMinutes, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateSetupBufferMinutes) Or(d PublicFacilityMergePatchUpdateSetupBufferMinutes) PublicFacilityMergePatchUpdateSetupBufferMinutes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateTeardownBufferMinutes returns new OptPublicFacilityMergePatchUpdateTeardownBufferMinutes with value set to v.
+func NewOptPublicFacilityMergePatchUpdateTeardownBufferMinutes(v PublicFacilityMergePatchUpdateTeardownBufferMinutes) OptPublicFacilityMergePatchUpdateTeardownBufferMinutes {
+	return OptPublicFacilityMergePatchUpdateTeardownBufferMinutes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateTeardownBufferMinutes is optional PublicFacilityMergePatchUpdateTeardownBufferMinutes.
+type OptPublicFacilityMergePatchUpdateTeardownBufferMinutes struct {
+	Value PublicFacilityMergePatchUpdateTeardownBufferMinutes
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateTeardownBufferMinutes was set.
+func (o OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) Reset() {
+	var v PublicFacilityMergePatchUpdateTeardownBufferMinutes
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) SetTo(v PublicFacilityMergePatchUpdateTeardownBufferMinutes) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) Get() (v PublicFacilityMergePatchUpdateTeardownBufferMinutes, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) Or(d PublicFacilityMergePatchUpdateTeardownBufferMinutes) PublicFacilityMergePatchUpdateTeardownBufferMinutes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPublicFacilityMergePatchUpdateTimeZone returns new OptPublicFacilityMergePatchUpdateTimeZone with value set to v.
+func NewOptPublicFacilityMergePatchUpdateTimeZone(v PublicFacilityMergePatchUpdateTimeZone) OptPublicFacilityMergePatchUpdateTimeZone {
+	return OptPublicFacilityMergePatchUpdateTimeZone{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPublicFacilityMergePatchUpdateTimeZone is optional PublicFacilityMergePatchUpdateTimeZone.
+type OptPublicFacilityMergePatchUpdateTimeZone struct {
+	Value PublicFacilityMergePatchUpdateTimeZone
+	Set   bool
+}
+
+// IsSet returns true if OptPublicFacilityMergePatchUpdateTimeZone was set.
+func (o OptPublicFacilityMergePatchUpdateTimeZone) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPublicFacilityMergePatchUpdateTimeZone) Reset() {
+	var v PublicFacilityMergePatchUpdateTimeZone
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPublicFacilityMergePatchUpdateTimeZone) SetTo(v PublicFacilityMergePatchUpdateTimeZone) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPublicFacilityMergePatchUpdateTimeZone) Get() (v PublicFacilityMergePatchUpdateTimeZone, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPublicFacilityMergePatchUpdateTimeZone) Or(d PublicFacilityMergePatchUpdateTimeZone) PublicFacilityMergePatchUpdateTimeZone {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTime returns new OptTime with value set to v.
+func NewOptTime(v time.Time) OptTime {
+	return OptTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTime is optional time.Time.
+type OptTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptTime was set.
+func (o OptTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/ProblemDetails
+type ProblemDetails struct {
+	// A URI reference [RFC3986] that identifies the problem type.
+	Type OptString `json:"type"`
+	// A short, human-readable summary of the problem type.
+	Title OptString `json:"title"`
+	// The HTTP status code for this occurrence of the problem.
+	Status OptInt `json:"status"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail OptString `json:"detail"`
+	// A URI reference that identifies the specific occurrence of the problem.
+	Instance OptString `json:"instance"`
+	// Every booking policy rule the request violates. Only returned when a reservation is rejected by the
+	// booking policy of its facility.
+	Violations []BookingPolicyViolation `json:"violations"`
+	// Index of the bundle component the problem applies to, starting at 0. Only returned when a
+	// reservation bundle
+	// is rejected because of one of its components.
+	Component OptInt `json:"component"`
+}
+
+// GetType returns the value of Type.
+func (s *ProblemDetails) GetType() OptString {
+	return s.Type
+}
+
+// GetTitle returns the value of Title.
+func (s *ProblemDetails) GetTitle() OptString {
+	return s.Title
+}
+
+// GetStatus returns the value of Status.
+func (s *ProblemDetails) GetStatus() OptInt {
+	return s.Status
+}
+
+// GetDetail returns the value of Detail.
+func (s *ProblemDetails) GetDetail() OptString {
+	return s.Detail
+}
+
+// GetInstance returns the value of Instance.
+func (s *ProblemDetails) GetInstance() OptString {
+	return s.Instance
+}
+
+// GetViolations returns the value of Violations.
+func (s *ProblemDetails) GetViolations() []BookingPolicyViolation {
+	return s.Violations
+}
+
+// GetComponent returns the value of Component.
+func (s *ProblemDetails) GetComponent() OptInt {
+	return s.Component
+}
+
+// SetType sets the value of Type.
+func (s *ProblemDetails) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ProblemDetails) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ProblemDetails) SetStatus(val OptInt) {
+	s.Status = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *ProblemDetails) SetDetail(val OptString) {
+	s.Detail = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *ProblemDetails) SetInstance(val OptString) {
+	s.Instance = val
+}
+
+// SetViolations sets the value of Violations.
+func (s *ProblemDetails) SetViolations(val []BookingPolicyViolation) {
+	s.Violations = val
+}
+
+// SetComponent sets the value of Component.
+func (s *ProblemDetails) SetComponent(val OptInt) {
+	s.Component = val
+}
+
+func (*ProblemDetails) amenitiesRetrieveRes()               {}
+func (*ProblemDetails) availabilityListRes()                {}
+func (*ProblemDetails) delegationGrantsListRes()            {}
+func (*ProblemDetails) facilitiesBlackoutsListRes()         {}
+func (*ProblemDetails) facilitiesBlackoutsRetrieveRes()     {}
+func (*ProblemDetails) facilitiesBookingPolicyRetrieveRes() {}
+func (*ProblemDetails) facilitiesBookingQuotaRetrieveRes()  {}
+func (*ProblemDetails) facilitiesListRes()                  {}
+func (*ProblemDetails) facilitiesOpeningHoursRetrieveRes()  {}
+func (*ProblemDetails) facilitiesRetrieveRes()              {}
+func (*ProblemDetails) locationsRetrieveRes()               {}
+func (*ProblemDetails) meRetrieveRes()                      {}
+func (*ProblemDetails) reservationBundlesListRes()          {}
+func (*ProblemDetails) reservationSeriesListRes()           {}
+
+// Ref: #/components/schemas/PublicFacility
+type PublicFacility struct {
+	ID int `json:"id"`
+	// Display name of the facility. Visible to users.
+	Name string `json:"name"`
+	// Optional description of the facility, including usage rules or details.
+	Description OptString `json:"description"`
+	// Physical location or address of the facility.
+	Location OptString `json:"location"`
+	// ID of the site, building or floor the facility is located in. Omit for an unplaced facility.
+	LocationID OptUUID `json:"location_id"`
+	// IANA time zone of the facility, e.g. Europe/Berlin. Omit to inherit the time zone of its location.
+	TimeZone OptString `json:"time_zone"`
+	// Time zone in which opening hours, booking policies, quotas and local timestamps of the facility are
+	// evaluated: its own time_zone, else that of the nearest location containing it that sets one, else
+	// UTC.
+	EffectiveTimeZone OptString `json:"effective_time_zone"`
+	// Display priority. Lower numbers appear earlier in sorted lists.
+	Priority OptInt64 `json:"priority"`
+	// Set to false to disable this facility from public listing or reservation.
+	IsActive OptBool `json:"is_active"`
+	// Minutes before each reservation during which the facility is blocked for setup.
+	// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+	SetupBufferMinutes OptInt32 `json:"setup_buffer_minutes"`
+	// Minutes after each reservation during which the facility is blocked for teardown or cleaning.
+	// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+	TeardownBufferMinutes OptInt32 `json:"teardown_buffer_minutes"`
+	// Set to true to require staff approval of reservations made by other users.
+	// Such reservations are requested as pending and hold their period until approved or rejected.
+	RequiresApproval OptBool `json:"requires_approval"`
+	// Minutes after the start of a reservation by which it has to be checked in.
+	// Reservations not checked in by then are released as no-shows. Omit to not require check-in.
+	CheckInGraceMinutes OptInt32 `json:"check_in_grace_minutes"`
+	// Maximum number of people per reservation, counting its owner and attendees. Omit for no limit.
+	// Changes apply to reservations made or updated afterwards.
+	Capacity OptInt32 `json:"capacity"`
+	// Slugs of the amenities of the facility ordered by slug. Changed through its amenities endpoint.
+	Amenities []string    `json:"amenities"`
+	CreatedAt OptDateTime `json:"created_at"`
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *PublicFacility) GetID() int {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *PublicFacility) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *PublicFacility) GetDescription() OptString {
+	return s.Description
+}
+
+// GetLocation returns the value of Location.
+func (s *PublicFacility) GetLocation() OptString {
+	return s.Location
+}
+
+// GetLocationID returns the value of LocationID.
+func (s *PublicFacility) GetLocationID() OptUUID {
+	return s.LocationID
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *PublicFacility) GetTimeZone() OptString {
+	return s.TimeZone
+}
+
+// GetEffectiveTimeZone returns the value of EffectiveTimeZone.
+func (s *PublicFacility) GetEffectiveTimeZone() OptString {
+	return s.EffectiveTimeZone
+}
+
+// GetPriority returns the value of Priority.
+func (s *PublicFacility) GetPriority() OptInt64 {
+	return s.Priority
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *PublicFacility) GetIsActive() OptBool {
+	return s.IsActive
+}
+
+// GetSetupBufferMinutes returns the value of SetupBufferMinutes.
+func (s *PublicFacility) GetSetupBufferMinutes() OptInt32 {
+	return s.SetupBufferMinutes
+}
+
+// GetTeardownBufferMinutes returns the value of TeardownBufferMinutes.
+func (s *PublicFacility) GetTeardownBufferMinutes() OptInt32 {
+	return s.TeardownBufferMinutes
+}
+
+// GetRequiresApproval returns the value of RequiresApproval.
+func (s *PublicFacility) GetRequiresApproval() OptBool {
+	return s.RequiresApproval
+}
+
+// GetCheckInGraceMinutes returns the value of CheckInGraceMinutes.
+func (s *PublicFacility) GetCheckInGraceMinutes() OptInt32 {
+	return s.CheckInGraceMinutes
+}
+
+// GetCapacity returns the value of Capacity.
+func (s *PublicFacility) GetCapacity() OptInt32 {
+	return s.Capacity
+}
+
+// GetAmenities returns the value of Amenities.
+func (s *PublicFacility) GetAmenities() []string {
+	return s.Amenities
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PublicFacility) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *PublicFacility) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *PublicFacility) SetID(val int) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *PublicFacility) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PublicFacility) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetLocation sets the value of Location.
+func (s *PublicFacility) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetLocationID sets the value of LocationID.
+func (s *PublicFacility) SetLocationID(val OptUUID) {
+	s.LocationID = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *PublicFacility) SetTimeZone(val OptString) {
+	s.TimeZone = val
+}
+
+// SetEffectiveTimeZone sets the value of EffectiveTimeZone.
+func (s *PublicFacility) SetEffectiveTimeZone(val OptString) {
+	s.EffectiveTimeZone = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *PublicFacility) SetPriority(val OptInt64) {
+	s.Priority = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *PublicFacility) SetIsActive(val OptBool) {
+	s.IsActive = val
+}
+
+// SetSetupBufferMinutes sets the value of SetupBufferMinutes.
+func (s *PublicFacility) SetSetupBufferMinutes(val OptInt32) {
+	s.SetupBufferMinutes = val
+}
+
+// SetTeardownBufferMinutes sets the value of TeardownBufferMinutes.
+func (s *PublicFacility) SetTeardownBufferMinutes(val OptInt32) {
+	s.TeardownBufferMinutes = val
+}
+
+// SetRequiresApproval sets the value of RequiresApproval.
+func (s *PublicFacility) SetRequiresApproval(val OptBool) {
+	s.RequiresApproval = val
+}
+
+// SetCheckInGraceMinutes sets the value of CheckInGraceMinutes.
+func (s *PublicFacility) SetCheckInGraceMinutes(val OptInt32) {
+	s.CheckInGraceMinutes = val
+}
+
+// SetCapacity sets the value of Capacity.
+func (s *PublicFacility) SetCapacity(val OptInt32) {
+	s.Capacity = val
+}
+
+// SetAmenities sets the value of Amenities.
+func (s *PublicFacility) SetAmenities(val []string) {
+	s.Amenities = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PublicFacility) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *PublicFacility) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*PublicFacility) facilitiesCreateRes()        {}
+func (*PublicFacility) facilitiesPartialUpdateRes() {}
+func (*PublicFacility) facilitiesRetrieveRes()      {}
+func (*PublicFacility) facilitiesUpdateRes()        {}
+
+// Ref: #/components/schemas/PublicFacilityMergePatchUpdate
+type PublicFacilityMergePatchUpdate struct {
+	// Display name of the facility. Visible to users.
+	Name OptString `json:"name"`
+	// Optional description of the facility, including usage rules or details.
+	Description OptPublicFacilityMergePatchUpdateDescription `json:"description"`
+	// Physical location or address of the facility.
+	Location OptPublicFacilityMergePatchUpdateLocation `json:"location"`
+	// ID of the site, building or floor the facility is located in. Omit for an unplaced facility.
+	LocationID OptPublicFacilityMergePatchUpdateLocationID `json:"location_id"`
+	// IANA time zone of the facility, e.g. Europe/Berlin. Omit to inherit the time zone of its location.
+	TimeZone OptPublicFacilityMergePatchUpdateTimeZone `json:"time_zone"`
+	// Display priority. Lower numbers appear earlier in sorted lists.
+	Priority OptPublicFacilityMergePatchUpdatePriority `json:"priority"`
+	// Set to false to disable this facility from public listing or reservation.
+	IsActive OptPublicFacilityMergePatchUpdateIsActive `json:"is_active"`
+	// Minutes before each reservation during which the facility is blocked for setup.
+	// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+	SetupBufferMinutes OptPublicFacilityMergePatchUpdateSetupBufferMinutes `json:"setup_buffer_minutes"`
+	// Minutes after each reservation during which the facility is blocked for teardown or cleaning.
+	// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+	TeardownBufferMinutes OptPublicFacilityMergePatchUpdateTeardownBufferMinutes `json:"teardown_buffer_minutes"`
+	// Set to true to require staff approval of reservations made by other users.
+	// Such reservations are requested as pending and hold their period until approved or rejected.
+	RequiresApproval OptPublicFacilityMergePatchUpdateRequiresApproval `json:"requires_approval"`
+	// Minutes after the start of a reservation by which it has to be checked in.
+	// Reservations not checked in by then are released as no-shows. Omit to not require check-in.
+	CheckInGraceMinutes OptPublicFacilityMergePatchUpdateCheckInGraceMinutes `json:"check_in_grace_minutes"`
+	// Maximum number of people per reservation, counting its owner and attendees. Omit for no limit.
+	// Changes apply to reservations made or updated afterwards.
+	Capacity OptPublicFacilityMergePatchUpdateCapacity `json:"capacity"`
+}
+
+// GetName returns the value of Name.
+func (s *PublicFacilityMergePatchUpdate) GetName() OptString {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *PublicFacilityMergePatchUpdate) GetDescription() OptPublicFacilityMergePatchUpdateDescription {
+	return s.Description
 }
 
 // GetLocation returns the value of Location.
@@ -1165,315 +4465,2314 @@ func (s *PublicFacilityMergePatchUpdate) GetLocation() OptPublicFacilityMergePat
 	return s.Location
 }
 
-// GetPriority returns the value of Priority.
-func (s *PublicFacilityMergePatchUpdate) GetPriority() OptPublicFacilityMergePatchUpdatePriority {
-	return s.Priority
+// GetLocationID returns the value of LocationID.
+func (s *PublicFacilityMergePatchUpdate) GetLocationID() OptPublicFacilityMergePatchUpdateLocationID {
+	return s.LocationID
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *PublicFacilityMergePatchUpdate) GetTimeZone() OptPublicFacilityMergePatchUpdateTimeZone {
+	return s.TimeZone
+}
+
+// GetPriority returns the value of Priority.
+func (s *PublicFacilityMergePatchUpdate) GetPriority() OptPublicFacilityMergePatchUpdatePriority {
+	return s.Priority
+}
+
+// GetIsActive returns the value of IsActive.
+func (s *PublicFacilityMergePatchUpdate) GetIsActive() OptPublicFacilityMergePatchUpdateIsActive {
+	return s.IsActive
+}
+
+// GetSetupBufferMinutes returns the value of SetupBufferMinutes.
+func (s *PublicFacilityMergePatchUpdate) GetSetupBufferMinutes() OptPublicFacilityMergePatchUpdateSetupBufferMinutes {
+	return s.SetupBufferMinutes
+}
+
+// GetTeardownBufferMinutes returns the value of TeardownBufferMinutes.
+func (s *PublicFacilityMergePatchUpdate) GetTeardownBufferMinutes() OptPublicFacilityMergePatchUpdateTeardownBufferMinutes {
+	return s.TeardownBufferMinutes
+}
+
+// GetRequiresApproval returns the value of RequiresApproval.
+func (s *PublicFacilityMergePatchUpdate) GetRequiresApproval() OptPublicFacilityMergePatchUpdateRequiresApproval {
+	return s.RequiresApproval
+}
+
+// GetCheckInGraceMinutes returns the value of CheckInGraceMinutes.
+func (s *PublicFacilityMergePatchUpdate) GetCheckInGraceMinutes() OptPublicFacilityMergePatchUpdateCheckInGraceMinutes {
+	return s.CheckInGraceMinutes
+}
+
+// GetCapacity returns the value of Capacity.
+func (s *PublicFacilityMergePatchUpdate) GetCapacity() OptPublicFacilityMergePatchUpdateCapacity {
+	return s.Capacity
+}
+
+// SetName sets the value of Name.
+func (s *PublicFacilityMergePatchUpdate) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PublicFacilityMergePatchUpdate) SetDescription(val OptPublicFacilityMergePatchUpdateDescription) {
+	s.Description = val
+}
+
+// SetLocation sets the value of Location.
+func (s *PublicFacilityMergePatchUpdate) SetLocation(val OptPublicFacilityMergePatchUpdateLocation) {
+	s.Location = val
+}
+
+// SetLocationID sets the value of LocationID.
+func (s *PublicFacilityMergePatchUpdate) SetLocationID(val OptPublicFacilityMergePatchUpdateLocationID) {
+	s.LocationID = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *PublicFacilityMergePatchUpdate) SetTimeZone(val OptPublicFacilityMergePatchUpdateTimeZone) {
+	s.TimeZone = val
+}
+
+// SetPriority sets the value of Priority.
+func (s *PublicFacilityMergePatchUpdate) SetPriority(val OptPublicFacilityMergePatchUpdatePriority) {
+	s.Priority = val
+}
+
+// SetIsActive sets the value of IsActive.
+func (s *PublicFacilityMergePatchUpdate) SetIsActive(val OptPublicFacilityMergePatchUpdateIsActive) {
+	s.IsActive = val
+}
+
+// SetSetupBufferMinutes sets the value of SetupBufferMinutes.
+func (s *PublicFacilityMergePatchUpdate) SetSetupBufferMinutes(val OptPublicFacilityMergePatchUpdateSetupBufferMinutes) {
+	s.SetupBufferMinutes = val
+}
+
+// SetTeardownBufferMinutes sets the value of TeardownBufferMinutes.
+func (s *PublicFacilityMergePatchUpdate) SetTeardownBufferMinutes(val OptPublicFacilityMergePatchUpdateTeardownBufferMinutes) {
+	s.TeardownBufferMinutes = val
+}
+
+// SetRequiresApproval sets the value of RequiresApproval.
+func (s *PublicFacilityMergePatchUpdate) SetRequiresApproval(val OptPublicFacilityMergePatchUpdateRequiresApproval) {
+	s.RequiresApproval = val
+}
+
+// SetCheckInGraceMinutes sets the value of CheckInGraceMinutes.
+func (s *PublicFacilityMergePatchUpdate) SetCheckInGraceMinutes(val OptPublicFacilityMergePatchUpdateCheckInGraceMinutes) {
+	s.CheckInGraceMinutes = val
+}
+
+// SetCapacity sets the value of Capacity.
+func (s *PublicFacilityMergePatchUpdate) SetCapacity(val OptPublicFacilityMergePatchUpdateCapacity) {
+	s.Capacity = val
+}
+
+// Maximum number of people per reservation, counting its owner and attendees. Omit for no limit.
+// Changes apply to reservations made or updated afterwards.
+// PublicFacilityMergePatchUpdateCapacity represents sum type.
+type PublicFacilityMergePatchUpdateCapacity struct {
+	Type  PublicFacilityMergePatchUpdateCapacityType // switch on this field
+	Int32 int32
+	Null  struct{}
+}
+
+// PublicFacilityMergePatchUpdateCapacityType is oneOf type of PublicFacilityMergePatchUpdateCapacity.
+type PublicFacilityMergePatchUpdateCapacityType string
+
+// Possible values for PublicFacilityMergePatchUpdateCapacityType.
+const (
+	Int32PublicFacilityMergePatchUpdateCapacity PublicFacilityMergePatchUpdateCapacityType = "int32"
+	NullPublicFacilityMergePatchUpdateCapacity  PublicFacilityMergePatchUpdateCapacityType = "struct{}"
+)
+
+// IsInt32 reports whether PublicFacilityMergePatchUpdateCapacity is int32.
+func (s PublicFacilityMergePatchUpdateCapacity) IsInt32() bool {
+	return s.Type == Int32PublicFacilityMergePatchUpdateCapacity
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateCapacity is struct{}.
+func (s PublicFacilityMergePatchUpdateCapacity) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateCapacity
+}
+
+// SetInt32 sets PublicFacilityMergePatchUpdateCapacity to int32.
+func (s *PublicFacilityMergePatchUpdateCapacity) SetInt32(v int32) {
+	s.Type = Int32PublicFacilityMergePatchUpdateCapacity
+	s.Int32 = v
+}
+
+// GetInt32 returns int32 and true boolean if PublicFacilityMergePatchUpdateCapacity is int32.
+func (s PublicFacilityMergePatchUpdateCapacity) GetInt32() (v int32, ok bool) {
+	if !s.IsInt32() {
+		return v, false
+	}
+	return s.Int32, true
+}
+
+// NewInt32PublicFacilityMergePatchUpdateCapacity returns new PublicFacilityMergePatchUpdateCapacity from int32.
+func NewInt32PublicFacilityMergePatchUpdateCapacity(v int32) PublicFacilityMergePatchUpdateCapacity {
+	var s PublicFacilityMergePatchUpdateCapacity
+	s.SetInt32(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateCapacity to struct{}.
+func (s *PublicFacilityMergePatchUpdateCapacity) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateCapacity
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateCapacity is struct{}.
+func (s PublicFacilityMergePatchUpdateCapacity) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateCapacity returns new PublicFacilityMergePatchUpdateCapacity from struct{}.
+func NewNullPublicFacilityMergePatchUpdateCapacity(v struct{}) PublicFacilityMergePatchUpdateCapacity {
+	var s PublicFacilityMergePatchUpdateCapacity
+	s.SetNull(v)
+	return s
+}
+
+// Minutes after the start of a reservation by which it has to be checked in.
+// Reservations not checked in by then are released as no-shows. Omit to not require check-in.
+// PublicFacilityMergePatchUpdateCheckInGraceMinutes represents sum type.
+type PublicFacilityMergePatchUpdateCheckInGraceMinutes struct {
+	Type  PublicFacilityMergePatchUpdateCheckInGraceMinutesType // switch on this field
+	Int32 int32
+	Null  struct{}
+}
+
+// PublicFacilityMergePatchUpdateCheckInGraceMinutesType is oneOf type of PublicFacilityMergePatchUpdateCheckInGraceMinutes.
+type PublicFacilityMergePatchUpdateCheckInGraceMinutesType string
+
+// Possible values for PublicFacilityMergePatchUpdateCheckInGraceMinutesType.
+const (
+	Int32PublicFacilityMergePatchUpdateCheckInGraceMinutes PublicFacilityMergePatchUpdateCheckInGraceMinutesType = "int32"
+	NullPublicFacilityMergePatchUpdateCheckInGraceMinutes  PublicFacilityMergePatchUpdateCheckInGraceMinutesType = "struct{}"
+)
+
+// IsInt32 reports whether PublicFacilityMergePatchUpdateCheckInGraceMinutes is int32.
+func (s PublicFacilityMergePatchUpdateCheckInGraceMinutes) IsInt32() bool {
+	return s.Type == Int32PublicFacilityMergePatchUpdateCheckInGraceMinutes
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateCheckInGraceMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateCheckInGraceMinutes) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateCheckInGraceMinutes
+}
+
+// SetInt32 sets PublicFacilityMergePatchUpdateCheckInGraceMinutes to int32.
+func (s *PublicFacilityMergePatchUpdateCheckInGraceMinutes) SetInt32(v int32) {
+	s.Type = Int32PublicFacilityMergePatchUpdateCheckInGraceMinutes
+	s.Int32 = v
+}
+
+// GetInt32 returns int32 and true boolean if PublicFacilityMergePatchUpdateCheckInGraceMinutes is int32.
+func (s PublicFacilityMergePatchUpdateCheckInGraceMinutes) GetInt32() (v int32, ok bool) {
+	if !s.IsInt32() {
+		return v, false
+	}
+	return s.Int32, true
+}
+
+// NewInt32PublicFacilityMergePatchUpdateCheckInGraceMinutes returns new PublicFacilityMergePatchUpdateCheckInGraceMinutes from int32.
+func NewInt32PublicFacilityMergePatchUpdateCheckInGraceMinutes(v int32) PublicFacilityMergePatchUpdateCheckInGraceMinutes {
+	var s PublicFacilityMergePatchUpdateCheckInGraceMinutes
+	s.SetInt32(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateCheckInGraceMinutes to struct{}.
+func (s *PublicFacilityMergePatchUpdateCheckInGraceMinutes) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateCheckInGraceMinutes
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateCheckInGraceMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateCheckInGraceMinutes) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateCheckInGraceMinutes returns new PublicFacilityMergePatchUpdateCheckInGraceMinutes from struct{}.
+func NewNullPublicFacilityMergePatchUpdateCheckInGraceMinutes(v struct{}) PublicFacilityMergePatchUpdateCheckInGraceMinutes {
+	var s PublicFacilityMergePatchUpdateCheckInGraceMinutes
+	s.SetNull(v)
+	return s
+}
+
+// Optional description of the facility, including usage rules or details.
+// PublicFacilityMergePatchUpdateDescription represents sum type.
+type PublicFacilityMergePatchUpdateDescription struct {
+	Type   PublicFacilityMergePatchUpdateDescriptionType // switch on this field
+	String string
+	Null   struct{}
+}
+
+// PublicFacilityMergePatchUpdateDescriptionType is oneOf type of PublicFacilityMergePatchUpdateDescription.
+type PublicFacilityMergePatchUpdateDescriptionType string
+
+// Possible values for PublicFacilityMergePatchUpdateDescriptionType.
+const (
+	StringPublicFacilityMergePatchUpdateDescription PublicFacilityMergePatchUpdateDescriptionType = "string"
+	NullPublicFacilityMergePatchUpdateDescription   PublicFacilityMergePatchUpdateDescriptionType = "struct{}"
+)
+
+// IsString reports whether PublicFacilityMergePatchUpdateDescription is string.
+func (s PublicFacilityMergePatchUpdateDescription) IsString() bool {
+	return s.Type == StringPublicFacilityMergePatchUpdateDescription
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateDescription is struct{}.
+func (s PublicFacilityMergePatchUpdateDescription) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateDescription
+}
+
+// SetString sets PublicFacilityMergePatchUpdateDescription to string.
+func (s *PublicFacilityMergePatchUpdateDescription) SetString(v string) {
+	s.Type = StringPublicFacilityMergePatchUpdateDescription
+	s.String = v
+}
+
+// GetString returns string and true boolean if PublicFacilityMergePatchUpdateDescription is string.
+func (s PublicFacilityMergePatchUpdateDescription) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringPublicFacilityMergePatchUpdateDescription returns new PublicFacilityMergePatchUpdateDescription from string.
+func NewStringPublicFacilityMergePatchUpdateDescription(v string) PublicFacilityMergePatchUpdateDescription {
+	var s PublicFacilityMergePatchUpdateDescription
+	s.SetString(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateDescription to struct{}.
+func (s *PublicFacilityMergePatchUpdateDescription) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateDescription
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateDescription is struct{}.
+func (s PublicFacilityMergePatchUpdateDescription) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateDescription returns new PublicFacilityMergePatchUpdateDescription from struct{}.
+func NewNullPublicFacilityMergePatchUpdateDescription(v struct{}) PublicFacilityMergePatchUpdateDescription {
+	var s PublicFacilityMergePatchUpdateDescription
+	s.SetNull(v)
+	return s
+}
+
+// Set to false to disable this facility from public listing or reservation.
+// PublicFacilityMergePatchUpdateIsActive represents sum type.
+type PublicFacilityMergePatchUpdateIsActive struct {
+	Type PublicFacilityMergePatchUpdateIsActiveType // switch on this field
+	Bool bool
+	Null struct{}
+}
+
+// PublicFacilityMergePatchUpdateIsActiveType is oneOf type of PublicFacilityMergePatchUpdateIsActive.
+type PublicFacilityMergePatchUpdateIsActiveType string
+
+// Possible values for PublicFacilityMergePatchUpdateIsActiveType.
+const (
+	BoolPublicFacilityMergePatchUpdateIsActive PublicFacilityMergePatchUpdateIsActiveType = "bool"
+	NullPublicFacilityMergePatchUpdateIsActive PublicFacilityMergePatchUpdateIsActiveType = "struct{}"
+)
+
+// IsBool reports whether PublicFacilityMergePatchUpdateIsActive is bool.
+func (s PublicFacilityMergePatchUpdateIsActive) IsBool() bool {
+	return s.Type == BoolPublicFacilityMergePatchUpdateIsActive
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateIsActive is struct{}.
+func (s PublicFacilityMergePatchUpdateIsActive) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateIsActive
+}
+
+// SetBool sets PublicFacilityMergePatchUpdateIsActive to bool.
+func (s *PublicFacilityMergePatchUpdateIsActive) SetBool(v bool) {
+	s.Type = BoolPublicFacilityMergePatchUpdateIsActive
+	s.Bool = v
+}
+
+// GetBool returns bool and true boolean if PublicFacilityMergePatchUpdateIsActive is bool.
+func (s PublicFacilityMergePatchUpdateIsActive) GetBool() (v bool, ok bool) {
+	if !s.IsBool() {
+		return v, false
+	}
+	return s.Bool, true
+}
+
+// NewBoolPublicFacilityMergePatchUpdateIsActive returns new PublicFacilityMergePatchUpdateIsActive from bool.
+func NewBoolPublicFacilityMergePatchUpdateIsActive(v bool) PublicFacilityMergePatchUpdateIsActive {
+	var s PublicFacilityMergePatchUpdateIsActive
+	s.SetBool(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateIsActive to struct{}.
+func (s *PublicFacilityMergePatchUpdateIsActive) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateIsActive
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateIsActive is struct{}.
+func (s PublicFacilityMergePatchUpdateIsActive) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateIsActive returns new PublicFacilityMergePatchUpdateIsActive from struct{}.
+func NewNullPublicFacilityMergePatchUpdateIsActive(v struct{}) PublicFacilityMergePatchUpdateIsActive {
+	var s PublicFacilityMergePatchUpdateIsActive
+	s.SetNull(v)
+	return s
+}
+
+// Physical location or address of the facility.
+// PublicFacilityMergePatchUpdateLocation represents sum type.
+type PublicFacilityMergePatchUpdateLocation struct {
+	Type   PublicFacilityMergePatchUpdateLocationType // switch on this field
+	String string
+	Null   struct{}
+}
+
+// PublicFacilityMergePatchUpdateLocationType is oneOf type of PublicFacilityMergePatchUpdateLocation.
+type PublicFacilityMergePatchUpdateLocationType string
+
+// Possible values for PublicFacilityMergePatchUpdateLocationType.
+const (
+	StringPublicFacilityMergePatchUpdateLocation PublicFacilityMergePatchUpdateLocationType = "string"
+	NullPublicFacilityMergePatchUpdateLocation   PublicFacilityMergePatchUpdateLocationType = "struct{}"
+)
+
+// IsString reports whether PublicFacilityMergePatchUpdateLocation is string.
+func (s PublicFacilityMergePatchUpdateLocation) IsString() bool {
+	return s.Type == StringPublicFacilityMergePatchUpdateLocation
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateLocation is struct{}.
+func (s PublicFacilityMergePatchUpdateLocation) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateLocation
+}
+
+// SetString sets PublicFacilityMergePatchUpdateLocation to string.
+func (s *PublicFacilityMergePatchUpdateLocation) SetString(v string) {
+	s.Type = StringPublicFacilityMergePatchUpdateLocation
+	s.String = v
+}
+
+// GetString returns string and true boolean if PublicFacilityMergePatchUpdateLocation is string.
+func (s PublicFacilityMergePatchUpdateLocation) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringPublicFacilityMergePatchUpdateLocation returns new PublicFacilityMergePatchUpdateLocation from string.
+func NewStringPublicFacilityMergePatchUpdateLocation(v string) PublicFacilityMergePatchUpdateLocation {
+	var s PublicFacilityMergePatchUpdateLocation
+	s.SetString(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateLocation to struct{}.
+func (s *PublicFacilityMergePatchUpdateLocation) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateLocation
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateLocation is struct{}.
+func (s PublicFacilityMergePatchUpdateLocation) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateLocation returns new PublicFacilityMergePatchUpdateLocation from struct{}.
+func NewNullPublicFacilityMergePatchUpdateLocation(v struct{}) PublicFacilityMergePatchUpdateLocation {
+	var s PublicFacilityMergePatchUpdateLocation
+	s.SetNull(v)
+	return s
+}
+
+// ID of the site, building or floor the facility is located in. Omit for an unplaced facility.
+// PublicFacilityMergePatchUpdateLocationID represents sum type.
+type PublicFacilityMergePatchUpdateLocationID struct {
+	Type PublicFacilityMergePatchUpdateLocationIDType // switch on this field
+	UUID uuid.UUID
+	Null struct{}
+}
+
+// PublicFacilityMergePatchUpdateLocationIDType is oneOf type of PublicFacilityMergePatchUpdateLocationID.
+type PublicFacilityMergePatchUpdateLocationIDType string
+
+// Possible values for PublicFacilityMergePatchUpdateLocationIDType.
+const (
+	UUIDPublicFacilityMergePatchUpdateLocationID PublicFacilityMergePatchUpdateLocationIDType = "uuid.UUID"
+	NullPublicFacilityMergePatchUpdateLocationID PublicFacilityMergePatchUpdateLocationIDType = "struct{}"
+)
+
+// IsUUID reports whether PublicFacilityMergePatchUpdateLocationID is uuid.UUID.
+func (s PublicFacilityMergePatchUpdateLocationID) IsUUID() bool {
+	return s.Type == UUIDPublicFacilityMergePatchUpdateLocationID
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateLocationID is struct{}.
+func (s PublicFacilityMergePatchUpdateLocationID) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateLocationID
+}
+
+// SetUUID sets PublicFacilityMergePatchUpdateLocationID to uuid.UUID.
+func (s *PublicFacilityMergePatchUpdateLocationID) SetUUID(v uuid.UUID) {
+	s.Type = UUIDPublicFacilityMergePatchUpdateLocationID
+	s.UUID = v
+}
+
+// GetUUID returns uuid.UUID and true boolean if PublicFacilityMergePatchUpdateLocationID is uuid.UUID.
+func (s PublicFacilityMergePatchUpdateLocationID) GetUUID() (v uuid.UUID, ok bool) {
+	if !s.IsUUID() {
+		return v, false
+	}
+	return s.UUID, true
+}
+
+// NewUUIDPublicFacilityMergePatchUpdateLocationID returns new PublicFacilityMergePatchUpdateLocationID from uuid.UUID.
+func NewUUIDPublicFacilityMergePatchUpdateLocationID(v uuid.UUID) PublicFacilityMergePatchUpdateLocationID {
+	var s PublicFacilityMergePatchUpdateLocationID
+	s.SetUUID(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateLocationID to struct{}.
+func (s *PublicFacilityMergePatchUpdateLocationID) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateLocationID
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateLocationID is struct{}.
+func (s PublicFacilityMergePatchUpdateLocationID) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateLocationID returns new PublicFacilityMergePatchUpdateLocationID from struct{}.
+func NewNullPublicFacilityMergePatchUpdateLocationID(v struct{}) PublicFacilityMergePatchUpdateLocationID {
+	var s PublicFacilityMergePatchUpdateLocationID
+	s.SetNull(v)
+	return s
+}
+
+// Display priority. Lower numbers appear earlier in sorted lists.
+// PublicFacilityMergePatchUpdatePriority represents sum type.
+type PublicFacilityMergePatchUpdatePriority struct {
+	Type  PublicFacilityMergePatchUpdatePriorityType // switch on this field
+	Int64 int64
+	Null  struct{}
+}
+
+// PublicFacilityMergePatchUpdatePriorityType is oneOf type of PublicFacilityMergePatchUpdatePriority.
+type PublicFacilityMergePatchUpdatePriorityType string
+
+// Possible values for PublicFacilityMergePatchUpdatePriorityType.
+const (
+	Int64PublicFacilityMergePatchUpdatePriority PublicFacilityMergePatchUpdatePriorityType = "int64"
+	NullPublicFacilityMergePatchUpdatePriority  PublicFacilityMergePatchUpdatePriorityType = "struct{}"
+)
+
+// IsInt64 reports whether PublicFacilityMergePatchUpdatePriority is int64.
+func (s PublicFacilityMergePatchUpdatePriority) IsInt64() bool {
+	return s.Type == Int64PublicFacilityMergePatchUpdatePriority
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdatePriority is struct{}.
+func (s PublicFacilityMergePatchUpdatePriority) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdatePriority
+}
+
+// SetInt64 sets PublicFacilityMergePatchUpdatePriority to int64.
+func (s *PublicFacilityMergePatchUpdatePriority) SetInt64(v int64) {
+	s.Type = Int64PublicFacilityMergePatchUpdatePriority
+	s.Int64 = v
+}
+
+// GetInt64 returns int64 and true boolean if PublicFacilityMergePatchUpdatePriority is int64.
+func (s PublicFacilityMergePatchUpdatePriority) GetInt64() (v int64, ok bool) {
+	if !s.IsInt64() {
+		return v, false
+	}
+	return s.Int64, true
+}
+
+// NewInt64PublicFacilityMergePatchUpdatePriority returns new PublicFacilityMergePatchUpdatePriority from int64.
+func NewInt64PublicFacilityMergePatchUpdatePriority(v int64) PublicFacilityMergePatchUpdatePriority {
+	var s PublicFacilityMergePatchUpdatePriority
+	s.SetInt64(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdatePriority to struct{}.
+func (s *PublicFacilityMergePatchUpdatePriority) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdatePriority
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdatePriority is struct{}.
+func (s PublicFacilityMergePatchUpdatePriority) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdatePriority returns new PublicFacilityMergePatchUpdatePriority from struct{}.
+func NewNullPublicFacilityMergePatchUpdatePriority(v struct{}) PublicFacilityMergePatchUpdatePriority {
+	var s PublicFacilityMergePatchUpdatePriority
+	s.SetNull(v)
+	return s
+}
+
+// Set to true to require staff approval of reservations made by other users.
+// Such reservations are requested as pending and hold their period until approved or rejected.
+// PublicFacilityMergePatchUpdateRequiresApproval represents sum type.
+type PublicFacilityMergePatchUpdateRequiresApproval struct {
+	Type PublicFacilityMergePatchUpdateRequiresApprovalType // switch on this field
+	Bool bool
+	Null struct{}
+}
+
+// PublicFacilityMergePatchUpdateRequiresApprovalType is oneOf type of PublicFacilityMergePatchUpdateRequiresApproval.
+type PublicFacilityMergePatchUpdateRequiresApprovalType string
+
+// Possible values for PublicFacilityMergePatchUpdateRequiresApprovalType.
+const (
+	BoolPublicFacilityMergePatchUpdateRequiresApproval PublicFacilityMergePatchUpdateRequiresApprovalType = "bool"
+	NullPublicFacilityMergePatchUpdateRequiresApproval PublicFacilityMergePatchUpdateRequiresApprovalType = "struct{}"
+)
+
+// IsBool reports whether PublicFacilityMergePatchUpdateRequiresApproval is bool.
+func (s PublicFacilityMergePatchUpdateRequiresApproval) IsBool() bool {
+	return s.Type == BoolPublicFacilityMergePatchUpdateRequiresApproval
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateRequiresApproval is struct{}.
+func (s PublicFacilityMergePatchUpdateRequiresApproval) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateRequiresApproval
+}
+
+// SetBool sets PublicFacilityMergePatchUpdateRequiresApproval to bool.
+func (s *PublicFacilityMergePatchUpdateRequiresApproval) SetBool(v bool) {
+	s.Type = BoolPublicFacilityMergePatchUpdateRequiresApproval
+	s.Bool = v
+}
+
+// GetBool returns bool and true boolean if PublicFacilityMergePatchUpdateRequiresApproval is bool.
+func (s PublicFacilityMergePatchUpdateRequiresApproval) GetBool() (v bool, ok bool) {
+	if !s.IsBool() {
+		return v, false
+	}
+	return s.Bool, true
+}
+
+// NewBoolPublicFacilityMergePatchUpdateRequiresApproval returns new PublicFacilityMergePatchUpdateRequiresApproval from bool.
+func NewBoolPublicFacilityMergePatchUpdateRequiresApproval(v bool) PublicFacilityMergePatchUpdateRequiresApproval {
+	var s PublicFacilityMergePatchUpdateRequiresApproval
+	s.SetBool(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateRequiresApproval to struct{}.
+func (s *PublicFacilityMergePatchUpdateRequiresApproval) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateRequiresApproval
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateRequiresApproval is struct{}.
+func (s PublicFacilityMergePatchUpdateRequiresApproval) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateRequiresApproval returns new PublicFacilityMergePatchUpdateRequiresApproval from struct{}.
+func NewNullPublicFacilityMergePatchUpdateRequiresApproval(v struct{}) PublicFacilityMergePatchUpdateRequiresApproval {
+	var s PublicFacilityMergePatchUpdateRequiresApproval
+	s.SetNull(v)
+	return s
+}
+
+// Minutes before each reservation during which the facility is blocked for setup.
+// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+// PublicFacilityMergePatchUpdateSetupBufferMinutes represents sum type.
+type PublicFacilityMergePatchUpdateSetupBufferMinutes struct {
+	Type  PublicFacilityMergePatchUpdateSetupBufferMinutesType // switch on this field
+	Int32 int32
+	Null  struct{}
+}
+
+// PublicFacilityMergePatchUpdateSetupBufferMinutesType is oneOf type of PublicFacilityMergePatchUpdateSetupBufferMinutes.
+type PublicFacilityMergePatchUpdateSetupBufferMinutesType string
+
+// Possible values for PublicFacilityMergePatchUpdateSetupBufferMinutesType.
+const (
+	Int32PublicFacilityMergePatchUpdateSetupBufferMinutes PublicFacilityMergePatchUpdateSetupBufferMinutesType = "int32"
+	NullPublicFacilityMergePatchUpdateSetupBufferMinutes  PublicFacilityMergePatchUpdateSetupBufferMinutesType = "struct{}"
+)
+
+// IsInt32 reports whether PublicFacilityMergePatchUpdateSetupBufferMinutes is int32.
+func (s PublicFacilityMergePatchUpdateSetupBufferMinutes) IsInt32() bool {
+	return s.Type == Int32PublicFacilityMergePatchUpdateSetupBufferMinutes
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateSetupBufferMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateSetupBufferMinutes) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateSetupBufferMinutes
+}
+
+// SetInt32 sets PublicFacilityMergePatchUpdateSetupBufferMinutes to int32.
+func (s *PublicFacilityMergePatchUpdateSetupBufferMinutes) SetInt32(v int32) {
+	s.Type = Int32PublicFacilityMergePatchUpdateSetupBufferMinutes
+	s.Int32 = v
+}
+
+// GetInt32 returns int32 and true boolean if PublicFacilityMergePatchUpdateSetupBufferMinutes is int32.
+func (s PublicFacilityMergePatchUpdateSetupBufferMinutes) GetInt32() (v int32, ok bool) {
+	if !s.IsInt32() {
+		return v, false
+	}
+	return s.Int32, true
+}
+
+// NewInt32PublicFacilityMergePatchUpdateSetupBufferMinutes returns new PublicFacilityMergePatchUpdateSetupBufferMinutes from int32.
+func NewInt32PublicFacilityMergePatchUpdateSetupBufferMinutes(v int32) PublicFacilityMergePatchUpdateSetupBufferMinutes {
+	var s PublicFacilityMergePatchUpdateSetupBufferMinutes
+	s.SetInt32(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateSetupBufferMinutes to struct{}.
+func (s *PublicFacilityMergePatchUpdateSetupBufferMinutes) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateSetupBufferMinutes
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateSetupBufferMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateSetupBufferMinutes) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateSetupBufferMinutes returns new PublicFacilityMergePatchUpdateSetupBufferMinutes from struct{}.
+func NewNullPublicFacilityMergePatchUpdateSetupBufferMinutes(v struct{}) PublicFacilityMergePatchUpdateSetupBufferMinutes {
+	var s PublicFacilityMergePatchUpdateSetupBufferMinutes
+	s.SetNull(v)
+	return s
+}
+
+// Minutes after each reservation during which the facility is blocked for teardown or cleaning.
+// Not part of the reservation's own period. Changes apply to reservations made or updated afterwards.
+// PublicFacilityMergePatchUpdateTeardownBufferMinutes represents sum type.
+type PublicFacilityMergePatchUpdateTeardownBufferMinutes struct {
+	Type  PublicFacilityMergePatchUpdateTeardownBufferMinutesType // switch on this field
+	Int32 int32
+	Null  struct{}
+}
+
+// PublicFacilityMergePatchUpdateTeardownBufferMinutesType is oneOf type of PublicFacilityMergePatchUpdateTeardownBufferMinutes.
+type PublicFacilityMergePatchUpdateTeardownBufferMinutesType string
+
+// Possible values for PublicFacilityMergePatchUpdateTeardownBufferMinutesType.
+const (
+	Int32PublicFacilityMergePatchUpdateTeardownBufferMinutes PublicFacilityMergePatchUpdateTeardownBufferMinutesType = "int32"
+	NullPublicFacilityMergePatchUpdateTeardownBufferMinutes  PublicFacilityMergePatchUpdateTeardownBufferMinutesType = "struct{}"
+)
+
+// IsInt32 reports whether PublicFacilityMergePatchUpdateTeardownBufferMinutes is int32.
+func (s PublicFacilityMergePatchUpdateTeardownBufferMinutes) IsInt32() bool {
+	return s.Type == Int32PublicFacilityMergePatchUpdateTeardownBufferMinutes
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateTeardownBufferMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateTeardownBufferMinutes) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateTeardownBufferMinutes
+}
+
+// SetInt32 sets PublicFacilityMergePatchUpdateTeardownBufferMinutes to int32.
+func (s *PublicFacilityMergePatchUpdateTeardownBufferMinutes) SetInt32(v int32) {
+	s.Type = Int32PublicFacilityMergePatchUpdateTeardownBufferMinutes
+	s.Int32 = v
+}
+
+// GetInt32 returns int32 and true boolean if PublicFacilityMergePatchUpdateTeardownBufferMinutes is int32.
+func (s PublicFacilityMergePatchUpdateTeardownBufferMinutes) GetInt32() (v int32, ok bool) {
+	if !s.IsInt32() {
+		return v, false
+	}
+	return s.Int32, true
+}
+
+// NewInt32PublicFacilityMergePatchUpdateTeardownBufferMinutes returns new PublicFacilityMergePatchUpdateTeardownBufferMinutes from int32.
+func NewInt32PublicFacilityMergePatchUpdateTeardownBufferMinutes(v int32) PublicFacilityMergePatchUpdateTeardownBufferMinutes {
+	var s PublicFacilityMergePatchUpdateTeardownBufferMinutes
+	s.SetInt32(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateTeardownBufferMinutes to struct{}.
+func (s *PublicFacilityMergePatchUpdateTeardownBufferMinutes) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateTeardownBufferMinutes
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateTeardownBufferMinutes is struct{}.
+func (s PublicFacilityMergePatchUpdateTeardownBufferMinutes) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateTeardownBufferMinutes returns new PublicFacilityMergePatchUpdateTeardownBufferMinutes from struct{}.
+func NewNullPublicFacilityMergePatchUpdateTeardownBufferMinutes(v struct{}) PublicFacilityMergePatchUpdateTeardownBufferMinutes {
+	var s PublicFacilityMergePatchUpdateTeardownBufferMinutes
+	s.SetNull(v)
+	return s
+}
+
+// IANA time zone of the facility, e.g. Europe/Berlin. Omit to inherit the time zone of its location.
+// PublicFacilityMergePatchUpdateTimeZone represents sum type.
+type PublicFacilityMergePatchUpdateTimeZone struct {
+	Type   PublicFacilityMergePatchUpdateTimeZoneType // switch on this field
+	String string
+	Null   struct{}
+}
+
+// PublicFacilityMergePatchUpdateTimeZoneType is oneOf type of PublicFacilityMergePatchUpdateTimeZone.
+type PublicFacilityMergePatchUpdateTimeZoneType string
+
+// Possible values for PublicFacilityMergePatchUpdateTimeZoneType.
+const (
+	StringPublicFacilityMergePatchUpdateTimeZone PublicFacilityMergePatchUpdateTimeZoneType = "string"
+	NullPublicFacilityMergePatchUpdateTimeZone   PublicFacilityMergePatchUpdateTimeZoneType = "struct{}"
+)
+
+// IsString reports whether PublicFacilityMergePatchUpdateTimeZone is string.
+func (s PublicFacilityMergePatchUpdateTimeZone) IsString() bool {
+	return s.Type == StringPublicFacilityMergePatchUpdateTimeZone
+}
+
+// IsNull reports whether PublicFacilityMergePatchUpdateTimeZone is struct{}.
+func (s PublicFacilityMergePatchUpdateTimeZone) IsNull() bool {
+	return s.Type == NullPublicFacilityMergePatchUpdateTimeZone
+}
+
+// SetString sets PublicFacilityMergePatchUpdateTimeZone to string.
+func (s *PublicFacilityMergePatchUpdateTimeZone) SetString(v string) {
+	s.Type = StringPublicFacilityMergePatchUpdateTimeZone
+	s.String = v
+}
+
+// GetString returns string and true boolean if PublicFacilityMergePatchUpdateTimeZone is string.
+func (s PublicFacilityMergePatchUpdateTimeZone) GetString() (v string, ok bool) {
+	if !s.IsString() {
+		return v, false
+	}
+	return s.String, true
+}
+
+// NewStringPublicFacilityMergePatchUpdateTimeZone returns new PublicFacilityMergePatchUpdateTimeZone from string.
+func NewStringPublicFacilityMergePatchUpdateTimeZone(v string) PublicFacilityMergePatchUpdateTimeZone {
+	var s PublicFacilityMergePatchUpdateTimeZone
+	s.SetString(v)
+	return s
+}
+
+// SetNull sets PublicFacilityMergePatchUpdateTimeZone to struct{}.
+func (s *PublicFacilityMergePatchUpdateTimeZone) SetNull(v struct{}) {
+	s.Type = NullPublicFacilityMergePatchUpdateTimeZone
+	s.Null = v
+}
+
+// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateTimeZone is struct{}.
+func (s PublicFacilityMergePatchUpdateTimeZone) GetNull() (v struct{}, ok bool) {
+	if !s.IsNull() {
+		return v, false
+	}
+	return s.Null, true
+}
+
+// NewNullPublicFacilityMergePatchUpdateTimeZone returns new PublicFacilityMergePatchUpdateTimeZone from struct{}.
+func NewNullPublicFacilityMergePatchUpdateTimeZone(v struct{}) PublicFacilityMergePatchUpdateTimeZone {
+	var s PublicFacilityMergePatchUpdateTimeZone
+	s.SetNull(v)
+	return s
+}
+
+// A reservation of a facility for a period of time.
+// Ref: #/components/schemas/Reservation
+type Reservation struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who owns the reservation.
+	UserID uuid.UUID `json:"user_id"`
+	// ID of the user who made the reservation, a delegate or staff when booked on behalf of its owner.
+	// Omitted once that user is deleted.
+	BookedBy OptUUID `json:"booked_by"`
+	// ID of the series the reservation belongs to. Omitted for single reservations.
+	SeriesID OptUUID `json:"series_id"`
+	// Start of the occurrence as generated by its series rule. Omitted for single reservations.
+	OriginalStartsAt OptDateTime `json:"original_starts_at"`
+	// Whether the series occurrence was edited or skipped individually.
+	// Such occurrences are kept when the series is regenerated as long as its rule still yields their
+	// original start.
+	IsException bool `json:"is_exception"`
+	// ID of the bundle the reservation belongs to. Omitted for reservations made individually.
+	BundleID OptUUID `json:"bundle_id"`
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservation.
+	Title string `json:"title"`
+	// Optional details of the reservation.
+	Description OptString `json:"description"`
+	// Start of the reserved period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the reserved period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// People attending the reservation besides its owner, each listed once. Attending users see the
+	// reservation
+	// in their listing. Together with the owner they must not exceed the capacity of the facility.
+	Attendees []ReservationAttendee `json:"attendees"`
+	// Time zone of the facility.
+	TimeZone string `json:"time_zone"`
+	// starts_at in the time zone of the facility.
+	LocalStartsAt time.Time `json:"local_starts_at"`
+	// ends_at in the time zone of the facility.
+	LocalEndsAt time.Time         `json:"local_ends_at"`
+	Status      ReservationStatus `json:"status"`
+	// Time the reservation was cancelled. Omitted while the reservation is confirmed.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	// Time staff approved or rejected the reservation request. Omitted for reservations that were not
+	// reviewed.
+	ReviewedAt OptDateTime `json:"reviewed_at"`
+	// Time the reservation was checked in. Omitted until it is checked in.
+	CheckedInAt OptDateTime `json:"checked_in_at"`
+	// Time the reservation was checked out early, ending its period. Omitted unless checked out.
+	CheckedOutAt OptDateTime `json:"checked_out_at"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Reservation) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *Reservation) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetBookedBy returns the value of BookedBy.
+func (s *Reservation) GetBookedBy() OptUUID {
+	return s.BookedBy
+}
+
+// GetSeriesID returns the value of SeriesID.
+func (s *Reservation) GetSeriesID() OptUUID {
+	return s.SeriesID
+}
+
+// GetOriginalStartsAt returns the value of OriginalStartsAt.
+func (s *Reservation) GetOriginalStartsAt() OptDateTime {
+	return s.OriginalStartsAt
+}
+
+// GetIsException returns the value of IsException.
+func (s *Reservation) GetIsException() bool {
+	return s.IsException
+}
+
+// GetBundleID returns the value of BundleID.
+func (s *Reservation) GetBundleID() OptUUID {
+	return s.BundleID
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *Reservation) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *Reservation) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *Reservation) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *Reservation) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *Reservation) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetAttendees returns the value of Attendees.
+func (s *Reservation) GetAttendees() []ReservationAttendee {
+	return s.Attendees
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *Reservation) GetTimeZone() string {
+	return s.TimeZone
+}
+
+// GetLocalStartsAt returns the value of LocalStartsAt.
+func (s *Reservation) GetLocalStartsAt() time.Time {
+	return s.LocalStartsAt
+}
+
+// GetLocalEndsAt returns the value of LocalEndsAt.
+func (s *Reservation) GetLocalEndsAt() time.Time {
+	return s.LocalEndsAt
+}
+
+// GetStatus returns the value of Status.
+func (s *Reservation) GetStatus() ReservationStatus {
+	return s.Status
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *Reservation) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
+}
+
+// GetReviewedAt returns the value of ReviewedAt.
+func (s *Reservation) GetReviewedAt() OptDateTime {
+	return s.ReviewedAt
+}
+
+// GetCheckedInAt returns the value of CheckedInAt.
+func (s *Reservation) GetCheckedInAt() OptDateTime {
+	return s.CheckedInAt
+}
+
+// GetCheckedOutAt returns the value of CheckedOutAt.
+func (s *Reservation) GetCheckedOutAt() OptDateTime {
+	return s.CheckedOutAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Reservation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Reservation) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Reservation) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *Reservation) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetBookedBy sets the value of BookedBy.
+func (s *Reservation) SetBookedBy(val OptUUID) {
+	s.BookedBy = val
+}
+
+// SetSeriesID sets the value of SeriesID.
+func (s *Reservation) SetSeriesID(val OptUUID) {
+	s.SeriesID = val
+}
+
+// SetOriginalStartsAt sets the value of OriginalStartsAt.
+func (s *Reservation) SetOriginalStartsAt(val OptDateTime) {
+	s.OriginalStartsAt = val
+}
+
+// SetIsException sets the value of IsException.
+func (s *Reservation) SetIsException(val bool) {
+	s.IsException = val
+}
+
+// SetBundleID sets the value of BundleID.
+func (s *Reservation) SetBundleID(val OptUUID) {
+	s.BundleID = val
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *Reservation) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Reservation) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Reservation) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *Reservation) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *Reservation) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetAttendees sets the value of Attendees.
+func (s *Reservation) SetAttendees(val []ReservationAttendee) {
+	s.Attendees = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *Reservation) SetTimeZone(val string) {
+	s.TimeZone = val
+}
+
+// SetLocalStartsAt sets the value of LocalStartsAt.
+func (s *Reservation) SetLocalStartsAt(val time.Time) {
+	s.LocalStartsAt = val
+}
+
+// SetLocalEndsAt sets the value of LocalEndsAt.
+func (s *Reservation) SetLocalEndsAt(val time.Time) {
+	s.LocalEndsAt = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Reservation) SetStatus(val ReservationStatus) {
+	s.Status = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *Reservation) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetReviewedAt sets the value of ReviewedAt.
+func (s *Reservation) SetReviewedAt(val OptDateTime) {
+	s.ReviewedAt = val
+}
+
+// SetCheckedInAt sets the value of CheckedInAt.
+func (s *Reservation) SetCheckedInAt(val OptDateTime) {
+	s.CheckedInAt = val
+}
+
+// SetCheckedOutAt sets the value of CheckedOutAt.
+func (s *Reservation) SetCheckedOutAt(val OptDateTime) {
+	s.CheckedOutAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Reservation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Reservation) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Reservation) adminReservationsApproveRes() {}
+func (*Reservation) adminReservationsRejectRes()  {}
+func (*Reservation) holdsConfirmRes()             {}
+func (*Reservation) reservationsCancelRes()       {}
+func (*Reservation) reservationsCheckInRes()      {}
+func (*Reservation) reservationsCheckOutRes()     {}
+func (*Reservation) reservationsCreateRes()       {}
+func (*Reservation) reservationsRetrieveRes()     {}
+func (*Reservation) reservationsUpdateRes()       {}
+
+// A person attending a reservation besides its owner, either a user or an external email address.
+// Ref: #/components/schemas/ReservationAttendee
+type ReservationAttendee struct {
+	// ID of an attending user. Omit for external attendees.
+	UserID OptUUID `json:"user_id"`
+	// Email address of an external attendee. Omit for attending users.
+	Email OptEmailString `json:"email"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *ReservationAttendee) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// GetEmail returns the value of Email.
+func (s *ReservationAttendee) GetEmail() OptEmailString {
+	return s.Email
+}
+
+// SetUserID sets the value of UserID.
+func (s *ReservationAttendee) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ReservationAttendee) SetEmail(val OptEmailString) {
+	s.Email = val
+}
+
+// Reservations of several facilities made, cancelled and rescheduled together.
+// Ref: #/components/schemas/ReservationBundle
+type ReservationBundle struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who owns the bundle.
+	UserID uuid.UUID `json:"user_id"`
+	// Short summary of the purpose of the reservations.
+	Title string `json:"title"`
+	// Optional details of the reservations.
+	Description OptString         `json:"description"`
+	Status      ReservationStatus `json:"status"`
+	// Time the bundle was cancelled. Omitted while the bundle is confirmed.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	// Reservations of the bundle ordered by start time, including those cancelled.
+	Reservations []Reservation `json:"reservations"`
+}
+
+// GetID returns the value of ID.
+func (s *ReservationBundle) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *ReservationBundle) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetTitle returns the value of Title.
+func (s *ReservationBundle) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *ReservationBundle) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStatus returns the value of Status.
+func (s *ReservationBundle) GetStatus() ReservationStatus {
+	return s.Status
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *ReservationBundle) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReservationBundle) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ReservationBundle) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetReservations returns the value of Reservations.
+func (s *ReservationBundle) GetReservations() []Reservation {
+	return s.Reservations
+}
+
+// SetID sets the value of ID.
+func (s *ReservationBundle) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *ReservationBundle) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationBundle) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ReservationBundle) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReservationBundle) SetStatus(val ReservationStatus) {
+	s.Status = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *ReservationBundle) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReservationBundle) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ReservationBundle) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetReservations sets the value of Reservations.
+func (s *ReservationBundle) SetReservations(val []Reservation) {
+	s.Reservations = val
+}
+
+func (*ReservationBundle) reservationBundlesCancelRes()     {}
+func (*ReservationBundle) reservationBundlesCreateRes()     {}
+func (*ReservationBundle) reservationBundlesRescheduleRes() {}
+func (*ReservationBundle) reservationBundlesRetrieveRes()   {}
+
+// A facility reserved as part of a bundle.
+// Ref: #/components/schemas/ReservationBundleComponent
+type ReservationBundleComponent struct {
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Start of the reserved period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the reserved period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *ReservationBundleComponent) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationBundleComponent) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *ReservationBundleComponent) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *ReservationBundleComponent) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationBundleComponent) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *ReservationBundleComponent) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// Fields of a reservation bundle that can be set by its owner.
+// Ref: #/components/schemas/ReservationBundleInput
+type ReservationBundleInput struct {
+	// Short summary of the purpose of the reservations.
+	Title string `json:"title"`
+	// Optional details of the reservations.
+	Description OptString `json:"description"`
+	// Facilities to reserve together. Either every one of them is reserved or none is.
+	Components []ReservationBundleComponent `json:"components"`
+}
+
+// GetTitle returns the value of Title.
+func (s *ReservationBundleInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *ReservationBundleInput) GetDescription() OptString {
+	return s.Description
+}
+
+// GetComponents returns the value of Components.
+func (s *ReservationBundleInput) GetComponents() []ReservationBundleComponent {
+	return s.Components
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationBundleInput) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ReservationBundleInput) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetComponents sets the value of Components.
+func (s *ReservationBundleInput) SetComponents(val []ReservationBundleComponent) {
+	s.Components = val
+}
+
+// New period of a reservation bundle.
+// Ref: #/components/schemas/ReservationBundleReschedule
+type ReservationBundleReschedule struct {
+	// New start of the earliest reservation of the bundle. Every reservation is moved by the same
+	// distance.
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationBundleReschedule) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationBundleReschedule) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+type ReservationBundlesCancelConflict ProblemDetails
+
+func (*ReservationBundlesCancelConflict) reservationBundlesCancelRes() {}
+
+type ReservationBundlesCancelNotFound ProblemDetails
+
+func (*ReservationBundlesCancelNotFound) reservationBundlesCancelRes() {}
+
+type ReservationBundlesCancelUnauthorized ProblemDetails
+
+func (*ReservationBundlesCancelUnauthorized) reservationBundlesCancelRes() {}
+
+type ReservationBundlesCreateBadRequest ProblemDetails
+
+func (*ReservationBundlesCreateBadRequest) reservationBundlesCreateRes() {}
+
+type ReservationBundlesCreateConflict ProblemDetails
+
+func (*ReservationBundlesCreateConflict) reservationBundlesCreateRes() {}
+
+type ReservationBundlesCreateUnauthorized ProblemDetails
+
+func (*ReservationBundlesCreateUnauthorized) reservationBundlesCreateRes() {}
+
+type ReservationBundlesListOKApplicationJSON []ReservationBundle
+
+func (*ReservationBundlesListOKApplicationJSON) reservationBundlesListRes() {}
+
+type ReservationBundlesRescheduleBadRequest ProblemDetails
+
+func (*ReservationBundlesRescheduleBadRequest) reservationBundlesRescheduleRes() {}
+
+type ReservationBundlesRescheduleConflict ProblemDetails
+
+func (*ReservationBundlesRescheduleConflict) reservationBundlesRescheduleRes() {}
+
+type ReservationBundlesRescheduleNotFound ProblemDetails
+
+func (*ReservationBundlesRescheduleNotFound) reservationBundlesRescheduleRes() {}
+
+type ReservationBundlesRescheduleUnauthorized ProblemDetails
+
+func (*ReservationBundlesRescheduleUnauthorized) reservationBundlesRescheduleRes() {}
+
+type ReservationBundlesRetrieveNotFound ProblemDetails
+
+func (*ReservationBundlesRetrieveNotFound) reservationBundlesRetrieveRes() {}
+
+type ReservationBundlesRetrieveUnauthorized ProblemDetails
+
+func (*ReservationBundlesRetrieveUnauthorized) reservationBundlesRetrieveRes() {}
+
+// Fields of a reservation that can be set by its owner or their delegates.
+// Ref: #/components/schemas/ReservationInput
+type ReservationInput struct {
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservation.
+	Title string `json:"title"`
+	// Optional details of the reservation.
+	Description OptString `json:"description"`
+	// Start of the reserved period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the reserved period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// People attending the reservation besides its owner, each listed once. Attending users see the
+	// reservation
+	// in their listing. Together with the owner they must not exceed the capacity of the facility.
+	Attendees []ReservationAttendee `json:"attendees"`
+	// ID of the user to book the reservation for. Defaults to the authenticated user. Booking for other
+	// users
+	// requires their delegation grant unless made by staff. Ignored when an existing reservation is
+	// changed.
+	UserID OptUUID `json:"user_id"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *ReservationInput) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *ReservationInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *ReservationInput) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationInput) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *ReservationInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetAttendees returns the value of Attendees.
+func (s *ReservationInput) GetAttendees() []ReservationAttendee {
+	return s.Attendees
+}
+
+// GetUserID returns the value of UserID.
+func (s *ReservationInput) GetUserID() OptUUID {
+	return s.UserID
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *ReservationInput) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationInput) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ReservationInput) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *ReservationInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetAttendees sets the value of Attendees.
+func (s *ReservationInput) SetAttendees(val []ReservationAttendee) {
+	s.Attendees = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *ReservationInput) SetUserID(val OptUUID) {
+	s.UserID = val
+}
+
+// A recurring reservation series whose occurrences are stored as reservations.
+// Ref: #/components/schemas/ReservationSeries
+type ReservationSeries struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who owns the series.
+	UserID uuid.UUID `json:"user_id"`
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservations.
+	Title string `json:"title"`
+	// Optional details of the reservations.
+	Description OptString `json:"description"`
+	// Start of the first occurrence (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the first occurrence (exclusive). Every occurrence lasts as long as the first one.
+	EndsAt time.Time `json:"ends_at"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	Rrule string `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Defaults to the time zone of the
+	// facility.
+	TimeZone OptString         `json:"time_zone"`
+	Status   ReservationStatus `json:"status"`
+	// Time the series was cancelled. Omitted while the series is confirmed.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	// Confirmed occurrences ordered by start time.
+	Occurrences []Reservation `json:"occurrences"`
+}
+
+// GetID returns the value of ID.
+func (s *ReservationSeries) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *ReservationSeries) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *ReservationSeries) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *ReservationSeries) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *ReservationSeries) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationSeries) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *ReservationSeries) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetRrule returns the value of Rrule.
+func (s *ReservationSeries) GetRrule() string {
+	return s.Rrule
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *ReservationSeries) GetTimeZone() OptString {
+	return s.TimeZone
+}
+
+// GetStatus returns the value of Status.
+func (s *ReservationSeries) GetStatus() ReservationStatus {
+	return s.Status
+}
+
+// GetCancelledAt returns the value of CancelledAt.
+func (s *ReservationSeries) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReservationSeries) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ReservationSeries) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// GetOccurrences returns the value of Occurrences.
+func (s *ReservationSeries) GetOccurrences() []Reservation {
+	return s.Occurrences
+}
+
+// SetID sets the value of ID.
+func (s *ReservationSeries) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *ReservationSeries) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *ReservationSeries) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationSeries) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ReservationSeries) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationSeries) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *ReservationSeries) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetRrule sets the value of Rrule.
+func (s *ReservationSeries) SetRrule(val string) {
+	s.Rrule = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *ReservationSeries) SetTimeZone(val OptString) {
+	s.TimeZone = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReservationSeries) SetStatus(val ReservationStatus) {
+	s.Status = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *ReservationSeries) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReservationSeries) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ReservationSeries) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetOccurrences sets the value of Occurrences.
+func (s *ReservationSeries) SetOccurrences(val []Reservation) {
+	s.Occurrences = val
+}
+
+func (*ReservationSeries) reservationSeriesCancelRes()         {}
+func (*ReservationSeries) reservationSeriesOccurrenceSkipRes() {}
+func (*ReservationSeries) reservationSeriesRetrieveRes()       {}
+
+type ReservationSeriesCancelConflict ProblemDetails
+
+func (*ReservationSeriesCancelConflict) reservationSeriesCancelRes() {}
+
+type ReservationSeriesCancelNotFound ProblemDetails
+
+func (*ReservationSeriesCancelNotFound) reservationSeriesCancelRes() {}
+
+type ReservationSeriesCancelUnauthorized ProblemDetails
+
+func (*ReservationSeriesCancelUnauthorized) reservationSeriesCancelRes() {}
+
+type ReservationSeriesCreateBadRequest ProblemDetails
+
+func (*ReservationSeriesCreateBadRequest) reservationSeriesCreateRes() {}
+
+type ReservationSeriesCreateConflict ProblemDetails
+
+func (*ReservationSeriesCreateConflict) reservationSeriesCreateRes() {}
+
+type ReservationSeriesCreateUnauthorized ProblemDetails
+
+func (*ReservationSeriesCreateUnauthorized) reservationSeriesCreateRes() {}
+
+// Fields of a recurring reservation series that can be set by its owner.
+// Ref: #/components/schemas/ReservationSeriesInput
+type ReservationSeriesInput struct {
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservations.
+	Title string `json:"title"`
+	// Optional details of the reservations.
+	Description OptString `json:"description"`
+	// Start of the first occurrence (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the first occurrence (exclusive). Every occurrence lasts as long as the first one.
+	EndsAt time.Time `json:"ends_at"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	Rrule string `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Defaults to the time zone of the
+	// facility.
+	TimeZone OptString `json:"time_zone"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *ReservationSeriesInput) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *ReservationSeriesInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *ReservationSeriesInput) GetDescription() OptString {
+	return s.Description
 }
 
-// GetIsActive returns the value of IsActive.
-func (s *PublicFacilityMergePatchUpdate) GetIsActive() OptPublicFacilityMergePatchUpdateIsActive {
-	return s.IsActive
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationSeriesInput) GetStartsAt() time.Time {
+	return s.StartsAt
 }
 
-// SetName sets the value of Name.
-func (s *PublicFacilityMergePatchUpdate) SetName(val OptString) {
-	s.Name = val
+// GetEndsAt returns the value of EndsAt.
+func (s *ReservationSeriesInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetRrule returns the value of Rrule.
+func (s *ReservationSeriesInput) GetRrule() string {
+	return s.Rrule
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *ReservationSeriesInput) GetTimeZone() OptString {
+	return s.TimeZone
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *ReservationSeriesInput) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationSeriesInput) SetTitle(val string) {
+	s.Title = val
 }
 
 // SetDescription sets the value of Description.
-func (s *PublicFacilityMergePatchUpdate) SetDescription(val OptPublicFacilityMergePatchUpdateDescription) {
+func (s *ReservationSeriesInput) SetDescription(val OptString) {
 	s.Description = val
 }
 
-// SetLocation sets the value of Location.
-func (s *PublicFacilityMergePatchUpdate) SetLocation(val OptPublicFacilityMergePatchUpdateLocation) {
-	s.Location = val
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationSeriesInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
 }
 
-// SetPriority sets the value of Priority.
-func (s *PublicFacilityMergePatchUpdate) SetPriority(val OptPublicFacilityMergePatchUpdatePriority) {
-	s.Priority = val
+// SetEndsAt sets the value of EndsAt.
+func (s *ReservationSeriesInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
 }
 
-// SetIsActive sets the value of IsActive.
-func (s *PublicFacilityMergePatchUpdate) SetIsActive(val OptPublicFacilityMergePatchUpdateIsActive) {
-	s.IsActive = val
+// SetRrule sets the value of Rrule.
+func (s *ReservationSeriesInput) SetRrule(val string) {
+	s.Rrule = val
 }
 
-// Optional description of the facility, including usage rules or details.
-// PublicFacilityMergePatchUpdateDescription represents sum type.
-type PublicFacilityMergePatchUpdateDescription struct {
-	Type   PublicFacilityMergePatchUpdateDescriptionType // switch on this field
-	String string
-	Null   struct{}
+// SetTimeZone sets the value of TimeZone.
+func (s *ReservationSeriesInput) SetTimeZone(val OptString) {
+	s.TimeZone = val
 }
 
-// PublicFacilityMergePatchUpdateDescriptionType is oneOf type of PublicFacilityMergePatchUpdateDescription.
-type PublicFacilityMergePatchUpdateDescriptionType string
+type ReservationSeriesListOKApplicationJSON []ReservationSeries
 
-// Possible values for PublicFacilityMergePatchUpdateDescriptionType.
-const (
-	StringPublicFacilityMergePatchUpdateDescription PublicFacilityMergePatchUpdateDescriptionType = "string"
-	NullPublicFacilityMergePatchUpdateDescription   PublicFacilityMergePatchUpdateDescriptionType = "struct{}"
-)
+func (*ReservationSeriesListOKApplicationJSON) reservationSeriesListRes() {}
 
-// IsString reports whether PublicFacilityMergePatchUpdateDescription is string.
-func (s PublicFacilityMergePatchUpdateDescription) IsString() bool {
-	return s.Type == StringPublicFacilityMergePatchUpdateDescription
+type ReservationSeriesOccurrenceSkipConflict ProblemDetails
+
+func (*ReservationSeriesOccurrenceSkipConflict) reservationSeriesOccurrenceSkipRes() {}
+
+type ReservationSeriesOccurrenceSkipNotFound ProblemDetails
+
+func (*ReservationSeriesOccurrenceSkipNotFound) reservationSeriesOccurrenceSkipRes() {}
+
+type ReservationSeriesOccurrenceSkipUnauthorized ProblemDetails
+
+func (*ReservationSeriesOccurrenceSkipUnauthorized) reservationSeriesOccurrenceSkipRes() {}
+
+type ReservationSeriesOccurrenceUpdateBadRequest ProblemDetails
+
+func (*ReservationSeriesOccurrenceUpdateBadRequest) reservationSeriesOccurrenceUpdateRes() {}
+
+type ReservationSeriesOccurrenceUpdateConflict ProblemDetails
+
+func (*ReservationSeriesOccurrenceUpdateConflict) reservationSeriesOccurrenceUpdateRes() {}
+
+type ReservationSeriesOccurrenceUpdateNotFound ProblemDetails
+
+func (*ReservationSeriesOccurrenceUpdateNotFound) reservationSeriesOccurrenceUpdateRes() {}
+
+type ReservationSeriesOccurrenceUpdateUnauthorized ProblemDetails
+
+func (*ReservationSeriesOccurrenceUpdateUnauthorized) reservationSeriesOccurrenceUpdateRes() {}
+
+type ReservationSeriesRetrieveNotFound ProblemDetails
+
+func (*ReservationSeriesRetrieveNotFound) reservationSeriesRetrieveRes() {}
+
+type ReservationSeriesRetrieveUnauthorized ProblemDetails
+
+func (*ReservationSeriesRetrieveUnauthorized) reservationSeriesRetrieveRes() {}
+
+type ReservationSeriesUpdateBadRequest ProblemDetails
+
+func (*ReservationSeriesUpdateBadRequest) reservationSeriesUpdateRes() {}
+
+type ReservationSeriesUpdateConflict ProblemDetails
+
+func (*ReservationSeriesUpdateConflict) reservationSeriesUpdateRes() {}
+
+type ReservationSeriesUpdateNotFound ProblemDetails
+
+func (*ReservationSeriesUpdateNotFound) reservationSeriesUpdateRes() {}
+
+type ReservationSeriesUpdateUnauthorized ProblemDetails
+
+func (*ReservationSeriesUpdateUnauthorized) reservationSeriesUpdateRes() {}
+
+// A series together with the occurrences skipped because of conflicts.
+// Ref: #/components/schemas/ReservationSeriesWithSkipped
+type ReservationSeriesWithSkipped struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who owns the series.
+	UserID uuid.UUID `json:"user_id"`
+	// ID of the reserved facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservations.
+	Title string `json:"title"`
+	// Optional details of the reservations.
+	Description OptString `json:"description"`
+	// Start of the first occurrence (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the first occurrence (exclusive). Every occurrence lasts as long as the first one.
+	EndsAt time.Time `json:"ends_at"`
+	// RFC 5545 recurrence rule without DTSTART, e.g. FREQ=WEEKLY;BYDAY=MO;COUNT=10. COUNT or UNTIL is
+	// required.
+	Rrule string `json:"rrule"`
+	// IANA time zone in which the rule is expanded, e.g. Asia/Tokyo. Defaults to the time zone of the
+	// facility.
+	TimeZone OptString         `json:"time_zone"`
+	Status   ReservationStatus `json:"status"`
+	// Time the series was cancelled. Omitted while the series is confirmed.
+	CancelledAt OptDateTime `json:"cancelled_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	// Confirmed occurrences ordered by start time.
+	Occurrences []Reservation `json:"occurrences"`
+	// Occurrences that were not created because they overlap existing reservations or blackouts, or fall
+	// outside the
+	// opening hours of the facility.
+	Skipped []OccurrencePeriod `json:"skipped"`
 }
 
-// IsNull reports whether PublicFacilityMergePatchUpdateDescription is struct{}.
-func (s PublicFacilityMergePatchUpdateDescription) IsNull() bool {
-	return s.Type == NullPublicFacilityMergePatchUpdateDescription
+// GetID returns the value of ID.
+func (s *ReservationSeriesWithSkipped) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetString sets PublicFacilityMergePatchUpdateDescription to string.
-func (s *PublicFacilityMergePatchUpdateDescription) SetString(v string) {
-	s.Type = StringPublicFacilityMergePatchUpdateDescription
-	s.String = v
+// GetUserID returns the value of UserID.
+func (s *ReservationSeriesWithSkipped) GetUserID() uuid.UUID {
+	return s.UserID
 }
 
-// GetString returns string and true boolean if PublicFacilityMergePatchUpdateDescription is string.
-func (s PublicFacilityMergePatchUpdateDescription) GetString() (v string, ok bool) {
-	if !s.IsString() {
-		return v, false
-	}
-	return s.String, true
+// GetFacilityID returns the value of FacilityID.
+func (s *ReservationSeriesWithSkipped) GetFacilityID() int {
+	return s.FacilityID
 }
 
-// NewStringPublicFacilityMergePatchUpdateDescription returns new PublicFacilityMergePatchUpdateDescription from string.
-func NewStringPublicFacilityMergePatchUpdateDescription(v string) PublicFacilityMergePatchUpdateDescription {
-	var s PublicFacilityMergePatchUpdateDescription
-	s.SetString(v)
-	return s
+// GetTitle returns the value of Title.
+func (s *ReservationSeriesWithSkipped) GetTitle() string {
+	return s.Title
 }
 
-// SetNull sets PublicFacilityMergePatchUpdateDescription to struct{}.
-func (s *PublicFacilityMergePatchUpdateDescription) SetNull(v struct{}) {
-	s.Type = NullPublicFacilityMergePatchUpdateDescription
-	s.Null = v
+// GetDescription returns the value of Description.
+func (s *ReservationSeriesWithSkipped) GetDescription() OptString {
+	return s.Description
 }
 
-// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateDescription is struct{}.
-func (s PublicFacilityMergePatchUpdateDescription) GetNull() (v struct{}, ok bool) {
-	if !s.IsNull() {
-		return v, false
-	}
-	return s.Null, true
+// GetStartsAt returns the value of StartsAt.
+func (s *ReservationSeriesWithSkipped) GetStartsAt() time.Time {
+	return s.StartsAt
 }
 
-// NewNullPublicFacilityMergePatchUpdateDescription returns new PublicFacilityMergePatchUpdateDescription from struct{}.
-func NewNullPublicFacilityMergePatchUpdateDescription(v struct{}) PublicFacilityMergePatchUpdateDescription {
-	var s PublicFacilityMergePatchUpdateDescription
-	s.SetNull(v)
-	return s
+// GetEndsAt returns the value of EndsAt.
+func (s *ReservationSeriesWithSkipped) GetEndsAt() time.Time {
+	return s.EndsAt
 }
 
-// Set to false to disable this facility from public listing or reservation.
-// PublicFacilityMergePatchUpdateIsActive represents sum type.
-type PublicFacilityMergePatchUpdateIsActive struct {
-	Type PublicFacilityMergePatchUpdateIsActiveType // switch on this field
-	Bool bool
-	Null struct{}
+// GetRrule returns the value of Rrule.
+func (s *ReservationSeriesWithSkipped) GetRrule() string {
+	return s.Rrule
 }
 
-// PublicFacilityMergePatchUpdateIsActiveType is oneOf type of PublicFacilityMergePatchUpdateIsActive.
-type PublicFacilityMergePatchUpdateIsActiveType string
+// GetTimeZone returns the value of TimeZone.
+func (s *ReservationSeriesWithSkipped) GetTimeZone() OptString {
+	return s.TimeZone
+}
 
-// Possible values for PublicFacilityMergePatchUpdateIsActiveType.
-const (
-	BoolPublicFacilityMergePatchUpdateIsActive PublicFacilityMergePatchUpdateIsActiveType = "bool"
-	NullPublicFacilityMergePatchUpdateIsActive PublicFacilityMergePatchUpdateIsActiveType = "struct{}"
-)
+// GetStatus returns the value of Status.
+func (s *ReservationSeriesWithSkipped) GetStatus() ReservationStatus {
+	return s.Status
+}
 
-// IsBool reports whether PublicFacilityMergePatchUpdateIsActive is bool.
-func (s PublicFacilityMergePatchUpdateIsActive) IsBool() bool {
-	return s.Type == BoolPublicFacilityMergePatchUpdateIsActive
+// GetCancelledAt returns the value of CancelledAt.
+func (s *ReservationSeriesWithSkipped) GetCancelledAt() OptDateTime {
+	return s.CancelledAt
 }
 
-// IsNull reports whether PublicFacilityMergePatchUpdateIsActive is struct{}.
-func (s PublicFacilityMergePatchUpdateIsActive) IsNull() bool {
-	return s.Type == NullPublicFacilityMergePatchUpdateIsActive
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReservationSeriesWithSkipped) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetBool sets PublicFacilityMergePatchUpdateIsActive to bool.
-func (s *PublicFacilityMergePatchUpdateIsActive) SetBool(v bool) {
-	s.Type = BoolPublicFacilityMergePatchUpdateIsActive
-	s.Bool = v
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ReservationSeriesWithSkipped) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// GetBool returns bool and true boolean if PublicFacilityMergePatchUpdateIsActive is bool.
-func (s PublicFacilityMergePatchUpdateIsActive) GetBool() (v bool, ok bool) {
-	if !s.IsBool() {
-		return v, false
+// GetOccurrences returns the value of Occurrences.
+func (s *ReservationSeriesWithSkipped) GetOccurrences() []Reservation {
+	return s.Occurrences
+}
+
+// GetSkipped returns the value of Skipped.
+func (s *ReservationSeriesWithSkipped) GetSkipped() []OccurrencePeriod {
+	return s.Skipped
+}
+
+// SetID sets the value of ID.
+func (s *ReservationSeriesWithSkipped) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *ReservationSeriesWithSkipped) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *ReservationSeriesWithSkipped) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReservationSeriesWithSkipped) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ReservationSeriesWithSkipped) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *ReservationSeriesWithSkipped) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *ReservationSeriesWithSkipped) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetRrule sets the value of Rrule.
+func (s *ReservationSeriesWithSkipped) SetRrule(val string) {
+	s.Rrule = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *ReservationSeriesWithSkipped) SetTimeZone(val OptString) {
+	s.TimeZone = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReservationSeriesWithSkipped) SetStatus(val ReservationStatus) {
+	s.Status = val
+}
+
+// SetCancelledAt sets the value of CancelledAt.
+func (s *ReservationSeriesWithSkipped) SetCancelledAt(val OptDateTime) {
+	s.CancelledAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReservationSeriesWithSkipped) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ReservationSeriesWithSkipped) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+// SetOccurrences sets the value of Occurrences.
+func (s *ReservationSeriesWithSkipped) SetOccurrences(val []Reservation) {
+	s.Occurrences = val
+}
+
+// SetSkipped sets the value of Skipped.
+func (s *ReservationSeriesWithSkipped) SetSkipped(val []OccurrencePeriod) {
+	s.Skipped = val
+}
+
+func (*ReservationSeriesWithSkipped) reservationSeriesCreateRes()           {}
+func (*ReservationSeriesWithSkipped) reservationSeriesOccurrenceUpdateRes() {}
+func (*ReservationSeriesWithSkipped) reservationSeriesUpdateRes()           {}
+
+// Lifecycle state of a reservation. Reservations of facilities requiring approval start as `pending`
+// and become
+// `confirmed` when approved, `rejected` when rejected or `expired` once they start without a decision.
+// `held` marks a hold that has not been confirmed yet. `no_show` marks a reservation released because
+// it was not
+// checked in within the grace period of its facility. Only confirmed, pending and held reservations
+// occupy their
+// facility.
+// Ref: #/components/schemas/ReservationStatus
+type ReservationStatus string
+
+const (
+	ReservationStatusConfirmed ReservationStatus = "confirmed"
+	ReservationStatusCancelled ReservationStatus = "cancelled"
+	ReservationStatusPending   ReservationStatus = "pending"
+	ReservationStatusRejected  ReservationStatus = "rejected"
+	ReservationStatusExpired   ReservationStatus = "expired"
+	ReservationStatusHeld      ReservationStatus = "held"
+	ReservationStatusNoShow    ReservationStatus = "no_show"
+)
+
+// AllValues returns all ReservationStatus values.
+func (ReservationStatus) AllValues() []ReservationStatus {
+	return []ReservationStatus{
+		ReservationStatusConfirmed,
+		ReservationStatusCancelled,
+		ReservationStatusPending,
+		ReservationStatusRejected,
+		ReservationStatusExpired,
+		ReservationStatusHeld,
+		ReservationStatusNoShow,
 	}
-	return s.Bool, true
 }
 
-// NewBoolPublicFacilityMergePatchUpdateIsActive returns new PublicFacilityMergePatchUpdateIsActive from bool.
-func NewBoolPublicFacilityMergePatchUpdateIsActive(v bool) PublicFacilityMergePatchUpdateIsActive {
-	var s PublicFacilityMergePatchUpdateIsActive
-	s.SetBool(v)
-	return s
+// MarshalText implements encoding.TextMarshaler.
+func (s ReservationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReservationStatusConfirmed:
+		return []byte(s), nil
+	case ReservationStatusCancelled:
+		return []byte(s), nil
+	case ReservationStatusPending:
+		return []byte(s), nil
+	case ReservationStatusRejected:
+		return []byte(s), nil
+	case ReservationStatusExpired:
+		return []byte(s), nil
+	case ReservationStatusHeld:
+		return []byte(s), nil
+	case ReservationStatusNoShow:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReservationStatus) UnmarshalText(data []byte) error {
+	switch ReservationStatus(data) {
+	case ReservationStatusConfirmed:
+		*s = ReservationStatusConfirmed
+		return nil
+	case ReservationStatusCancelled:
+		*s = ReservationStatusCancelled
+		return nil
+	case ReservationStatusPending:
+		*s = ReservationStatusPending
+		return nil
+	case ReservationStatusRejected:
+		*s = ReservationStatusRejected
+		return nil
+	case ReservationStatusExpired:
+		*s = ReservationStatusExpired
+		return nil
+	case ReservationStatusHeld:
+		*s = ReservationStatusHeld
+		return nil
+	case ReservationStatusNoShow:
+		*s = ReservationStatusNoShow
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetNull sets PublicFacilityMergePatchUpdateIsActive to struct{}.
-func (s *PublicFacilityMergePatchUpdateIsActive) SetNull(v struct{}) {
-	s.Type = NullPublicFacilityMergePatchUpdateIsActive
-	s.Null = v
-}
+type ReservationsCancelConflict ProblemDetails
+
+func (*ReservationsCancelConflict) reservationsCancelRes() {}
+
+type ReservationsCancelNotFound ProblemDetails
+
+func (*ReservationsCancelNotFound) reservationsCancelRes() {}
+
+type ReservationsCancelUnauthorized ProblemDetails
+
+func (*ReservationsCancelUnauthorized) reservationsCancelRes() {}
+
+type ReservationsCheckInConflict ProblemDetails
+
+func (*ReservationsCheckInConflict) reservationsCheckInRes() {}
+
+type ReservationsCheckInNotFound ProblemDetails
+
+func (*ReservationsCheckInNotFound) reservationsCheckInRes() {}
+
+type ReservationsCheckInUnauthorized ProblemDetails
+
+func (*ReservationsCheckInUnauthorized) reservationsCheckInRes() {}
+
+type ReservationsCheckOutConflict ProblemDetails
+
+func (*ReservationsCheckOutConflict) reservationsCheckOutRes() {}
+
+type ReservationsCheckOutNotFound ProblemDetails
+
+func (*ReservationsCheckOutNotFound) reservationsCheckOutRes() {}
+
+type ReservationsCheckOutUnauthorized ProblemDetails
+
+func (*ReservationsCheckOutUnauthorized) reservationsCheckOutRes() {}
+
+type ReservationsCreateBadRequest ProblemDetails
+
+func (*ReservationsCreateBadRequest) reservationsCreateRes() {}
+
+type ReservationsCreateConflict ProblemDetails
+
+func (*ReservationsCreateConflict) reservationsCreateRes() {}
+
+type ReservationsCreateForbidden ProblemDetails
+
+func (*ReservationsCreateForbidden) reservationsCreateRes() {}
+
+type ReservationsCreateUnauthorized ProblemDetails
 
-// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateIsActive is struct{}.
-func (s PublicFacilityMergePatchUpdateIsActive) GetNull() (v struct{}, ok bool) {
-	if !s.IsNull() {
-		return v, false
-	}
-	return s.Null, true
-}
+func (*ReservationsCreateUnauthorized) reservationsCreateRes() {}
 
-// NewNullPublicFacilityMergePatchUpdateIsActive returns new PublicFacilityMergePatchUpdateIsActive from struct{}.
-func NewNullPublicFacilityMergePatchUpdateIsActive(v struct{}) PublicFacilityMergePatchUpdateIsActive {
-	var s PublicFacilityMergePatchUpdateIsActive
-	s.SetNull(v)
-	return s
-}
+type ReservationsListBadRequest ProblemDetails
 
-// Physical location or address of the facility.
-// PublicFacilityMergePatchUpdateLocation represents sum type.
-type PublicFacilityMergePatchUpdateLocation struct {
-	Type   PublicFacilityMergePatchUpdateLocationType // switch on this field
-	String string
-	Null   struct{}
-}
+func (*ReservationsListBadRequest) reservationsListRes() {}
 
-// PublicFacilityMergePatchUpdateLocationType is oneOf type of PublicFacilityMergePatchUpdateLocation.
-type PublicFacilityMergePatchUpdateLocationType string
+type ReservationsListOKApplicationJSON []Reservation
 
-// Possible values for PublicFacilityMergePatchUpdateLocationType.
-const (
-	StringPublicFacilityMergePatchUpdateLocation PublicFacilityMergePatchUpdateLocationType = "string"
-	NullPublicFacilityMergePatchUpdateLocation   PublicFacilityMergePatchUpdateLocationType = "struct{}"
-)
+func (*ReservationsListOKApplicationJSON) reservationsListRes() {}
 
-// IsString reports whether PublicFacilityMergePatchUpdateLocation is string.
-func (s PublicFacilityMergePatchUpdateLocation) IsString() bool {
-	return s.Type == StringPublicFacilityMergePatchUpdateLocation
-}
+type ReservationsListUnauthorized ProblemDetails
 
-// IsNull reports whether PublicFacilityMergePatchUpdateLocation is struct{}.
-func (s PublicFacilityMergePatchUpdateLocation) IsNull() bool {
-	return s.Type == NullPublicFacilityMergePatchUpdateLocation
-}
+func (*ReservationsListUnauthorized) reservationsListRes() {}
 
-// SetString sets PublicFacilityMergePatchUpdateLocation to string.
-func (s *PublicFacilityMergePatchUpdateLocation) SetString(v string) {
-	s.Type = StringPublicFacilityMergePatchUpdateLocation
-	s.String = v
-}
+type ReservationsRetrieveNotFound ProblemDetails
 
-// GetString returns string and true boolean if PublicFacilityMergePatchUpdateLocation is string.
-func (s PublicFacilityMergePatchUpdateLocation) GetString() (v string, ok bool) {
-	if !s.IsString() {
-		return v, false
-	}
-	return s.String, true
-}
+func (*ReservationsRetrieveNotFound) reservationsRetrieveRes() {}
 
-// NewStringPublicFacilityMergePatchUpdateLocation returns new PublicFacilityMergePatchUpdateLocation from string.
-func NewStringPublicFacilityMergePatchUpdateLocation(v string) PublicFacilityMergePatchUpdateLocation {
-	var s PublicFacilityMergePatchUpdateLocation
-	s.SetString(v)
-	return s
-}
+type ReservationsRetrieveUnauthorized ProblemDetails
 
-// SetNull sets PublicFacilityMergePatchUpdateLocation to struct{}.
-func (s *PublicFacilityMergePatchUpdateLocation) SetNull(v struct{}) {
-	s.Type = NullPublicFacilityMergePatchUpdateLocation
-	s.Null = v
-}
+func (*ReservationsRetrieveUnauthorized) reservationsRetrieveRes() {}
 
-// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdateLocation is struct{}.
-func (s PublicFacilityMergePatchUpdateLocation) GetNull() (v struct{}, ok bool) {
-	if !s.IsNull() {
-		return v, false
-	}
-	return s.Null, true
-}
+type ReservationsUpdateBadRequest ProblemDetails
 
-// NewNullPublicFacilityMergePatchUpdateLocation returns new PublicFacilityMergePatchUpdateLocation from struct{}.
-func NewNullPublicFacilityMergePatchUpdateLocation(v struct{}) PublicFacilityMergePatchUpdateLocation {
-	var s PublicFacilityMergePatchUpdateLocation
-	s.SetNull(v)
-	return s
-}
+func (*ReservationsUpdateBadRequest) reservationsUpdateRes() {}
 
-// Display priority. Lower numbers appear earlier in sorted lists.
-// PublicFacilityMergePatchUpdatePriority represents sum type.
-type PublicFacilityMergePatchUpdatePriority struct {
-	Type  PublicFacilityMergePatchUpdatePriorityType // switch on this field
-	Int64 int64
-	Null  struct{}
-}
+type ReservationsUpdateConflict ProblemDetails
 
-// PublicFacilityMergePatchUpdatePriorityType is oneOf type of PublicFacilityMergePatchUpdatePriority.
-type PublicFacilityMergePatchUpdatePriorityType string
+func (*ReservationsUpdateConflict) reservationsUpdateRes() {}
 
-// Possible values for PublicFacilityMergePatchUpdatePriorityType.
-const (
-	Int64PublicFacilityMergePatchUpdatePriority PublicFacilityMergePatchUpdatePriorityType = "int64"
-	NullPublicFacilityMergePatchUpdatePriority  PublicFacilityMergePatchUpdatePriorityType = "struct{}"
-)
+type ReservationsUpdateNotFound ProblemDetails
 
-// IsInt64 reports whether PublicFacilityMergePatchUpdatePriority is int64.
-func (s PublicFacilityMergePatchUpdatePriority) IsInt64() bool {
-	return s.Type == Int64PublicFacilityMergePatchUpdatePriority
+func (*ReservationsUpdateNotFound) reservationsUpdateRes() {}
+
+type ReservationsUpdateUnauthorized ProblemDetails
+
+func (*ReservationsUpdateUnauthorized) reservationsUpdateRes() {}
+
+// Metadata of an API token. The token secret itself is never exposed.
+// Ref: #/components/schemas/TokenMetadata
+type TokenMetadata struct {
+	ID uuid.UUID `json:"id"`
+	// Human-readable name of the token.
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	// Expiration time of the token. Omitted when the token never expires.
+	ExpiresAt OptDateTime `json:"expires_at"`
 }
 
-// IsNull reports whether PublicFacilityMergePatchUpdatePriority is struct{}.
-func (s PublicFacilityMergePatchUpdatePriority) IsNull() bool {
-	return s.Type == NullPublicFacilityMergePatchUpdatePriority
+// GetID returns the value of ID.
+func (s *TokenMetadata) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetInt64 sets PublicFacilityMergePatchUpdatePriority to int64.
-func (s *PublicFacilityMergePatchUpdatePriority) SetInt64(v int64) {
-	s.Type = Int64PublicFacilityMergePatchUpdatePriority
-	s.Int64 = v
+// GetName returns the value of Name.
+func (s *TokenMetadata) GetName() string {
+	return s.Name
 }
 
-// GetInt64 returns int64 and true boolean if PublicFacilityMergePatchUpdatePriority is int64.
-func (s PublicFacilityMergePatchUpdatePriority) GetInt64() (v int64, ok bool) {
-	if !s.IsInt64() {
-		return v, false
-	}
-	return s.Int64, true
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TokenMetadata) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// NewInt64PublicFacilityMergePatchUpdatePriority returns new PublicFacilityMergePatchUpdatePriority from int64.
-func NewInt64PublicFacilityMergePatchUpdatePriority(v int64) PublicFacilityMergePatchUpdatePriority {
-	var s PublicFacilityMergePatchUpdatePriority
-	s.SetInt64(v)
-	return s
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *TokenMetadata) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// SetNull sets PublicFacilityMergePatchUpdatePriority to struct{}.
-func (s *PublicFacilityMergePatchUpdatePriority) SetNull(v struct{}) {
-	s.Type = NullPublicFacilityMergePatchUpdatePriority
-	s.Null = v
+// SetID sets the value of ID.
+func (s *TokenMetadata) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// GetNull returns struct{} and true boolean if PublicFacilityMergePatchUpdatePriority is struct{}.
-func (s PublicFacilityMergePatchUpdatePriority) GetNull() (v struct{}, ok bool) {
-	if !s.IsNull() {
-		return v, false
-	}
-	return s.Null, true
+// SetName sets the value of Name.
+func (s *TokenMetadata) SetName(val string) {
+	s.Name = val
 }
 
-// NewNullPublicFacilityMergePatchUpdatePriority returns new PublicFacilityMergePatchUpdatePriority from struct{}.
-func NewNullPublicFacilityMergePatchUpdatePriority(v struct{}) PublicFacilityMergePatchUpdatePriority {
-	var s PublicFacilityMergePatchUpdatePriority
-	s.SetNull(v)
-	return s
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TokenMetadata) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *TokenMetadata) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
 // Ref: #/components/schemas/UnexpectedError
@@ -1506,12 +6805,14 @@ type UnexpectedErrorCode string
 
 const (
 	UnexpectedErrorCodeINTERNALSERVERERROR UnexpectedErrorCode = "INTERNAL_SERVER_ERROR"
+	UnexpectedErrorCodeUNAUTHORIZED        UnexpectedErrorCode = "UNAUTHORIZED"
 )
 
 // AllValues returns all UnexpectedErrorCode values.
 func (UnexpectedErrorCode) AllValues() []UnexpectedErrorCode {
 	return []UnexpectedErrorCode{
 		UnexpectedErrorCodeINTERNALSERVERERROR,
+		UnexpectedErrorCodeUNAUTHORIZED,
 	}
 }
 
@@ -1520,6 +6821,8 @@ func (s UnexpectedErrorCode) MarshalText() ([]byte, error) {
 	switch s {
 	case UnexpectedErrorCodeINTERNALSERVERERROR:
 		return []byte(s), nil
+	case UnexpectedErrorCodeUNAUTHORIZED:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -1531,6 +6834,9 @@ func (s *UnexpectedErrorCode) UnmarshalText(data []byte) error {
 	case UnexpectedErrorCodeINTERNALSERVERERROR:
 		*s = UnexpectedErrorCodeINTERNALSERVERERROR
 		return nil
+	case UnexpectedErrorCodeUNAUTHORIZED:
+		*s = UnexpectedErrorCodeUNAUTHORIZED
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -1561,3 +6867,235 @@ func (s *UnexpectedErrorStatusCode) SetStatusCode(val int) {
 func (s *UnexpectedErrorStatusCode) SetResponse(val UnexpectedError) {
 	s.Response = val
 }
+
+type WaitlistCreateBadRequest ProblemDetails
+
+func (*WaitlistCreateBadRequest) waitlistCreateRes() {}
+
+type WaitlistCreateConflict ProblemDetails
+
+func (*WaitlistCreateConflict) waitlistCreateRes() {}
+
+type WaitlistCreateUnauthorized ProblemDetails
+
+func (*WaitlistCreateUnauthorized) waitlistCreateRes() {}
+
+type WaitlistDestroyConflict ProblemDetails
+
+func (*WaitlistDestroyConflict) waitlistDestroyRes() {}
+
+// WaitlistDestroyNoContent is response for WaitlistDestroy operation.
+type WaitlistDestroyNoContent struct{}
+
+func (*WaitlistDestroyNoContent) waitlistDestroyRes() {}
+
+type WaitlistDestroyNotFound ProblemDetails
+
+func (*WaitlistDestroyNotFound) waitlistDestroyRes() {}
+
+type WaitlistDestroyUnauthorized ProblemDetails
+
+func (*WaitlistDestroyUnauthorized) waitlistDestroyRes() {}
+
+// A request on the waitlist of a facility, promoted to a reservation once a conflicting one releases
+// its period.
+// Ref: #/components/schemas/WaitlistEntry
+type WaitlistEntry struct {
+	ID uuid.UUID `json:"id"`
+	// ID of the user who joined the waitlist.
+	UserID uuid.UUID `json:"user_id"`
+	// ID of the requested facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservation.
+	Title string `json:"title"`
+	// Optional details of the reservation.
+	Description OptString `json:"description"`
+	// Start of the requested period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the requested period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+	// ID of the reservation the entry was promoted to. Omitted while the entry is waiting.
+	ReservationID OptUUID `json:"reservation_id"`
+	// Time the entry was promoted to a reservation. Omitted while the entry is waiting.
+	PromotedAt OptDateTime `json:"promoted_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *WaitlistEntry) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserID returns the value of UserID.
+func (s *WaitlistEntry) GetUserID() uuid.UUID {
+	return s.UserID
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *WaitlistEntry) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *WaitlistEntry) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *WaitlistEntry) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *WaitlistEntry) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *WaitlistEntry) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// GetReservationID returns the value of ReservationID.
+func (s *WaitlistEntry) GetReservationID() OptUUID {
+	return s.ReservationID
+}
+
+// GetPromotedAt returns the value of PromotedAt.
+func (s *WaitlistEntry) GetPromotedAt() OptDateTime {
+	return s.PromotedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *WaitlistEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *WaitlistEntry) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *WaitlistEntry) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *WaitlistEntry) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *WaitlistEntry) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *WaitlistEntry) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *WaitlistEntry) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *WaitlistEntry) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+// SetReservationID sets the value of ReservationID.
+func (s *WaitlistEntry) SetReservationID(val OptUUID) {
+	s.ReservationID = val
+}
+
+// SetPromotedAt sets the value of PromotedAt.
+func (s *WaitlistEntry) SetPromotedAt(val OptDateTime) {
+	s.PromotedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *WaitlistEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*WaitlistEntry) waitlistCreateRes() {}
+
+// Reservation to make once the requested period of a facility becomes available.
+// Ref: #/components/schemas/WaitlistEntryInput
+type WaitlistEntryInput struct {
+	// ID of the requested facility.
+	FacilityID int `json:"facility_id"`
+	// Short summary of the purpose of the reservation.
+	Title string `json:"title"`
+	// Optional details of the reservation.
+	Description OptString `json:"description"`
+	// Start of the requested period (inclusive).
+	StartsAt time.Time `json:"starts_at"`
+	// End of the requested period (exclusive).
+	EndsAt time.Time `json:"ends_at"`
+}
+
+// GetFacilityID returns the value of FacilityID.
+func (s *WaitlistEntryInput) GetFacilityID() int {
+	return s.FacilityID
+}
+
+// GetTitle returns the value of Title.
+func (s *WaitlistEntryInput) GetTitle() string {
+	return s.Title
+}
+
+// GetDescription returns the value of Description.
+func (s *WaitlistEntryInput) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStartsAt returns the value of StartsAt.
+func (s *WaitlistEntryInput) GetStartsAt() time.Time {
+	return s.StartsAt
+}
+
+// GetEndsAt returns the value of EndsAt.
+func (s *WaitlistEntryInput) GetEndsAt() time.Time {
+	return s.EndsAt
+}
+
+// SetFacilityID sets the value of FacilityID.
+func (s *WaitlistEntryInput) SetFacilityID(val int) {
+	s.FacilityID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *WaitlistEntryInput) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetDescription sets the value of Description.
+func (s *WaitlistEntryInput) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStartsAt sets the value of StartsAt.
+func (s *WaitlistEntryInput) SetStartsAt(val time.Time) {
+	s.StartsAt = val
+}
+
+// SetEndsAt sets the value of EndsAt.
+func (s *WaitlistEntryInput) SetEndsAt(val time.Time) {
+	s.EndsAt = val
+}
+
+type WaitlistListBadRequest ProblemDetails
+
+func (*WaitlistListBadRequest) waitlistListRes() {}
+
+type WaitlistListOKApplicationJSON []WaitlistEntry
+
+func (*WaitlistListOKApplicationJSON) waitlistListRes() {}
+
+type WaitlistListUnauthorized ProblemDetails
+
+func (*WaitlistListUnauthorized) waitlistListRes() {}
@@ -8,6 +8,26 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AdminReservationsApprove implements admin_reservations_approve operation.
+	//
+	// Confirms a pending reservation request. Admin access required.
+	//
+	// POST /api/v1/admin/reservations/{id}/approve/
+	AdminReservationsApprove(ctx context.Context, params AdminReservationsApproveParams) (AdminReservationsApproveRes, error)
+	// AdminReservationsPendingList implements admin_reservations_pending_list operation.
+	//
+	// Returns reservation requests awaiting approval ordered by start time. Requests that started without
+	// a decision
+	// are expired first. Admin access required.
+	//
+	// GET /api/v1/admin/reservations/pending/
+	AdminReservationsPendingList(ctx context.Context, params AdminReservationsPendingListParams) (AdminReservationsPendingListRes, error)
+	// AdminReservationsReject implements admin_reservations_reject operation.
+	//
+	// Rejects a pending reservation request and releases its period. Admin access required.
+	//
+	// POST /api/v1/admin/reservations/{id}/reject/
+	AdminReservationsReject(ctx context.Context, params AdminReservationsRejectParams) (AdminReservationsRejectRes, error)
 	// AdminUsersCreate implements admin_users_create operation.
 	//
 	// Create a new user account. Admin access required.
@@ -32,6 +52,26 @@ type Handler interface {
 	//
 	// PATCH /api/v1/admin/users/{id}/
 	AdminUsersPartialUpdate(ctx context.Context, req *AdminUserMergePatchUpdate, params AdminUsersPartialUpdateParams) (AdminUsersPartialUpdateRes, error)
+	// AdminUsersQuotasDestroy implements admin_users_quotas_destroy operation.
+	//
+	// Removes the limits set for a single user so that the quota of every user applies again.
+	// Admin access required.
+	//
+	// DELETE /api/v1/admin/users/{id}/quotas/
+	AdminUsersQuotasDestroy(ctx context.Context, params AdminUsersQuotasDestroyParams) (AdminUsersQuotasDestroyRes, error)
+	// AdminUsersQuotasList implements admin_users_quotas_list operation.
+	//
+	// Returns the booking quotas a user is subject to together with their usage, organization-wide first.
+	// Admin access required.
+	//
+	// GET /api/v1/admin/users/{id}/quotas/
+	AdminUsersQuotasList(ctx context.Context, params AdminUsersQuotasListParams) (AdminUsersQuotasListRes, error)
+	// AdminUsersQuotasUpdate implements admin_users_quotas_update operation.
+	//
+	// Replaces the limits of a booking quota for a single user. Admin access required.
+	//
+	// PUT /api/v1/admin/users/{id}/quotas/
+	AdminUsersQuotasUpdate(ctx context.Context, req *BookingQuota, params AdminUsersQuotasUpdateParams) (AdminUsersQuotasUpdateRes, error)
 	// AdminUsersRetrieve implements admin_users_retrieve operation.
 	//
 	// Fetch details of a specific user by ID. Admin access required.
@@ -44,6 +84,157 @@ type Handler interface {
 	//
 	// PUT /api/v1/admin/users/{id}/
 	AdminUsersUpdate(ctx context.Context, req *AdminUser, params AdminUsersUpdateParams) (AdminUsersUpdateRes, error)
+	// AmenitiesCreate implements amenities_create operation.
+	//
+	// Adds an amenity to the catalogue. Only administrators are authorized.
+	//
+	// POST /api/v1/amenities/
+	AmenitiesCreate(ctx context.Context, req *Amenity) (AmenitiesCreateRes, error)
+	// AmenitiesDestroy implements amenities_destroy operation.
+	//
+	// Deletes an amenity and removes it from every facility offering it. Only administrators are
+	// authorized.
+	//
+	// DELETE /api/v1/amenities/{id}/
+	AmenitiesDestroy(ctx context.Context, params AmenitiesDestroyParams) (AmenitiesDestroyRes, error)
+	// AmenitiesList implements amenities_list operation.
+	//
+	// Returns the amenities facilities can offer ordered by name. No authentication required.
+	//
+	// GET /api/v1/amenities/
+	AmenitiesList(ctx context.Context) ([]Amenity, error)
+	// AmenitiesRetrieve implements amenities_retrieve operation.
+	//
+	// Returns an amenity. No authentication required.
+	//
+	// GET /api/v1/amenities/{id}/
+	AmenitiesRetrieve(ctx context.Context, params AmenitiesRetrieveParams) (AmenitiesRetrieveRes, error)
+	// AmenitiesUpdate implements amenities_update operation.
+	//
+	// Replaces the slug, name and description of an amenity. Only administrators are authorized.
+	//
+	// PUT /api/v1/amenities/{id}/
+	AmenitiesUpdate(ctx context.Context, req *Amenity, params AmenitiesUpdateParams) (AmenitiesUpdateRes, error)
+	// AvailabilityList implements availability_list operation.
+	//
+	// Returns free periods of active facilities within the given range and their opening hours, together
+	// with their
+	// blackouts. Free periods leave room for the setup and teardown buffers of the facility. No
+	// authentication
+	// required.
+	//
+	// GET /api/v1/availability/
+	AvailabilityList(ctx context.Context, params AvailabilityListParams) (AvailabilityListRes, error)
+	// BookingPolicyRetrieve implements booking_policy_retrieve operation.
+	//
+	// Returns the organization-wide booking policy inherited by every facility. No authentication
+	// required.
+	//
+	// GET /api/v1/booking-policy/
+	BookingPolicyRetrieve(ctx context.Context) (*BookingPolicy, error)
+	// BookingPolicyUpdate implements booking_policy_update operation.
+	//
+	// Replaces the organization-wide booking policy. Existing reservations are kept.
+	// Only administrators are authorized.
+	//
+	// PUT /api/v1/booking-policy/
+	BookingPolicyUpdate(ctx context.Context, req *BookingPolicy) (BookingPolicyUpdateRes, error)
+	// BookingQuotaRetrieve implements booking_quota_retrieve operation.
+	//
+	// Returns the limits on reservations of a user across every facility. No authentication required.
+	//
+	// GET /api/v1/booking-quota/
+	BookingQuotaRetrieve(ctx context.Context) (*BookingQuota, error)
+	// BookingQuotaUpdate implements booking_quota_update operation.
+	//
+	// Replaces the limits on reservations of a user across every facility. Existing reservations are kept.
+	// Only administrators are authorized.
+	//
+	// PUT /api/v1/booking-quota/
+	BookingQuotaUpdate(ctx context.Context, req *BookingQuota) (BookingQuotaUpdateRes, error)
+	// DelegationGrantsCreate implements delegation_grants_create operation.
+	//
+	// Lets another user book and manage reservations and series on behalf of the authenticated user.
+	//
+	// POST /api/v1/delegation-grants/
+	DelegationGrantsCreate(ctx context.Context, req *DelegationGrantInput) (DelegationGrantsCreateRes, error)
+	// DelegationGrantsDestroy implements delegation_grants_destroy operation.
+	//
+	// Revokes a delegation grant. Reservations booked by the delegate are kept. Only its grantor, its
+	// delegate and staff
+	// are authorized.
+	//
+	// DELETE /api/v1/delegation-grants/{id}/
+	DelegationGrantsDestroy(ctx context.Context, params DelegationGrantsDestroyParams) (DelegationGrantsDestroyRes, error)
+	// DelegationGrantsList implements delegation_grants_list operation.
+	//
+	// Returns delegation grants in the order they were made. Staff see all grants, other users those they
+	// gave or
+	// received.
+	//
+	// GET /api/v1/delegation-grants/
+	DelegationGrantsList(ctx context.Context) (DelegationGrantsListRes, error)
+	// FacilitiesAmenitiesUpdate implements facilities_amenities_update operation.
+	//
+	// Replaces the amenities a facility offers. Slugs of amenities that do not exist are rejected.
+	// Only administrators are authorized.
+	//
+	// PUT /api/v1/facilities/{id}/amenities/
+	FacilitiesAmenitiesUpdate(ctx context.Context, req *FacilityAmenities, params FacilitiesAmenitiesUpdateParams) (FacilitiesAmenitiesUpdateRes, error)
+	// FacilitiesBlackoutsCreate implements facilities_blackouts_create operation.
+	//
+	// Blocks a facility for a one-off or recurring window. Existing reservations are kept.
+	// Only administrators are authorized.
+	//
+	// POST /api/v1/facilities/{id}/blackouts/
+	FacilitiesBlackoutsCreate(ctx context.Context, req *BlackoutInput, params FacilitiesBlackoutsCreateParams) (FacilitiesBlackoutsCreateRes, error)
+	// FacilitiesBlackoutsDestroy implements facilities_blackouts_destroy operation.
+	//
+	// Deletes a blackout window of a facility. Only administrators are authorized.
+	//
+	// DELETE /api/v1/facilities/{id}/blackouts/{blackout_id}/
+	FacilitiesBlackoutsDestroy(ctx context.Context, params FacilitiesBlackoutsDestroyParams) (FacilitiesBlackoutsDestroyRes, error)
+	// FacilitiesBlackoutsList implements facilities_blackouts_list operation.
+	//
+	// Returns the blackout windows of a facility ordered by start time. No authentication required.
+	//
+	// GET /api/v1/facilities/{id}/blackouts/
+	FacilitiesBlackoutsList(ctx context.Context, params FacilitiesBlackoutsListParams) (FacilitiesBlackoutsListRes, error)
+	// FacilitiesBlackoutsRetrieve implements facilities_blackouts_retrieve operation.
+	//
+	// Returns a blackout window of a facility. No authentication required.
+	//
+	// GET /api/v1/facilities/{id}/blackouts/{blackout_id}/
+	FacilitiesBlackoutsRetrieve(ctx context.Context, params FacilitiesBlackoutsRetrieveParams) (FacilitiesBlackoutsRetrieveRes, error)
+	// FacilitiesBookingPolicyRetrieve implements facilities_booking_policy_retrieve operation.
+	//
+	// Returns the booking policy of a facility together with the rules in effect. No authentication
+	// required.
+	//
+	// GET /api/v1/facilities/{id}/booking-policy/
+	FacilitiesBookingPolicyRetrieve(ctx context.Context, params FacilitiesBookingPolicyRetrieveParams) (FacilitiesBookingPolicyRetrieveRes, error)
+	// FacilitiesBookingPolicyUpdate implements facilities_booking_policy_update operation.
+	//
+	// Replaces the booking policy of a facility. Omitted rules are inherited from the organization-wide
+	// default.
+	// Existing reservations are kept. Only administrators are authorized.
+	//
+	// PUT /api/v1/facilities/{id}/booking-policy/
+	FacilitiesBookingPolicyUpdate(ctx context.Context, req *BookingPolicy, params FacilitiesBookingPolicyUpdateParams) (FacilitiesBookingPolicyUpdateRes, error)
+	// FacilitiesBookingQuotaRetrieve implements facilities_booking_quota_retrieve operation.
+	//
+	// Returns the limits on reservations of a user of a facility. No authentication required.
+	//
+	// GET /api/v1/facilities/{id}/booking-quota/
+	FacilitiesBookingQuotaRetrieve(ctx context.Context, params FacilitiesBookingQuotaRetrieveParams) (FacilitiesBookingQuotaRetrieveRes, error)
+	// FacilitiesBookingQuotaUpdate implements facilities_booking_quota_update operation.
+	//
+	// Replaces the limits on reservations of a user of a facility. They apply in addition to the
+	// organization-wide quota.
+	// Existing reservations are kept. Only administrators are authorized.
+	//
+	// PUT /api/v1/facilities/{id}/booking-quota/
+	FacilitiesBookingQuotaUpdate(ctx context.Context, req *BookingQuota, params FacilitiesBookingQuotaUpdateParams) (FacilitiesBookingQuotaUpdateRes, error)
 	// FacilitiesCreate implements facilities_create operation.
 	//
 	// Creates a new facility. Only administrators are authorized.
@@ -58,10 +249,46 @@ type Handler interface {
 	FacilitiesDestroy(ctx context.Context, params FacilitiesDestroyParams) (FacilitiesDestroyRes, error)
 	// FacilitiesList implements facilities_list operation.
 	//
-	// Returns a list of all active facilities. No authentication required.
+	// Returns a page of facilities. Staff see inactive facilities too, other users only active ones. No
+	// authentication
+	// required.
 	//
 	// GET /api/v1/facilities/
-	FacilitiesList(ctx context.Context) ([]PublicFacility, error)
+	FacilitiesList(ctx context.Context, params FacilitiesListParams) (FacilitiesListRes, error)
+	// FacilitiesOpeningHoursOverridesDestroy implements facilities_opening_hours_overrides_destroy operation.
+	//
+	// Removes the opening hours override of a date so the weekly rules apply again.
+	// Only administrators are authorized.
+	//
+	// DELETE /api/v1/facilities/{id}/opening-hours/overrides/{date}/
+	FacilitiesOpeningHoursOverridesDestroy(ctx context.Context, params FacilitiesOpeningHoursOverridesDestroyParams) (FacilitiesOpeningHoursOverridesDestroyRes, error)
+	// FacilitiesOpeningHoursOverridesList implements facilities_opening_hours_overrides_list operation.
+	//
+	// Returns the date-specific opening hours of a facility ordered by date. No authentication required.
+	//
+	// GET /api/v1/facilities/{id}/opening-hours/overrides/
+	FacilitiesOpeningHoursOverridesList(ctx context.Context, params FacilitiesOpeningHoursOverridesListParams) (FacilitiesOpeningHoursOverridesListRes, error)
+	// FacilitiesOpeningHoursOverridesUpdate implements facilities_opening_hours_overrides_update operation.
+	//
+	// Sets the opening hours of a facility on a date, replacing its weekly rules for that day. Existing
+	// reservations
+	// are kept. Only administrators are authorized.
+	//
+	// PUT /api/v1/facilities/{id}/opening-hours/overrides/{date}/
+	FacilitiesOpeningHoursOverridesUpdate(ctx context.Context, req *OpeningHoursOverrideInput, params FacilitiesOpeningHoursOverridesUpdateParams) (FacilitiesOpeningHoursOverridesUpdateRes, error)
+	// FacilitiesOpeningHoursRetrieve implements facilities_opening_hours_retrieve operation.
+	//
+	// Returns the weekly opening hours of a facility. No authentication required.
+	//
+	// GET /api/v1/facilities/{id}/opening-hours/
+	FacilitiesOpeningHoursRetrieve(ctx context.Context, params FacilitiesOpeningHoursRetrieveParams) (FacilitiesOpeningHoursRetrieveRes, error)
+	// FacilitiesOpeningHoursUpdate implements facilities_opening_hours_update operation.
+	//
+	// Replaces the weekly opening hours of a facility. Existing reservations are kept.
+	// Only administrators are authorized.
+	//
+	// PUT /api/v1/facilities/{id}/opening-hours/
+	FacilitiesOpeningHoursUpdate(ctx context.Context, req *OpeningHours, params FacilitiesOpeningHoursUpdateParams) (FacilitiesOpeningHoursUpdateRes, error)
 	// FacilitiesPartialUpdate implements facilities_partial_update operation.
 	//
 	// Updates select fields of a facility. Only administrators are authorized.
@@ -74,18 +301,273 @@ type Handler interface {
 	//
 	// GET /api/v1/facilities/{id}/
 	FacilitiesRetrieve(ctx context.Context, params FacilitiesRetrieveParams) (FacilitiesRetrieveRes, error)
+	// FacilitiesSearchList implements facilities_search_list operation.
+	//
+	// Returns active facilities whose name, location or description contains any word of the query, or
+	// whose name is
+	// similar to it to tolerate typos, most relevant first. No authentication required.
+	//
+	// GET /api/v1/facilities/search/
+	FacilitiesSearchList(ctx context.Context, params FacilitiesSearchListParams) ([]FacilitySearchResult, error)
 	// FacilitiesUpdate implements facilities_update operation.
 	//
 	// Updates an existing facility. Only administrators are authorized.
 	//
 	// PUT /api/v1/facilities/{id}/
 	FacilitiesUpdate(ctx context.Context, req *PublicFacility, params FacilitiesUpdateParams) (FacilitiesUpdateRes, error)
+	// HoldsConfirm implements holds_confirm operation.
+	//
+	// Turns a hold into a reservation with the given details. Holds of facilities requiring approval
+	// become pending
+	// requests unless confirmed by staff. Only its owner and staff are authorized.
+	//
+	// POST /api/v1/holds/{id}/confirm/
+	HoldsConfirm(ctx context.Context, req *HoldConfirmation, params HoldsConfirmParams) (HoldsConfirmRes, error)
+	// HoldsCreate implements holds_create operation.
+	//
+	// Holds a period of a facility for the authenticated user while the reservation details are filled
+	// out.
+	// The period is checked like a new reservation and blocks other reservations until the hold is
+	// confirmed or expires.
+	//
+	// POST /api/v1/holds/
+	HoldsCreate(ctx context.Context, req *HoldInput) (HoldsCreateRes, error)
+	// HoldsDestroy implements holds_destroy operation.
+	//
+	// Releases the period of a hold. Only its owner and staff are authorized.
+	//
+	// DELETE /api/v1/holds/{id}/
+	HoldsDestroy(ctx context.Context, params HoldsDestroyParams) (HoldsDestroyRes, error)
+	// HoldsRetrieve implements holds_retrieve operation.
+	//
+	// Returns a hold that has not expired. Only its owner and staff are authorized.
+	//
+	// GET /api/v1/holds/{id}/
+	HoldsRetrieve(ctx context.Context, params HoldsRetrieveParams) (HoldsRetrieveRes, error)
+	// LocationsCreate implements locations_create operation.
+	//
+	// Creates a site, building or floor. Only administrators are authorized.
+	//
+	// POST /api/v1/locations/
+	LocationsCreate(ctx context.Context, req *Location) (LocationsCreateRes, error)
+	// LocationsDestroy implements locations_destroy operation.
+	//
+	// Deletes a location. Locations still containing other locations or facilities cannot be deleted.
+	// Only administrators are authorized.
+	//
+	// DELETE /api/v1/locations/{id}/
+	LocationsDestroy(ctx context.Context, params LocationsDestroyParams) (LocationsDestroyRes, error)
+	// LocationsList implements locations_list operation.
+	//
+	// Returns all locations ordered by name. No authentication required.
+	//
+	// GET /api/v1/locations/
+	LocationsList(ctx context.Context) ([]Location, error)
+	// LocationsRetrieve implements locations_retrieve operation.
+	//
+	// Returns a location. No authentication required.
+	//
+	// GET /api/v1/locations/{id}/
+	LocationsRetrieve(ctx context.Context, params LocationsRetrieveParams) (LocationsRetrieveRes, error)
+	// LocationsTreeList implements locations_tree_list operation.
+	//
+	// Returns the sites ordered by name together with the locations and active facilities they contain.
+	// No authentication required.
+	//
+	// GET /api/v1/locations/tree/
+	LocationsTreeList(ctx context.Context) ([]LocationTreeNode, error)
+	// LocationsUpdate implements locations_update operation.
+	//
+	// Renames a location or moves it to another parent. Only administrators are authorized.
+	//
+	// PUT /api/v1/locations/{id}/
+	LocationsUpdate(ctx context.Context, req *Location, params LocationsUpdateParams) (LocationsUpdateRes, error)
 	// MeRetrieve implements me_retrieve operation.
 	//
 	// Returns basic profile information of the currently authenticated user.
 	//
 	// GET /api/v1/me/
 	MeRetrieve(ctx context.Context) (MeRetrieveRes, error)
+	// ReservationBundlesCancel implements reservation_bundles_cancel operation.
+	//
+	// Cancels a confirmed bundle together with its confirmed reservations. Waitlist entries fitting the
+	// released
+	// periods are promoted. Only its owner, their delegates and staff are authorized.
+	//
+	// POST /api/v1/reservation-bundles/{id}/cancel/
+	ReservationBundlesCancel(ctx context.Context, params ReservationBundlesCancelParams) (ReservationBundlesCancelRes, error)
+	// ReservationBundlesCreate implements reservation_bundles_create operation.
+	//
+	// Reserves several facilities for the authenticated user at once. Every component is checked like a
+	// new reservation
+	// and the bundle is rejected as a whole when any of them fails, reporting the index of that component.
+	// Facilities requiring approval cannot be reserved by bundle.
+	//
+	// POST /api/v1/reservation-bundles/
+	ReservationBundlesCreate(ctx context.Context, req *ReservationBundleInput) (ReservationBundlesCreateRes, error)
+	// ReservationBundlesList implements reservation_bundles_list operation.
+	//
+	// Returns reservation bundles in the order they were made. Staff see all bundles, other users only
+	// their own and
+	// those of the users who granted them delegation.
+	//
+	// GET /api/v1/reservation-bundles/
+	ReservationBundlesList(ctx context.Context) (ReservationBundlesListRes, error)
+	// ReservationBundlesReschedule implements reservation_bundles_reschedule operation.
+	//
+	// Moves every confirmed reservation of a bundle by the same distance. The new periods are checked like
+	// changed
+	// reservations and the bundle is kept unchanged when any of them fails, reporting the index of that
+	// component.
+	// Only bundles whose reservations have not started can be rescheduled. Only its owner, their delegates
+	// and staff
+	// are authorized.
+	//
+	// POST /api/v1/reservation-bundles/{id}/reschedule/
+	ReservationBundlesReschedule(ctx context.Context, req *ReservationBundleReschedule, params ReservationBundlesRescheduleParams) (ReservationBundlesRescheduleRes, error)
+	// ReservationBundlesRetrieve implements reservation_bundles_retrieve operation.
+	//
+	// Returns a reservation bundle. Only its owner, their delegates and staff are authorized.
+	//
+	// GET /api/v1/reservation-bundles/{id}/
+	ReservationBundlesRetrieve(ctx context.Context, params ReservationBundlesRetrieveParams) (ReservationBundlesRetrieveRes, error)
+	// ReservationSeriesCancel implements reservation_series_cancel operation.
+	//
+	// Cancels a confirmed series together with its occurrences that have not started yet.
+	// Only its owner, their delegates and staff are authorized.
+	//
+	// POST /api/v1/reservation-series/{id}/cancel/
+	ReservationSeriesCancel(ctx context.Context, params ReservationSeriesCancelParams) (ReservationSeriesCancelRes, error)
+	// ReservationSeriesCreate implements reservation_series_create operation.
+	//
+	// Creates a recurring series for the authenticated user and expands it into reservations.
+	// Facilities requiring approval cannot be reserved by series.
+	//
+	// POST /api/v1/reservation-series/
+	ReservationSeriesCreate(ctx context.Context, req *ReservationSeriesInput, params ReservationSeriesCreateParams) (ReservationSeriesCreateRes, error)
+	// ReservationSeriesList implements reservation_series_list operation.
+	//
+	// Returns reservation series ordered by their first occurrence. Staff see all series, other users only
+	// their own
+	// and those of the users who granted them delegation.
+	//
+	// GET /api/v1/reservation-series/
+	ReservationSeriesList(ctx context.Context) (ReservationSeriesListRes, error)
+	// ReservationSeriesOccurrenceSkip implements reservation_series_occurrence_skip operation.
+	//
+	// Skips an occurrence of a confirmed series.
+	// With `this_and_following` the series ends before the occurrence, with `all` the whole series is
+	// cancelled.
+	// Only its owner, their delegates and staff are authorized.
+	//
+	// POST /api/v1/reservation-series/{id}/occurrences/{occurrence_id}/skip/
+	ReservationSeriesOccurrenceSkip(ctx context.Context, params ReservationSeriesOccurrenceSkipParams) (ReservationSeriesOccurrenceSkipRes, error)
+	// ReservationSeriesOccurrenceUpdate implements reservation_series_occurrence_update operation.
+	//
+	// Edits an upcoming occurrence of a confirmed series.
+	// With `this_and_following` the series is split and the edited occurrences form a new series,
+	// with `all` the change is applied to every upcoming occurrence keeping their distance to the edited
+	// one.
+	// Occurrences cannot have attendees. Only its owner, their delegates and staff are authorized.
+	//
+	// PUT /api/v1/reservation-series/{id}/occurrences/{occurrence_id}/
+	ReservationSeriesOccurrenceUpdate(ctx context.Context, req *ReservationInput, params ReservationSeriesOccurrenceUpdateParams) (ReservationSeriesOccurrenceUpdateRes, error)
+	// ReservationSeriesRetrieve implements reservation_series_retrieve operation.
+	//
+	// Returns a reservation series. Only its owner, their delegates and staff are authorized.
+	//
+	// GET /api/v1/reservation-series/{id}/
+	ReservationSeriesRetrieve(ctx context.Context, params ReservationSeriesRetrieveParams) (ReservationSeriesRetrieveRes, error)
+	// ReservationSeriesUpdate implements reservation_series_update operation.
+	//
+	// Replaces a confirmed series and regenerates its occurrences that have not started yet.
+	// Only its owner, their delegates and staff are authorized.
+	//
+	// PUT /api/v1/reservation-series/{id}/
+	ReservationSeriesUpdate(ctx context.Context, req *ReservationSeriesInput, params ReservationSeriesUpdateParams) (ReservationSeriesUpdateRes, error)
+	// ReservationsCancel implements reservations_cancel operation.
+	//
+	// Cancels a confirmed or pending reservation and releases its period. The earliest waitlist entries
+	// fitting the
+	// period are promoted to reservations. Only its owner, their delegates and staff are authorized.
+	//
+	// POST /api/v1/reservations/{id}/cancel/
+	ReservationsCancel(ctx context.Context, params ReservationsCancelParams) (ReservationsCancelRes, error)
+	// ReservationsCheckIn implements reservations_check_in operation.
+	//
+	// Records that a confirmed reservation is being used. Check-in opens 15 minutes before the start and
+	// closes at
+	// the end of the reservation, or at the check-in grace deadline of its facility. Only its owner, their
+	// delegates and staff are authorized.
+	//
+	// POST /api/v1/reservations/{id}/check-in/
+	ReservationsCheckIn(ctx context.Context, params ReservationsCheckInParams) (ReservationsCheckInRes, error)
+	// ReservationsCheckOut implements reservations_check_out operation.
+	//
+	// Ends a checked-in reservation early and releases the rest of its period. Only its owner, their
+	// delegates
+	// and staff are authorized.
+	//
+	// POST /api/v1/reservations/{id}/check-out/
+	ReservationsCheckOut(ctx context.Context, params ReservationsCheckOutParams) (ReservationsCheckOutRes, error)
+	// ReservationsCreate implements reservations_create operation.
+	//
+	// Reserves a facility within its opening hours for the authenticated user, or on behalf of the user
+	// given by
+	// `user_id` when that user granted them delegation. Staff may book for any user. Overlapping
+	// reservations and
+	// those exceeding a booking quota of the owner are rejected. Reservations of facilities requiring
+	// approval are
+	// created as pending requests unless made by staff.
+	//
+	// POST /api/v1/reservations/
+	ReservationsCreate(ctx context.Context, req *ReservationInput) (ReservationsCreateRes, error)
+	// ReservationsList implements reservations_list operation.
+	//
+	// Returns reservations overlapping the given period. Staff see all reservations, other users only
+	// their own,
+	// those of the users who granted them delegation and those they attend.
+	//
+	// GET /api/v1/reservations/
+	ReservationsList(ctx context.Context, params ReservationsListParams) (ReservationsListRes, error)
+	// ReservationsRetrieve implements reservations_retrieve operation.
+	//
+	// Returns a reservation. Only its owner, their delegates, its attendees and staff are authorized.
+	//
+	// GET /api/v1/reservations/{id}/
+	ReservationsRetrieve(ctx context.Context, params ReservationsRetrieveParams) (ReservationsRetrieveRes, error)
+	// ReservationsUpdate implements reservations_update operation.
+	//
+	// Replaces the facility, period, details and attendees of a confirmed or pending reservation. Changes
+	// by other
+	// users than staff to a facility requiring approval turn the reservation into a pending request again.
+	// Only its owner, their delegates and staff are authorized.
+	//
+	// PUT /api/v1/reservations/{id}/
+	ReservationsUpdate(ctx context.Context, req *ReservationInput, params ReservationsUpdateParams) (ReservationsUpdateRes, error)
+	// WaitlistCreate implements waitlist_create operation.
+	//
+	// Puts the authenticated user on the waitlist for a period of a facility. The period is checked like a
+	// new
+	// reservation. When a conflicting reservation releases its period, the earliest entries that fit are
+	// promoted to
+	// reservations.
+	//
+	// POST /api/v1/waitlist/
+	WaitlistCreate(ctx context.Context, req *WaitlistEntryInput) (WaitlistCreateRes, error)
+	// WaitlistDestroy implements waitlist_destroy operation.
+	//
+	// Withdraws a waiting entry. Only its owner and staff are authorized.
+	//
+	// DELETE /api/v1/waitlist/{id}/
+	WaitlistDestroy(ctx context.Context, params WaitlistDestroyParams) (WaitlistDestroyRes, error)
+	// WaitlistList implements waitlist_list operation.
+	//
+	// Returns waitlist entries in the order they were made. Staff see all entries, other users only their
+	// own.
+	//
+	// GET /api/v1/waitlist/
+	WaitlistList(ctx context.Context, params WaitlistListParams) (WaitlistListRes, error)
 	// NewError creates *UnexpectedErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -95,18 +577,20 @@ type Handler interface {
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
@@ -13,6 +13,35 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AdminReservationsApprove implements admin_reservations_approve operation.
+//
+// Confirms a pending reservation request. Admin access required.
+//
+// POST /api/v1/admin/reservations/{id}/approve/
+func (UnimplementedHandler) AdminReservationsApprove(ctx context.Context, params AdminReservationsApproveParams) (r AdminReservationsApproveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReservationsPendingList implements admin_reservations_pending_list operation.
+//
+// Returns reservation requests awaiting approval ordered by start time. Requests that started without
+// a decision
+// are expired first. Admin access required.
+//
+// GET /api/v1/admin/reservations/pending/
+func (UnimplementedHandler) AdminReservationsPendingList(ctx context.Context, params AdminReservationsPendingListParams) (r AdminReservationsPendingListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminReservationsReject implements admin_reservations_reject operation.
+//
+// Rejects a pending reservation request and releases its period. Admin access required.
+//
+// POST /api/v1/admin/reservations/{id}/reject/
+func (UnimplementedHandler) AdminReservationsReject(ctx context.Context, params AdminReservationsRejectParams) (r AdminReservationsRejectRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminUsersCreate implements admin_users_create operation.
 //
 // Create a new user account. Admin access required.
@@ -49,6 +78,35 @@ func (UnimplementedHandler) AdminUsersPartialUpdate(ctx context.Context, req *Ad
 	return r, ht.ErrNotImplemented
 }
 
+// AdminUsersQuotasDestroy implements admin_users_quotas_destroy operation.
+//
+// Removes the limits set for a single user so that the quota of every user applies again.
+// Admin access required.
+//
+// DELETE /api/v1/admin/users/{id}/quotas/
+func (UnimplementedHandler) AdminUsersQuotasDestroy(ctx context.Context, params AdminUsersQuotasDestroyParams) (r AdminUsersQuotasDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminUsersQuotasList implements admin_users_quotas_list operation.
+//
+// Returns the booking quotas a user is subject to together with their usage, organization-wide first.
+// Admin access required.
+//
+// GET /api/v1/admin/users/{id}/quotas/
+func (UnimplementedHandler) AdminUsersQuotasList(ctx context.Context, params AdminUsersQuotasListParams) (r AdminUsersQuotasListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminUsersQuotasUpdate implements admin_users_quotas_update operation.
+//
+// Replaces the limits of a booking quota for a single user. Admin access required.
+//
+// PUT /api/v1/admin/users/{id}/quotas/
+func (UnimplementedHandler) AdminUsersQuotasUpdate(ctx context.Context, req *BookingQuota, params AdminUsersQuotasUpdateParams) (r AdminUsersQuotasUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminUsersRetrieve implements admin_users_retrieve operation.
 //
 // Fetch details of a specific user by ID. Admin access required.
@@ -67,6 +125,223 @@ func (UnimplementedHandler) AdminUsersUpdate(ctx context.Context, req *AdminUser
 	return r, ht.ErrNotImplemented
 }
 
+// AmenitiesCreate implements amenities_create operation.
+//
+// Adds an amenity to the catalogue. Only administrators are authorized.
+//
+// POST /api/v1/amenities/
+func (UnimplementedHandler) AmenitiesCreate(ctx context.Context, req *Amenity) (r AmenitiesCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AmenitiesDestroy implements amenities_destroy operation.
+//
+// Deletes an amenity and removes it from every facility offering it. Only administrators are
+// authorized.
+//
+// DELETE /api/v1/amenities/{id}/
+func (UnimplementedHandler) AmenitiesDestroy(ctx context.Context, params AmenitiesDestroyParams) (r AmenitiesDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AmenitiesList implements amenities_list operation.
+//
+// Returns the amenities facilities can offer ordered by name. No authentication required.
+//
+// GET /api/v1/amenities/
+func (UnimplementedHandler) AmenitiesList(ctx context.Context) (r []Amenity, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AmenitiesRetrieve implements amenities_retrieve operation.
+//
+// Returns an amenity. No authentication required.
+//
+// GET /api/v1/amenities/{id}/
+func (UnimplementedHandler) AmenitiesRetrieve(ctx context.Context, params AmenitiesRetrieveParams) (r AmenitiesRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AmenitiesUpdate implements amenities_update operation.
+//
+// Replaces the slug, name and description of an amenity. Only administrators are authorized.
+//
+// PUT /api/v1/amenities/{id}/
+func (UnimplementedHandler) AmenitiesUpdate(ctx context.Context, req *Amenity, params AmenitiesUpdateParams) (r AmenitiesUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AvailabilityList implements availability_list operation.
+//
+// Returns free periods of active facilities within the given range and their opening hours, together
+// with their
+// blackouts. Free periods leave room for the setup and teardown buffers of the facility. No
+// authentication
+// required.
+//
+// GET /api/v1/availability/
+func (UnimplementedHandler) AvailabilityList(ctx context.Context, params AvailabilityListParams) (r AvailabilityListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookingPolicyRetrieve implements booking_policy_retrieve operation.
+//
+// Returns the organization-wide booking policy inherited by every facility. No authentication
+// required.
+//
+// GET /api/v1/booking-policy/
+func (UnimplementedHandler) BookingPolicyRetrieve(ctx context.Context) (r *BookingPolicy, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookingPolicyUpdate implements booking_policy_update operation.
+//
+// Replaces the organization-wide booking policy. Existing reservations are kept.
+// Only administrators are authorized.
+//
+// PUT /api/v1/booking-policy/
+func (UnimplementedHandler) BookingPolicyUpdate(ctx context.Context, req *BookingPolicy) (r BookingPolicyUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookingQuotaRetrieve implements booking_quota_retrieve operation.
+//
+// Returns the limits on reservations of a user across every facility. No authentication required.
+//
+// GET /api/v1/booking-quota/
+func (UnimplementedHandler) BookingQuotaRetrieve(ctx context.Context) (r *BookingQuota, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BookingQuotaUpdate implements booking_quota_update operation.
+//
+// Replaces the limits on reservations of a user across every facility. Existing reservations are kept.
+// Only administrators are authorized.
+//
+// PUT /api/v1/booking-quota/
+func (UnimplementedHandler) BookingQuotaUpdate(ctx context.Context, req *BookingQuota) (r BookingQuotaUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DelegationGrantsCreate implements delegation_grants_create operation.
+//
+// Lets another user book and manage reservations and series on behalf of the authenticated user.
+//
+// POST /api/v1/delegation-grants/
+func (UnimplementedHandler) DelegationGrantsCreate(ctx context.Context, req *DelegationGrantInput) (r DelegationGrantsCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DelegationGrantsDestroy implements delegation_grants_destroy operation.
+//
+// Revokes a delegation grant. Reservations booked by the delegate are kept. Only its grantor, its
+// delegate and staff
+// are authorized.
+//
+// DELETE /api/v1/delegation-grants/{id}/
+func (UnimplementedHandler) DelegationGrantsDestroy(ctx context.Context, params DelegationGrantsDestroyParams) (r DelegationGrantsDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DelegationGrantsList implements delegation_grants_list operation.
+//
+// Returns delegation grants in the order they were made. Staff see all grants, other users those they
+// gave or
+// received.
+//
+// GET /api/v1/delegation-grants/
+func (UnimplementedHandler) DelegationGrantsList(ctx context.Context) (r DelegationGrantsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesAmenitiesUpdate implements facilities_amenities_update operation.
+//
+// Replaces the amenities a facility offers. Slugs of amenities that do not exist are rejected.
+// Only administrators are authorized.
+//
+// PUT /api/v1/facilities/{id}/amenities/
+func (UnimplementedHandler) FacilitiesAmenitiesUpdate(ctx context.Context, req *FacilityAmenities, params FacilitiesAmenitiesUpdateParams) (r FacilitiesAmenitiesUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBlackoutsCreate implements facilities_blackouts_create operation.
+//
+// Blocks a facility for a one-off or recurring window. Existing reservations are kept.
+// Only administrators are authorized.
+//
+// POST /api/v1/facilities/{id}/blackouts/
+func (UnimplementedHandler) FacilitiesBlackoutsCreate(ctx context.Context, req *BlackoutInput, params FacilitiesBlackoutsCreateParams) (r FacilitiesBlackoutsCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBlackoutsDestroy implements facilities_blackouts_destroy operation.
+//
+// Deletes a blackout window of a facility. Only administrators are authorized.
+//
+// DELETE /api/v1/facilities/{id}/blackouts/{blackout_id}/
+func (UnimplementedHandler) FacilitiesBlackoutsDestroy(ctx context.Context, params FacilitiesBlackoutsDestroyParams) (r FacilitiesBlackoutsDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBlackoutsList implements facilities_blackouts_list operation.
+//
+// Returns the blackout windows of a facility ordered by start time. No authentication required.
+//
+// GET /api/v1/facilities/{id}/blackouts/
+func (UnimplementedHandler) FacilitiesBlackoutsList(ctx context.Context, params FacilitiesBlackoutsListParams) (r FacilitiesBlackoutsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBlackoutsRetrieve implements facilities_blackouts_retrieve operation.
+//
+// Returns a blackout window of a facility. No authentication required.
+//
+// GET /api/v1/facilities/{id}/blackouts/{blackout_id}/
+func (UnimplementedHandler) FacilitiesBlackoutsRetrieve(ctx context.Context, params FacilitiesBlackoutsRetrieveParams) (r FacilitiesBlackoutsRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBookingPolicyRetrieve implements facilities_booking_policy_retrieve operation.
+//
+// Returns the booking policy of a facility together with the rules in effect. No authentication
+// required.
+//
+// GET /api/v1/facilities/{id}/booking-policy/
+func (UnimplementedHandler) FacilitiesBookingPolicyRetrieve(ctx context.Context, params FacilitiesBookingPolicyRetrieveParams) (r FacilitiesBookingPolicyRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBookingPolicyUpdate implements facilities_booking_policy_update operation.
+//
+// Replaces the booking policy of a facility. Omitted rules are inherited from the organization-wide
+// default.
+// Existing reservations are kept. Only administrators are authorized.
+//
+// PUT /api/v1/facilities/{id}/booking-policy/
+func (UnimplementedHandler) FacilitiesBookingPolicyUpdate(ctx context.Context, req *BookingPolicy, params FacilitiesBookingPolicyUpdateParams) (r FacilitiesBookingPolicyUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBookingQuotaRetrieve implements facilities_booking_quota_retrieve operation.
+//
+// Returns the limits on reservations of a user of a facility. No authentication required.
+//
+// GET /api/v1/facilities/{id}/booking-quota/
+func (UnimplementedHandler) FacilitiesBookingQuotaRetrieve(ctx context.Context, params FacilitiesBookingQuotaRetrieveParams) (r FacilitiesBookingQuotaRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesBookingQuotaUpdate implements facilities_booking_quota_update operation.
+//
+// Replaces the limits on reservations of a user of a facility. They apply in addition to the
+// organization-wide quota.
+// Existing reservations are kept. Only administrators are authorized.
+//
+// PUT /api/v1/facilities/{id}/booking-quota/
+func (UnimplementedHandler) FacilitiesBookingQuotaUpdate(ctx context.Context, req *BookingQuota, params FacilitiesBookingQuotaUpdateParams) (r FacilitiesBookingQuotaUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // FacilitiesCreate implements facilities_create operation.
 //
 // Creates a new facility. Only administrators are authorized.
@@ -87,10 +362,61 @@ func (UnimplementedHandler) FacilitiesDestroy(ctx context.Context, params Facili
 
 // FacilitiesList implements facilities_list operation.
 //
-// Returns a list of all active facilities. No authentication required.
+// Returns a page of facilities. Staff see inactive facilities too, other users only active ones. No
+// authentication
+// required.
 //
 // GET /api/v1/facilities/
-func (UnimplementedHandler) FacilitiesList(ctx context.Context) (r []PublicFacility, _ error) {
+func (UnimplementedHandler) FacilitiesList(ctx context.Context, params FacilitiesListParams) (r FacilitiesListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesOpeningHoursOverridesDestroy implements facilities_opening_hours_overrides_destroy operation.
+//
+// Removes the opening hours override of a date so the weekly rules apply again.
+// Only administrators are authorized.
+//
+// DELETE /api/v1/facilities/{id}/opening-hours/overrides/{date}/
+func (UnimplementedHandler) FacilitiesOpeningHoursOverridesDestroy(ctx context.Context, params FacilitiesOpeningHoursOverridesDestroyParams) (r FacilitiesOpeningHoursOverridesDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesOpeningHoursOverridesList implements facilities_opening_hours_overrides_list operation.
+//
+// Returns the date-specific opening hours of a facility ordered by date. No authentication required.
+//
+// GET /api/v1/facilities/{id}/opening-hours/overrides/
+func (UnimplementedHandler) FacilitiesOpeningHoursOverridesList(ctx context.Context, params FacilitiesOpeningHoursOverridesListParams) (r FacilitiesOpeningHoursOverridesListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesOpeningHoursOverridesUpdate implements facilities_opening_hours_overrides_update operation.
+//
+// Sets the opening hours of a facility on a date, replacing its weekly rules for that day. Existing
+// reservations
+// are kept. Only administrators are authorized.
+//
+// PUT /api/v1/facilities/{id}/opening-hours/overrides/{date}/
+func (UnimplementedHandler) FacilitiesOpeningHoursOverridesUpdate(ctx context.Context, req *OpeningHoursOverrideInput, params FacilitiesOpeningHoursOverridesUpdateParams) (r FacilitiesOpeningHoursOverridesUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesOpeningHoursRetrieve implements facilities_opening_hours_retrieve operation.
+//
+// Returns the weekly opening hours of a facility. No authentication required.
+//
+// GET /api/v1/facilities/{id}/opening-hours/
+func (UnimplementedHandler) FacilitiesOpeningHoursRetrieve(ctx context.Context, params FacilitiesOpeningHoursRetrieveParams) (r FacilitiesOpeningHoursRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FacilitiesOpeningHoursUpdate implements facilities_opening_hours_update operation.
+//
+// Replaces the weekly opening hours of a facility. Existing reservations are kept.
+// Only administrators are authorized.
+//
+// PUT /api/v1/facilities/{id}/opening-hours/
+func (UnimplementedHandler) FacilitiesOpeningHoursUpdate(ctx context.Context, req *OpeningHours, params FacilitiesOpeningHoursUpdateParams) (r FacilitiesOpeningHoursUpdateRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -112,6 +438,17 @@ func (UnimplementedHandler) FacilitiesRetrieve(ctx context.Context, params Facil
 	return r, ht.ErrNotImplemented
 }
 
+// FacilitiesSearchList implements facilities_search_list operation.
+//
+// Returns active facilities whose name, location or description contains any word of the query, or
+// whose name is
+// similar to it to tolerate typos, most relevant first. No authentication required.
+//
+// GET /api/v1/facilities/search/
+func (UnimplementedHandler) FacilitiesSearchList(ctx context.Context, params FacilitiesSearchListParams) (r []FacilitySearchResult, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // FacilitiesUpdate implements facilities_update operation.
 //
 // Updates an existing facility. Only administrators are authorized.
@@ -121,6 +458,103 @@ func (UnimplementedHandler) FacilitiesUpdate(ctx context.Context, req *PublicFac
 	return r, ht.ErrNotImplemented
 }
 
+// HoldsConfirm implements holds_confirm operation.
+//
+// Turns a hold into a reservation with the given details. Holds of facilities requiring approval
+// become pending
+// requests unless confirmed by staff. Only its owner and staff are authorized.
+//
+// POST /api/v1/holds/{id}/confirm/
+func (UnimplementedHandler) HoldsConfirm(ctx context.Context, req *HoldConfirmation, params HoldsConfirmParams) (r HoldsConfirmRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HoldsCreate implements holds_create operation.
+//
+// Holds a period of a facility for the authenticated user while the reservation details are filled
+// out.
+// The period is checked like a new reservation and blocks other reservations until the hold is
+// confirmed or expires.
+//
+// POST /api/v1/holds/
+func (UnimplementedHandler) HoldsCreate(ctx context.Context, req *HoldInput) (r HoldsCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HoldsDestroy implements holds_destroy operation.
+//
+// Releases the period of a hold. Only its owner and staff are authorized.
+//
+// DELETE /api/v1/holds/{id}/
+func (UnimplementedHandler) HoldsDestroy(ctx context.Context, params HoldsDestroyParams) (r HoldsDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// HoldsRetrieve implements holds_retrieve operation.
+//
+// Returns a hold that has not expired. Only its owner and staff are authorized.
+//
+// GET /api/v1/holds/{id}/
+func (UnimplementedHandler) HoldsRetrieve(ctx context.Context, params HoldsRetrieveParams) (r HoldsRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsCreate implements locations_create operation.
+//
+// Creates a site, building or floor. Only administrators are authorized.
+//
+// POST /api/v1/locations/
+func (UnimplementedHandler) LocationsCreate(ctx context.Context, req *Location) (r LocationsCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsDestroy implements locations_destroy operation.
+//
+// Deletes a location. Locations still containing other locations or facilities cannot be deleted.
+// Only administrators are authorized.
+//
+// DELETE /api/v1/locations/{id}/
+func (UnimplementedHandler) LocationsDestroy(ctx context.Context, params LocationsDestroyParams) (r LocationsDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsList implements locations_list operation.
+//
+// Returns all locations ordered by name. No authentication required.
+//
+// GET /api/v1/locations/
+func (UnimplementedHandler) LocationsList(ctx context.Context) (r []Location, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsRetrieve implements locations_retrieve operation.
+//
+// Returns a location. No authentication required.
+//
+// GET /api/v1/locations/{id}/
+func (UnimplementedHandler) LocationsRetrieve(ctx context.Context, params LocationsRetrieveParams) (r LocationsRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsTreeList implements locations_tree_list operation.
+//
+// Returns the sites ordered by name together with the locations and active facilities they contain.
+// No authentication required.
+//
+// GET /api/v1/locations/tree/
+func (UnimplementedHandler) LocationsTreeList(ctx context.Context) (r []LocationTreeNode, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// LocationsUpdate implements locations_update operation.
+//
+// Renames a location or moves it to another parent. Only administrators are authorized.
+//
+// PUT /api/v1/locations/{id}/
+func (UnimplementedHandler) LocationsUpdate(ctx context.Context, req *Location, params LocationsUpdateParams) (r LocationsUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // MeRetrieve implements me_retrieve operation.
 //
 // Returns basic profile information of the currently authenticated user.
@@ -130,6 +564,252 @@ func (UnimplementedHandler) MeRetrieve(ctx context.Context) (r MeRetrieveRes, _
 	return r, ht.ErrNotImplemented
 }
 
+// ReservationBundlesCancel implements reservation_bundles_cancel operation.
+//
+// Cancels a confirmed bundle together with its confirmed reservations. Waitlist entries fitting the
+// released
+// periods are promoted. Only its owner, their delegates and staff are authorized.
+//
+// POST /api/v1/reservation-bundles/{id}/cancel/
+func (UnimplementedHandler) ReservationBundlesCancel(ctx context.Context, params ReservationBundlesCancelParams) (r ReservationBundlesCancelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationBundlesCreate implements reservation_bundles_create operation.
+//
+// Reserves several facilities for the authenticated user at once. Every component is checked like a
+// new reservation
+// and the bundle is rejected as a whole when any of them fails, reporting the index of that component.
+// Facilities requiring approval cannot be reserved by bundle.
+//
+// POST /api/v1/reservation-bundles/
+func (UnimplementedHandler) ReservationBundlesCreate(ctx context.Context, req *ReservationBundleInput) (r ReservationBundlesCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationBundlesList implements reservation_bundles_list operation.
+//
+// Returns reservation bundles in the order they were made. Staff see all bundles, other users only
+// their own and
+// those of the users who granted them delegation.
+//
+// GET /api/v1/reservation-bundles/
+func (UnimplementedHandler) ReservationBundlesList(ctx context.Context) (r ReservationBundlesListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationBundlesReschedule implements reservation_bundles_reschedule operation.
+//
+// Moves every confirmed reservation of a bundle by the same distance. The new periods are checked like
+// changed
+// reservations and the bundle is kept unchanged when any of them fails, reporting the index of that
+// component.
+// Only bundles whose reservations have not started can be rescheduled. Only its owner, their delegates
+// and staff
+// are authorized.
+//
+// POST /api/v1/reservation-bundles/{id}/reschedule/
+func (UnimplementedHandler) ReservationBundlesReschedule(ctx context.Context, req *ReservationBundleReschedule, params ReservationBundlesRescheduleParams) (r ReservationBundlesRescheduleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationBundlesRetrieve implements reservation_bundles_retrieve operation.
+//
+// Returns a reservation bundle. Only its owner, their delegates and staff are authorized.
+//
+// GET /api/v1/reservation-bundles/{id}/
+func (UnimplementedHandler) ReservationBundlesRetrieve(ctx context.Context, params ReservationBundlesRetrieveParams) (r ReservationBundlesRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesCancel implements reservation_series_cancel operation.
+//
+// Cancels a confirmed series together with its occurrences that have not started yet.
+// Only its owner, their delegates and staff are authorized.
+//
+// POST /api/v1/reservation-series/{id}/cancel/
+func (UnimplementedHandler) ReservationSeriesCancel(ctx context.Context, params ReservationSeriesCancelParams) (r ReservationSeriesCancelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesCreate implements reservation_series_create operation.
+//
+// Creates a recurring series for the authenticated user and expands it into reservations.
+// Facilities requiring approval cannot be reserved by series.
+//
+// POST /api/v1/reservation-series/
+func (UnimplementedHandler) ReservationSeriesCreate(ctx context.Context, req *ReservationSeriesInput, params ReservationSeriesCreateParams) (r ReservationSeriesCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesList implements reservation_series_list operation.
+//
+// Returns reservation series ordered by their first occurrence. Staff see all series, other users only
+// their own
+// and those of the users who granted them delegation.
+//
+// GET /api/v1/reservation-series/
+func (UnimplementedHandler) ReservationSeriesList(ctx context.Context) (r ReservationSeriesListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesOccurrenceSkip implements reservation_series_occurrence_skip operation.
+//
+// Skips an occurrence of a confirmed series.
+// With `this_and_following` the series ends before the occurrence, with `all` the whole series is
+// cancelled.
+// Only its owner, their delegates and staff are authorized.
+//
+// POST /api/v1/reservation-series/{id}/occurrences/{occurrence_id}/skip/
+func (UnimplementedHandler) ReservationSeriesOccurrenceSkip(ctx context.Context, params ReservationSeriesOccurrenceSkipParams) (r ReservationSeriesOccurrenceSkipRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesOccurrenceUpdate implements reservation_series_occurrence_update operation.
+//
+// Edits an upcoming occurrence of a confirmed series.
+// With `this_and_following` the series is split and the edited occurrences form a new series,
+// with `all` the change is applied to every upcoming occurrence keeping their distance to the edited
+// one.
+// Occurrences cannot have attendees. Only its owner, their delegates and staff are authorized.
+//
+// PUT /api/v1/reservation-series/{id}/occurrences/{occurrence_id}/
+func (UnimplementedHandler) ReservationSeriesOccurrenceUpdate(ctx context.Context, req *ReservationInput, params ReservationSeriesOccurrenceUpdateParams) (r ReservationSeriesOccurrenceUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesRetrieve implements reservation_series_retrieve operation.
+//
+// Returns a reservation series. Only its owner, their delegates and staff are authorized.
+//
+// GET /api/v1/reservation-series/{id}/
+func (UnimplementedHandler) ReservationSeriesRetrieve(ctx context.Context, params ReservationSeriesRetrieveParams) (r ReservationSeriesRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationSeriesUpdate implements reservation_series_update operation.
+//
+// Replaces a confirmed series and regenerates its occurrences that have not started yet.
+// Only its owner, their delegates and staff are authorized.
+//
+// PUT /api/v1/reservation-series/{id}/
+func (UnimplementedHandler) ReservationSeriesUpdate(ctx context.Context, req *ReservationSeriesInput, params ReservationSeriesUpdateParams) (r ReservationSeriesUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsCancel implements reservations_cancel operation.
+//
+// Cancels a confirmed or pending reservation and releases its period. The earliest waitlist entries
+// fitting the
+// period are promoted to reservations. Only its owner, their delegates and staff are authorized.
+//
+// POST /api/v1/reservations/{id}/cancel/
+func (UnimplementedHandler) ReservationsCancel(ctx context.Context, params ReservationsCancelParams) (r ReservationsCancelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsCheckIn implements reservations_check_in operation.
+//
+// Records that a confirmed reservation is being used. Check-in opens 15 minutes before the start and
+// closes at
+// the end of the reservation, or at the check-in grace deadline of its facility. Only its owner, their
+// delegates and staff are authorized.
+//
+// POST /api/v1/reservations/{id}/check-in/
+func (UnimplementedHandler) ReservationsCheckIn(ctx context.Context, params ReservationsCheckInParams) (r ReservationsCheckInRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsCheckOut implements reservations_check_out operation.
+//
+// Ends a checked-in reservation early and releases the rest of its period. Only its owner, their
+// delegates
+// and staff are authorized.
+//
+// POST /api/v1/reservations/{id}/check-out/
+func (UnimplementedHandler) ReservationsCheckOut(ctx context.Context, params ReservationsCheckOutParams) (r ReservationsCheckOutRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsCreate implements reservations_create operation.
+//
+// Reserves a facility within its opening hours for the authenticated user, or on behalf of the user
+// given by
+// `user_id` when that user granted them delegation. Staff may book for any user. Overlapping
+// reservations and
+// those exceeding a booking quota of the owner are rejected. Reservations of facilities requiring
+// approval are
+// created as pending requests unless made by staff.
+//
+// POST /api/v1/reservations/
+func (UnimplementedHandler) ReservationsCreate(ctx context.Context, req *ReservationInput) (r ReservationsCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsList implements reservations_list operation.
+//
+// Returns reservations overlapping the given period. Staff see all reservations, other users only
+// their own,
+// those of the users who granted them delegation and those they attend.
+//
+// GET /api/v1/reservations/
+func (UnimplementedHandler) ReservationsList(ctx context.Context, params ReservationsListParams) (r ReservationsListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsRetrieve implements reservations_retrieve operation.
+//
+// Returns a reservation. Only its owner, their delegates, its attendees and staff are authorized.
+//
+// GET /api/v1/reservations/{id}/
+func (UnimplementedHandler) ReservationsRetrieve(ctx context.Context, params ReservationsRetrieveParams) (r ReservationsRetrieveRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReservationsUpdate implements reservations_update operation.
+//
+// Replaces the facility, period, details and attendees of a confirmed or pending reservation. Changes
+// by other
+// users than staff to a facility requiring approval turn the reservation into a pending request again.
+// Only its owner, their delegates and staff are authorized.
+//
+// PUT /api/v1/reservations/{id}/
+func (UnimplementedHandler) ReservationsUpdate(ctx context.Context, req *ReservationInput, params ReservationsUpdateParams) (r ReservationsUpdateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WaitlistCreate implements waitlist_create operation.
+//
+// Puts the authenticated user on the waitlist for a period of a facility. The period is checked like a
+// new
+// reservation. When a conflicting reservation releases its period, the earliest entries that fit are
+// promoted to
+// reservations.
+//
+// POST /api/v1/waitlist/
+func (UnimplementedHandler) WaitlistCreate(ctx context.Context, req *WaitlistEntryInput) (r WaitlistCreateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WaitlistDestroy implements waitlist_destroy operation.
+//
+// Withdraws a waiting entry. Only its owner and staff are authorized.
+//
+// DELETE /api/v1/waitlist/{id}/
+func (UnimplementedHandler) WaitlistDestroy(ctx context.Context, params WaitlistDestroyParams) (r WaitlistDestroyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// WaitlistList implements waitlist_list operation.
+//
+// Returns waitlist entries in the order they were made. Staff see all entries, other users only their
+// own.
+//
+// GET /api/v1/waitlist/
+func (UnimplementedHandler) WaitlistList(ctx context.Context, params WaitlistListParams) (r WaitlistListRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *UnexpectedErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
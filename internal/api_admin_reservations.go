@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// errReservationNotPending is returned inside transactions when a reviewed reservation is not a pending request.
+var errReservationNotPending = errors.New("reservation is not pending")
+
+// AdminReservationsPendingList returns the reservation requests awaiting approval ordered by their start time.
+// Requests that started without a decision are expired first. Only staff users are allowed.
+func (s *APIService) AdminReservationsPendingList(
+	ctx context.Context,
+	params api.AdminReservationsPendingListParams,
+) (res api.AdminReservationsPendingListRes, err error) {
+	defer derrors.Wrap(&err, "AdminReservationsPendingList(ctx, params)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminReservationsPendingListUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminReservationsPendingListForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	var facilityID *int32
+	if v, ok := params.FacilityID.Get(); ok {
+		id, ok := toFacilityID(v)
+		if !ok {
+			return (*api.AdminReservationsPendingListBadRequest)(facilityUnavailableProblem()), nil
+		}
+		facilityID = &id
+	}
+
+	if err := s.expirePendingReservations(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	reservations, err := s.ds.ListPendingReservations(ctx, facilityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending reservations: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(api.AdminReservationsPendingListOKApplicationJSON, 0, len(reservations))
+	for _, r := range reservations {
+		list = append(list, toReservation(r, zones[r.FacilityID]))
+	}
+	return &list, nil
+}
+
+// AdminReservationsApprove confirms a pending reservation request. Its period is already held,
+// so approval cannot conflict with other reservations. Only staff users are allowed.
+func (s *APIService) AdminReservationsApprove(
+	ctx context.Context,
+	params api.AdminReservationsApproveParams,
+) (res api.AdminReservationsApproveRes, err error) {
+	defer derrors.Wrap(&err, "AdminReservationsApprove(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminReservationsApproveUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminReservationsApproveForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	reservation, err := s.reviewReservation(ctx, params.ID, db.ReservationStatusConfirmed)
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.AdminReservationsApproveNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationNotPending):
+		return (*api.AdminReservationsApproveConflict)(reservationNotPendingProblem()), nil
+	case err != nil:
+		return nil, err
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := toReservation(reservation, loc)
+	return &approved, nil
+}
+
+// AdminReservationsReject rejects a pending reservation request, releasing its period for other reservations.
+// Waitlist entries fitting the released period are promoted to reservations in the same transaction,
+// and their users are notified once it commits. Only staff users are allowed.
+func (s *APIService) AdminReservationsReject(
+	ctx context.Context,
+	params api.AdminReservationsRejectParams,
+) (res api.AdminReservationsRejectRes, err error) {
+	defer derrors.Wrap(&err, "AdminReservationsReject(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminReservationsRejectUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminReservationsRejectForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	reservation, err := s.reviewReservation(ctx, params.ID, db.ReservationStatusRejected)
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.AdminReservationsRejectNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationNotPending):
+		return (*api.AdminReservationsRejectConflict)(reservationNotPendingProblem()), nil
+	case err != nil:
+		return nil, err
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected := toReservation(reservation, loc)
+	return &rejected, nil
+}
+
+// reviewReservation moves a pending reservation request to the given status.
+// Requests that started without a decision are expired first, so they can no longer be reviewed.
+// Waitlist entries fitting the period of a rejected request are promoted.
+func (s *APIService) reviewReservation(
+	ctx context.Context,
+	id uuid.UUID,
+	status db.ReservationStatus,
+) (db.Reservation, error) {
+	if err := s.expirePendingReservations(ctx, time.Now()); err != nil {
+		return db.Reservation{}, err
+	}
+
+	var (
+		reservation db.Reservation
+		promoted    []db.WaitlistEntry
+	)
+	err := s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := tx.GetReservationByIDForUpdate(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errReservationNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get reservation: %w", err)
+		}
+		if current.Status != db.ReservationStatusPending {
+			return errReservationNotPending
+		}
+
+		reservation, err = tx.ReviewReservation(ctx, db.ReviewReservationParams{
+			Status: status,
+			ID:     id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to review reservation: %w", err)
+		}
+		if status == db.ReservationStatusRejected {
+			promoted, err = promoteWaitlist(ctx, tx, reservation)
+		}
+		return err
+	})
+	if err != nil {
+		return db.Reservation{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+	return reservation, nil
+}
+
+// expirePendingReservations expires the requests that started without a decision by now.
+// Waitlist entries fitting the released periods are promoted to reservations in the same transaction,
+// and their users are notified once it commits.
+func (s *APIService) expirePendingReservations(ctx context.Context, now time.Time) error {
+	var promoted []db.WaitlistEntry
+	err := s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		expired, err := tx.ExpirePendingReservations(ctx, now)
+		if err != nil {
+			return fmt.Errorf("failed to expire pending reservations: %w", err)
+		}
+		promoted, err = promoteWaitlists(ctx, tx, expired)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+	return nil
+}
+
+func reservationNotPendingProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "Only pending reservation requests can be approved or rejected.")
+}
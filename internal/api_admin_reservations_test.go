@@ -0,0 +1,177 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestAdminReservationsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+
+	t.Run("pending list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.AdminReservationsPendingList(t.Context(), api.AdminReservationsPendingListParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminReservationsPendingListUnauthorized{}, res)
+	})
+
+	t.Run("approve rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminReservationsApprove(userCtx,
+			api.AdminReservationsApproveParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminReservationsApproveForbidden{}, res)
+	})
+
+	t.Run("reject rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminReservationsReject(userCtx,
+			api.AdminReservationsRejectParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminReservationsRejectForbidden{}, res)
+	})
+}
+
+func TestReservationApproval(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUserCtx := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	ownerCtx := internal.WithAuthenticatedUser(ctx, newUserCtx(t))
+	otherCtx := internal.WithAuthenticatedUser(ctx, newUserCtx(t))
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+		Name:             gofakeit.Company(),
+		RequiresApproval: api.NewOptBool(true),
+	})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	input := &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Lecture",
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(time.Hour),
+	}
+
+	createRes, err := svc.ReservationsCreate(ownerCtx, input)
+	require.NoError(t, err)
+	requested, ok := createRes.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", createRes)
+	assert.Equal(t, api.ReservationStatusPending, requested.Status)
+
+	t.Run("pending requests hold their period", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(otherCtx, input)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("staff users see pending requests", func(t *testing.T) {
+		res, err := svc.AdminReservationsPendingList(staffCtx, api.AdminReservationsPendingListParams{
+			FacilityID: api.NewOptInt(facility.ID),
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AdminReservationsPendingListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, requested.ID, (*list)[0].ID)
+	})
+
+	t.Run("approval confirms the request once", func(t *testing.T) {
+		res, err := svc.AdminReservationsApprove(staffCtx, api.AdminReservationsApproveParams{ID: requested.ID})
+		require.NoError(t, err)
+		approved, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusConfirmed, approved.Status)
+		assert.True(t, approved.ReviewedAt.IsSet())
+
+		res, err = svc.AdminReservationsApprove(staffCtx, api.AdminReservationsApproveParams{ID: requested.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminReservationsApproveConflict{}, res)
+	})
+
+	t.Run("rejection releases the period", func(t *testing.T) {
+		later := &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Rehearsal",
+			StartsAt:   startsAt.Add(2 * time.Hour),
+			EndsAt:     startsAt.Add(3 * time.Hour),
+		}
+		createRes, err := svc.ReservationsCreate(otherCtx, later)
+		require.NoError(t, err)
+		pending, ok := createRes.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", createRes)
+
+		res, err := svc.AdminReservationsReject(staffCtx, api.AdminReservationsRejectParams{ID: pending.ID})
+		require.NoError(t, err)
+		rejected, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusRejected, rejected.Status)
+
+		createRes, err = svc.ReservationsCreate(ownerCtx, later)
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, createRes)
+	})
+
+	t.Run("moving a confirmed reservation requests approval again", func(t *testing.T) {
+		moved := *input
+		moved.StartsAt = startsAt.Add(-2 * time.Hour)
+		moved.EndsAt = startsAt.Add(-time.Hour)
+		res, err := svc.ReservationsUpdate(ownerCtx, &moved, api.ReservationsUpdateParams{ID: requested.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusPending, updated.Status)
+	})
+
+	t.Run("series cannot reserve facilities requiring approval", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCreate(ownerCtx, &api.ReservationSeriesInput{
+			FacilityID: facility.ID,
+			Title:      "Weekly lecture",
+			StartsAt:   startsAt.AddDate(0, 0, 7),
+			EndsAt:     startsAt.AddDate(0, 0, 7).Add(time.Hour),
+			Rrule:      "FREQ=WEEKLY;COUNT=3",
+			TimeZone:   api.NewOptString("UTC"),
+		}, api.ReservationSeriesCreateParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCreateBadRequest{}, res)
+	})
+}
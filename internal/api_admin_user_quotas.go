@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// AdminUsersQuotasList returns every booking quota a user is subject to together with their usage
+// in the current week. Only staff users are allowed.
+func (s *APIService) AdminUsersQuotasList(
+	ctx context.Context,
+	params api.AdminUsersQuotasListParams,
+) (res api.AdminUsersQuotasListRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersQuotasList(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersQuotasListUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersQuotasListForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	user, err := s.ds.GetUserByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AdminUsersQuotasListNotFound)(userNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	rows, err := s.ds.ListUserBookingQuotas(ctx, &user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list booking quotas: %w", err)
+	}
+
+	quotas := userBookingQuotas(rows)
+	list := make(api.AdminUsersQuotasListOKApplicationJSON, 0, len(quotas))
+	for _, quota := range quotas {
+		usage, err := bookingQuotaUsage(ctx, s.ds, user.ID, quota)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+	return &list, nil
+}
+
+// AdminUsersQuotasUpdate overrides a booking quota for a single user, replacing the quota of every user
+// in the same scope. Only staff users are allowed.
+func (s *APIService) AdminUsersQuotasUpdate(
+	ctx context.Context,
+	req *api.BookingQuota,
+	params api.AdminUsersQuotasUpdateParams,
+) (res api.AdminUsersQuotasUpdateRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersQuotasUpdate(ctx, req, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersQuotasUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersQuotasUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	user, err := s.ds.GetUserByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AdminUsersQuotasUpdateNotFound)(userNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var facilityID *int32
+	if v, ok := params.FacilityID.Get(); ok {
+		id, ok := toFacilityID(v)
+		if !ok {
+			return (*api.AdminUsersQuotasUpdateNotFound)(facilityNotFoundProblem()), nil
+		}
+		facility, err := s.ds.GetFacilityByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return (*api.AdminUsersQuotasUpdateNotFound)(facilityNotFoundProblem()), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get facility: %w", err)
+		}
+		facilityID = &facility.ID
+	}
+
+	quota, err := s.ds.UpsertBookingQuota(ctx, toUpsertBookingQuotaParams(req, facilityID, &user.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert booking quota: %w", err)
+	}
+
+	usage, err := bookingQuotaUsage(ctx, s.ds, user.ID, bookingQuota{limits: quota, isOverride: true})
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// AdminUsersQuotasDestroy removes a booking quota override of a user so that the quota of every user
+// applies again. Only staff users are allowed.
+func (s *APIService) AdminUsersQuotasDestroy(
+	ctx context.Context,
+	params api.AdminUsersQuotasDestroyParams,
+) (res api.AdminUsersQuotasDestroyRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersQuotasDestroy(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersQuotasDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersQuotasDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	var facilityID *int32
+	if v, ok := params.FacilityID.Get(); ok {
+		id, ok := toFacilityID(v)
+		if !ok {
+			return (*api.AdminUsersQuotasDestroyNotFound)(quotaOverrideNotFoundProblem()), nil
+		}
+		facilityID = &id
+	}
+
+	deleted, err := s.ds.DeleteUserBookingQuota(ctx, db.DeleteUserBookingQuotaParams{
+		FacilityID: facilityID,
+		UserID:     params.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete booking quota: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.AdminUsersQuotasDestroyNotFound)(quotaOverrideNotFoundProblem()), nil
+	}
+
+	return &api.AdminUsersQuotasDestroyNoContent{}, nil
+}
+
+func quotaOverrideNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Booking quota override not found.")
+}
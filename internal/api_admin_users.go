@@ -0,0 +1,281 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+const adminUsersPath = "/api/v1/admin/users/"
+
+// AdminUsersList returns all users ordered by creation time. Only staff users are allowed.
+func (s *APIService) AdminUsersList(ctx context.Context) (res api.AdminUsersListRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersList(ctx)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersListUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersListForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	users, err := s.ds.ListUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	list := make(api.AdminUsersListOKApplicationJSON, 0, len(users))
+	for _, u := range users {
+		list = append(list, toAdminUser(u))
+	}
+	return &list, nil
+}
+
+// AdminUsersCreate creates a new user together with its initial API token. Only staff users are allowed.
+func (s *APIService) AdminUsersCreate(ctx context.Context, req *api.AdminUser) (res api.AdminUsersCreateRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersCreate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersCreateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersCreateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	caller, _ := AuthenticatedUserFromContext(ctx)
+	result, err := CreateUser(ctx, s.ds, caller, CreateUserParams{
+		Username: req.Username,
+		IsStaff:  req.IsStaff.Or(false),
+		Email:    emailPtr(req.Email),
+	})
+	if isUniqueViolation(err) {
+		return (*api.AdminUsersCreateBadRequest)(duplicateUsernameProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	user := toAdminUser(result.User)
+	return &api.AdminUserWithToken{
+		ID:       user.ID,
+		URL:      user.URL,
+		Username: user.Username,
+		Email:    user.Email,
+		IsStaff:  user.IsStaff,
+		Token:    result.Token.Token,
+	}, nil
+}
+
+// AdminUsersRetrieve returns a single user. Only staff users are allowed.
+func (s *APIService) AdminUsersRetrieve(
+	ctx context.Context,
+	params api.AdminUsersRetrieveParams,
+) (res api.AdminUsersRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersRetrieve(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersRetrieveUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersRetrieveForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	user, err := s.ds.GetUserByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AdminUsersRetrieveNotFound)(userNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	found := toAdminUser(user)
+	return &found, nil
+}
+
+// AdminUsersUpdate replaces all writable fields of a user. Only staff users are allowed.
+func (s *APIService) AdminUsersUpdate(
+	ctx context.Context,
+	req *api.AdminUser,
+	params api.AdminUsersUpdateParams,
+) (res api.AdminUsersUpdateRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersUpdate(ctx, req, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	user, err := s.ds.UpdateUser(ctx, db.UpdateUserParams{
+		ID:       params.ID,
+		Username: req.Username,
+		Email:    emailPtr(req.Email),
+		IsStaff:  req.IsStaff.Or(false),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AdminUsersUpdateNotFound)(userNotFoundProblem()), nil
+	}
+	if isUniqueViolation(err) {
+		return (*api.AdminUsersUpdateBadRequest)(duplicateUsernameProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	updated := toAdminUser(user)
+	return &updated, nil
+}
+
+// AdminUsersPartialUpdate applies a JSON merge patch to a user. Only staff users are allowed.
+func (s *APIService) AdminUsersPartialUpdate(
+	ctx context.Context,
+	req *api.AdminUserMergePatchUpdate,
+	params api.AdminUsersPartialUpdateParams,
+) (res api.AdminUsersPartialUpdateRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersPartialUpdate(ctx, req, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersPartialUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersPartialUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if v, ok := req.IsStaff.Get(); ok && v.IsNull() {
+		problem := newProblem(http.StatusBadRequest, "is_staff must not be null.")
+		return (*api.AdminUsersPartialUpdateBadRequest)(problem), nil
+	}
+
+	var user db.User
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := tx.GetUserByIDForUpdate(ctx, params.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		user, err = tx.UpdateUser(ctx, applyUserPatch(current, req))
+		if err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AdminUsersPartialUpdateNotFound)(userNotFoundProblem()), nil
+	}
+	if isUniqueViolation(err) {
+		return (*api.AdminUsersPartialUpdateBadRequest)(duplicateUsernameProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	updated := toAdminUser(user)
+	return &updated, nil
+}
+
+// AdminUsersDestroy deletes a user and all of its tokens. Only staff users are allowed.
+func (s *APIService) AdminUsersDestroy(
+	ctx context.Context,
+	params api.AdminUsersDestroyParams,
+) (res api.AdminUsersDestroyRes, err error) {
+	defer derrors.Wrap(&err, "AdminUsersDestroy(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AdminUsersDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AdminUsersDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	deleted, err := s.ds.DeleteUser(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.AdminUsersDestroyNotFound)(userNotFoundProblem()), nil
+	}
+
+	return &api.AdminUsersDestroyNoContent{}, nil
+}
+
+// applyUserPatch merges the fields present in req over the current user row.
+// Fields explicitly set to null are cleared.
+func applyUserPatch(current db.User, req *api.AdminUserMergePatchUpdate) db.UpdateUserParams {
+	arg := db.UpdateUserParams{
+		ID:       current.ID,
+		Username: current.Username,
+		Email:    current.Email,
+		IsStaff:  current.IsStaff,
+	}
+
+	if v, ok := req.Username.Get(); ok {
+		arg.Username = v
+	}
+	if v, ok := req.Email.Get(); ok {
+		arg.Email = nil
+		if email, ok := v.GetEmailString(); ok {
+			s := string(email)
+			arg.Email = &s
+		}
+	}
+	if v, ok := req.IsStaff.Get(); ok {
+		if isStaff, ok := v.GetBool(); ok {
+			arg.IsStaff = isStaff
+		}
+	}
+
+	return arg
+}
+
+// toAdminUser converts a database user into its admin API representation.
+func toAdminUser(u db.User) api.AdminUser {
+	email := api.OptEmailString{}
+	if u.Email != nil {
+		email = api.NewOptEmailString(api.EmailString(*u.Email))
+	}
+
+	return api.AdminUser{
+		ID:       u.ID,
+		URL:      adminUserURL(u.ID),
+		Username: u.Username,
+		Email:    email,
+		IsStaff:  api.NewOptBool(u.IsStaff),
+	}
+}
+
+// adminUserURL returns the path of the admin resource for the given user.
+func adminUserURL(id uuid.UUID) url.URL {
+	return url.URL{Path: adminUsersPath + id.String() + "/"}
+}
+
+func emailPtr(o api.OptEmailString) *string {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	email := string(v)
+	return &email
+}
+
+func userNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "User not found.")
+}
+
+func duplicateUsernameProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "A user with that username already exists.")
+}
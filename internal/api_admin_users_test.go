@@ -0,0 +1,142 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestAdminUsersStaffOnlyOperations(t *testing.T) {
+	// Access checks run before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	regularCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       "regular-user-id",
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.AdminUsersList(t.Context())
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersListUnauthorized{}, res)
+	})
+
+	t.Run("list rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminUsersList(regularCtx)
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersListForbidden{}, res)
+	})
+
+	t.Run("create rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminUsersCreate(regularCtx, &api.AdminUser{Username: "new-user"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersCreateForbidden{}, res)
+	})
+
+	t.Run("retrieve rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.AdminUsersRetrieve(t.Context(), api.AdminUsersRetrieveParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersRetrieveUnauthorized{}, res)
+	})
+
+	t.Run("destroy rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminUsersDestroy(regularCtx, api.AdminUsersDestroyParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersDestroyForbidden{}, res)
+	})
+}
+
+func TestAdminUsersCRUD(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	username := gofakeit.Username()
+	createRes, err := svc.AdminUsersCreate(staffCtx, &api.AdminUser{
+		Username: username,
+		Email:    api.NewOptEmailString("user@example.com"),
+	})
+	require.NoError(t, err)
+	created, ok := createRes.(*api.AdminUserWithToken)
+	require.True(t, ok, "unexpected response %T", createRes)
+	assert.Equal(t, username, created.Username)
+	assert.Equal(t, api.NewOptBool(false), created.IsStaff)
+	assert.Equal(t, "/api/v1/admin/users/"+created.ID.String()+"/", created.URL.String())
+	assert.Len(t, created.Token, 64)
+
+	t.Run("create rejects duplicate username", func(t *testing.T) {
+		res, err := svc.AdminUsersCreate(staffCtx, &api.AdminUser{Username: username})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersCreateBadRequest{}, res)
+	})
+
+	t.Run("list includes the created user", func(t *testing.T) {
+		res, err := svc.AdminUsersList(staffCtx)
+		require.NoError(t, err)
+		list, ok := res.(*api.AdminUsersListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+
+		ids := make([]uuid.UUID, 0, len(*list))
+		for _, u := range *list {
+			ids = append(ids, u.ID)
+		}
+		assert.Contains(t, ids, created.ID)
+	})
+
+	t.Run("retrieve returns the user", func(t *testing.T) {
+		res, err := svc.AdminUsersRetrieve(staffCtx, api.AdminUsersRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		found, ok := res.(*api.AdminUser)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptEmailString("user@example.com"), found.Email)
+	})
+
+	t.Run("partial update clears email and grants staff", func(t *testing.T) {
+		res, err := svc.AdminUsersPartialUpdate(staffCtx, &api.AdminUserMergePatchUpdate{
+			Email:   api.NewOptAdminUserMergePatchUpdateEmail(api.NewNullAdminUserMergePatchUpdateEmail(struct{}{})),
+			IsStaff: api.NewOptAdminUserMergePatchUpdateIsStaff(api.NewBoolAdminUserMergePatchUpdateIsStaff(true)),
+		}, api.AdminUsersPartialUpdateParams{ID: created.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.AdminUser)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, username, updated.Username)
+		assert.False(t, updated.Email.IsSet())
+		assert.Equal(t, api.NewOptBool(true), updated.IsStaff)
+	})
+
+	t.Run("update replaces all fields", func(t *testing.T) {
+		newUsername := gofakeit.Username()
+		res, err := svc.AdminUsersUpdate(staffCtx, &api.AdminUser{Username: newUsername},
+			api.AdminUsersUpdateParams{ID: created.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.AdminUser)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, newUsername, updated.Username)
+		assert.Equal(t, api.NewOptBool(false), updated.IsStaff)
+	})
+
+	t.Run("destroy removes the user", func(t *testing.T) {
+		res, err := svc.AdminUsersDestroy(staffCtx, api.AdminUsersDestroyParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersDestroyNoContent{}, res)
+
+		retrieveRes, err := svc.AdminUsersRetrieve(staffCtx, api.AdminUsersRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersRetrieveNotFound{}, retrieveRes)
+	})
+}
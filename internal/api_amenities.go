@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// errUnknownAmenity is returned inside transactions when a slug does not identify an amenity.
+var errUnknownAmenity = errors.New("unknown amenity")
+
+// AmenitiesList returns all amenities ordered by name.
+func (s *APIService) AmenitiesList(ctx context.Context) (res []api.Amenity, err error) {
+	defer derrors.Wrap(&err, "AmenitiesList(ctx)")
+
+	amenities, err := s.ds.ListAmenities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list amenities: %w", err)
+	}
+
+	res = make([]api.Amenity, 0, len(amenities))
+	for _, a := range amenities {
+		res = append(res, toAmenity(a))
+	}
+	return res, nil
+}
+
+// AmenitiesCreate adds an amenity to the catalogue. Only staff users are allowed.
+func (s *APIService) AmenitiesCreate(ctx context.Context, req *api.Amenity) (res api.AmenitiesCreateRes, err error) {
+	defer derrors.Wrap(&err, "AmenitiesCreate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AmenitiesCreateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AmenitiesCreateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	amenity, err := s.ds.CreateAmenity(ctx, db.CreateAmenityParams{
+		ID:          uuid.Must(uuid.NewV7()),
+		Slug:        req.Slug,
+		Name:        req.Name,
+		Description: ptrOf(req.Description),
+	})
+	if isUniqueViolation(err) {
+		return (*api.AmenitiesCreateConflict)(amenitySlugTakenProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create amenity: %w", err)
+	}
+
+	created := toAmenity(amenity)
+	return &created, nil
+}
+
+// AmenitiesRetrieve returns a single amenity.
+func (s *APIService) AmenitiesRetrieve(
+	ctx context.Context,
+	params api.AmenitiesRetrieveParams,
+) (res api.AmenitiesRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "AmenitiesRetrieve(ctx, %s)", params.ID)
+
+	amenity, err := s.ds.GetAmenityByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return amenityNotFoundProblem(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get amenity: %w", err)
+	}
+
+	found := toAmenity(amenity)
+	return &found, nil
+}
+
+// AmenitiesUpdate replaces all writable fields of an amenity. Only staff users are allowed.
+// Facilities offering the amenity keep it when its slug changes.
+func (s *APIService) AmenitiesUpdate(
+	ctx context.Context,
+	req *api.Amenity,
+	params api.AmenitiesUpdateParams,
+) (res api.AmenitiesUpdateRes, err error) {
+	defer derrors.Wrap(&err, "AmenitiesUpdate(ctx, req, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AmenitiesUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AmenitiesUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	amenity, err := s.ds.UpdateAmenity(ctx, db.UpdateAmenityParams{
+		ID:          params.ID,
+		Slug:        req.Slug,
+		Name:        req.Name,
+		Description: ptrOf(req.Description),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.AmenitiesUpdateNotFound)(amenityNotFoundProblem()), nil
+	}
+	if isUniqueViolation(err) {
+		return (*api.AmenitiesUpdateConflict)(amenitySlugTakenProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update amenity: %w", err)
+	}
+
+	updated := toAmenity(amenity)
+	return &updated, nil
+}
+
+// AmenitiesDestroy removes an amenity from the catalogue and from every facility offering it.
+// Only staff users are allowed.
+func (s *APIService) AmenitiesDestroy(
+	ctx context.Context,
+	params api.AmenitiesDestroyParams,
+) (res api.AmenitiesDestroyRes, err error) {
+	defer derrors.Wrap(&err, "AmenitiesDestroy(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.AmenitiesDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.AmenitiesDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	deleted, err := s.ds.DeleteAmenity(ctx, params.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete amenity: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.AmenitiesDestroyNotFound)(amenityNotFoundProblem()), nil
+	}
+
+	return &api.AmenitiesDestroyNoContent{}, nil
+}
+
+// FacilitiesAmenitiesUpdate replaces the amenities a facility offers. Only staff users are allowed.
+func (s *APIService) FacilitiesAmenitiesUpdate(
+	ctx context.Context,
+	req *api.FacilityAmenities,
+	params api.FacilitiesAmenitiesUpdateParams,
+) (res api.FacilitiesAmenitiesUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesAmenitiesUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesAmenitiesUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesAmenitiesUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesAmenitiesUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	slugs := amenitySlugs(req.Amenities)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		// Locking the facility serializes concurrent replacements of its amenities.
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+		if err := tx.DeleteFacilityAmenities(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete facility amenities: %w", err)
+		}
+		if len(slugs) == 0 {
+			return nil
+		}
+
+		added, err := tx.AddFacilityAmenities(ctx, db.AddFacilityAmenitiesParams{FacilityID: id, Slugs: slugs})
+		if err != nil {
+			return fmt.Errorf("failed to add facility amenities: %w", err)
+		}
+		if int(added) != len(slugs) {
+			return errUnknownAmenity
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return (*api.FacilitiesAmenitiesUpdateNotFound)(facilityNotFoundProblem()), nil
+	case errors.Is(err, errUnknownAmenity):
+		problem := newProblem(http.StatusBadRequest, "amenities must only list slugs of existing amenities.")
+		return (*api.FacilitiesAmenitiesUpdateBadRequest)(problem), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return &api.FacilityAmenities{Amenities: slugs}, nil
+}
+
+// facilityAmenities returns the slugs of the amenities offered by each of the facilities, ordered by slug.
+func facilityAmenities(ctx context.Context, q db.Querier, facilityIDs []int32) (map[int32][]string, error) {
+	rows, err := q.ListFacilityAmenities(ctx, facilityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facility amenities: %w", err)
+	}
+
+	amenities := make(map[int32][]string, len(facilityIDs))
+	for _, row := range rows {
+		amenities[row.FacilityID] = append(amenities[row.FacilityID], row.Slug)
+	}
+	return amenities, nil
+}
+
+// amenitySlugs returns the requested amenity slugs sorted and listed once.
+// It returns nil when no slugs are requested.
+func amenitySlugs(slugs []string) []string {
+	if len(slugs) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(slugs)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+// toAmenity converts a database amenity into its API representation.
+func toAmenity(a db.Amenity) api.Amenity {
+	return api.Amenity{
+		ID:          a.ID,
+		Slug:        a.Slug,
+		Name:        a.Name,
+		Description: optString(a.Description),
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+func amenityNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Amenity not found.")
+}
+
+func amenitySlugTakenProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "An amenity with that slug already exists.")
+}
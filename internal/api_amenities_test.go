@@ -0,0 +1,161 @@
+package internal_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestAmenitiesStaffOnlyOperations(t *testing.T) {
+	// Access checks run before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	regularCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       "regular-user-id",
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.AmenitiesCreate(t.Context(), &api.Amenity{Slug: "projector", Name: "Projector"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AmenitiesCreateUnauthorized{}, res)
+	})
+
+	t.Run("update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AmenitiesUpdate(regularCtx, &api.Amenity{Slug: "projector", Name: "Projector"},
+			api.AmenitiesUpdateParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AmenitiesUpdateForbidden{}, res)
+	})
+
+	t.Run("destroy rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AmenitiesDestroy(regularCtx, api.AmenitiesDestroyParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AmenitiesDestroyForbidden{}, res)
+	})
+
+	t.Run("facility amenities update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesAmenitiesUpdate(regularCtx, &api.FacilityAmenities{Amenities: []string{"projector"}},
+			api.FacilitiesAmenitiesUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesAmenitiesUpdateForbidden{}, res)
+	})
+}
+
+func TestAmenities(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	newAmenity := func(t *testing.T, name string) *api.Amenity {
+		t.Helper()
+		// The database is shared between runs, so slugs get a random suffix.
+		slug := fmt.Sprintf("%s-%d", name, gofakeit.Number(1, math.MaxInt32))
+		res, err := svc.AmenitiesCreate(staffCtx, &api.Amenity{Slug: slug, Name: name})
+		require.NoError(t, err)
+		amenity, ok := res.(*api.Amenity)
+		require.True(t, ok, "unexpected response %T", res)
+		return amenity
+	}
+	projector, whiteboard := newAmenity(t, "projector"), newAmenity(t, "whiteboard")
+
+	newFacility := func(t *testing.T, amenities ...string) *api.PublicFacility {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+
+		updateRes, err := svc.FacilitiesAmenitiesUpdate(staffCtx, &api.FacilityAmenities{Amenities: amenities},
+			api.FacilitiesAmenitiesUpdateParams{ID: facility.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.FacilityAmenities{}, updateRes)
+		return facility
+	}
+	both := newFacility(t, whiteboard.Slug, projector.Slug, projector.Slug)
+	onlyProjector := newFacility(t, projector.Slug)
+	none := newFacility(t)
+
+	t.Run("create rejects a taken slug", func(t *testing.T) {
+		res, err := svc.AmenitiesCreate(staffCtx, &api.Amenity{Slug: projector.Slug, Name: "Another projector"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AmenitiesCreateConflict{}, res)
+	})
+
+	t.Run("facility amenities update rejects unknown slugs", func(t *testing.T) {
+		res, err := svc.FacilitiesAmenitiesUpdate(staffCtx, &api.FacilityAmenities{
+			Amenities: []string{projector.Slug, "hologram"},
+		}, api.FacilitiesAmenitiesUpdateParams{ID: none.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesAmenitiesUpdateBadRequest{}, res)
+	})
+
+	t.Run("retrieve returns the amenities of the facility ordered by slug", func(t *testing.T) {
+		res, err := svc.FacilitiesRetrieve(ctx, api.FacilitiesRetrieveParams{ID: both.ID})
+		require.NoError(t, err)
+		found, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, []string{projector.Slug, whiteboard.Slug}, found.Amenities)
+	})
+
+	t.Run("list returns facilities offering every requested amenity", func(t *testing.T) {
+		ids := listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{Amenity: []string{projector.Slug}})
+		assert.Contains(t, ids, both.ID)
+		assert.Contains(t, ids, onlyProjector.ID)
+		assert.NotContains(t, ids, none.ID)
+
+		ids = listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{
+			Amenity: []string{projector.Slug, whiteboard.Slug},
+		})
+		assert.Equal(t, []int{both.ID}, ids)
+	})
+
+	t.Run("availability returns facilities offering every requested amenity", func(t *testing.T) {
+		from := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:    from,
+			To:      from.Add(time.Hour),
+			Amenity: []string{whiteboard.Slug, projector.Slug},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, both.ID, (*list)[0].FacilityID)
+	})
+
+	t.Run("destroy removes the amenity from facilities", func(t *testing.T) {
+		res, err := svc.AmenitiesDestroy(staffCtx, api.AmenitiesDestroyParams{ID: whiteboard.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AmenitiesDestroyNoContent{}, res)
+
+		retrieveRes, err := svc.FacilitiesRetrieve(ctx, api.FacilitiesRetrieveParams{ID: both.ID})
+		require.NoError(t, err)
+		found, ok := retrieveRes.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", retrieveRes)
+		assert.Equal(t, []string{projector.Slug}, found.Amenities)
+
+		amenityRes, err := svc.AmenitiesRetrieve(ctx, api.AmenitiesRetrieveParams{ID: whiteboard.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, amenityRes)
+	})
+}
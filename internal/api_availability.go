@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// maxAvailabilityRange bounds the searched range so that a search stays cheap across many facilities.
+const maxAvailabilityRange = 31 * 24 * time.Hour
+
+// AvailabilityList returns the free periods of active facilities within the requested range.
+// Free periods are computed by a single query subtracting the periods blocked by confirmed reservations,
+// widened so that the buffers of a new reservation fit, from the range. They are then narrowed to the opening
+// hours of each facility minus its blackouts, which are reported alongside. Facilities filtered by amenities
+// offer every one of them, and facilities filtered by location are located in it or in any location within it.
+func (s *APIService) AvailabilityList(
+	ctx context.Context,
+	params api.AvailabilityListParams,
+) (res api.AvailabilityListRes, err error) {
+	defer derrors.Wrap(&err, "AvailabilityList(ctx, params)")
+
+	if !params.From.Before(params.To) {
+		return newProblem(http.StatusBadRequest, "from must be before to."), nil
+	}
+	if params.To.Sub(params.From) > maxAvailabilityRange {
+		return newProblem(http.StatusBadRequest, "The searched range must not exceed 31 days."), nil
+	}
+
+	var facilityIDs []int32
+	if len(params.FacilityID) > 0 {
+		// IDs that cannot identify any facility simply match nothing.
+		facilityIDs = make([]int32, 0, len(params.FacilityID))
+		for _, v := range params.FacilityID {
+			if id, ok := toFacilityID(v); ok {
+				facilityIDs = append(facilityIDs, id)
+			}
+		}
+	}
+
+	rows, err := s.ds.ListFacilityAvailability(ctx, db.ListFacilityAvailabilityParams{
+		From:               params.From,
+		To:                 params.To,
+		FacilityIds:        facilityIDs,
+		Amenities:          amenitySlugs(params.Amenity),
+		LocationID:         ptrOf(params.LocationID),
+		MinDurationMinutes: params.MinDurationMinutes.Or(0),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facility availability: %w", err)
+	}
+
+	list, err := s.narrowAvailability(ctx, rows, params.From, params.To, params.MinDurationMinutes.Or(0))
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// narrowAvailability groups free periods, which are ordered by facility, into one entry per facility,
+// narrowing them to its opening hours minus its blackouts and reporting the blackouts overlapping [from, to).
+// Periods shorter than minDurationMinutes after narrowing are dropped, and so are facilities left without
+// free periods and blackouts. Periods are reported both in UTC and in the time zone of the facility.
+func (s *APIService) narrowAvailability(
+	ctx context.Context,
+	rows []db.ListFacilityAvailabilityRow,
+	from, to time.Time,
+	minDurationMinutes int32,
+) (api.AvailabilityListOKApplicationJSON, error) {
+	list := toFacilityAvailability(rows)
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	facilityIDs := make([]int32, 0, len(list))
+	for _, row := range rows {
+		if n := len(facilityIDs); n == 0 || facilityIDs[n-1] != row.FacilityID {
+			facilityIDs = append(facilityIDs, row.FacilityID)
+		}
+	}
+	calendars, err := loadOpeningHours(ctx, s.ds, facilityIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	blackouts, err := loadBlackouts(ctx, s.ds, facilityIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	minDuration := time.Duration(minDurationMinutes) * time.Minute
+	narrowed := make(api.AvailabilityListOKApplicationJSON, 0, len(list))
+	for i, entry := range list {
+		id := facilityIDs[i]
+		loc := calendars[id].loc
+		slots := make([]api.AvailabilitySlot, 0, len(entry.Slots))
+		for _, slot := range entry.Slots {
+			open := subtractBlackouts(calendars[id].openPeriods(slot.StartsAt, slot.EndsAt), blackouts[id])
+			for _, p := range open {
+				if p.end.Sub(p.start) >= minDuration {
+					slots = append(slots, toAvailabilitySlot(p.start, p.end, loc))
+				}
+			}
+		}
+		if len(slots) == 0 && len(blackouts[id]) == 0 {
+			continue
+		}
+
+		entry.TimeZone = loc.String()
+		entry.Slots = slots
+		for _, b := range blackouts[id] {
+			entry.Blackouts = append(entry.Blackouts, toBlackoutPeriod(b, loc))
+		}
+		narrowed = append(narrowed, entry)
+	}
+	return narrowed, nil
+}
+
+// toFacilityAvailability groups free periods, which are ordered by facility, into one entry per facility.
+// The periods are reported in UTC until narrowed in the time zone of the facility.
+func toFacilityAvailability(rows []db.ListFacilityAvailabilityRow) api.AvailabilityListOKApplicationJSON {
+	list := make(api.AvailabilityListOKApplicationJSON, 0)
+	for _, row := range rows {
+		if n := len(list); n == 0 || list[n-1].FacilityID != int(row.FacilityID) {
+			list = append(list, api.FacilityAvailability{
+				FacilityID: int(row.FacilityID),
+				Name:       row.FacilityName,
+				TimeZone:   time.UTC.String(),
+				Slots:      make([]api.AvailabilitySlot, 0, 1),
+				Blackouts:  make([]api.BlackoutPeriod, 0),
+			})
+		}
+
+		last := &list[len(list)-1]
+		last.Slots = append(last.Slots, toAvailabilitySlot(row.StartsAt, row.EndsAt, time.UTC))
+	}
+	return list
+}
+
+// toAvailabilitySlot converts a free period into its API representation in UTC and in loc.
+func toAvailabilitySlot(start, end time.Time, loc *time.Location) api.AvailabilitySlot {
+	return api.AvailabilitySlot{
+		StartsAt:      start.UTC(),
+		EndsAt:        end.UTC(),
+		LocalStartsAt: start.In(loc),
+		LocalEndsAt:   end.In(loc),
+	}
+}
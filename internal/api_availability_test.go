@@ -0,0 +1,116 @@
+package internal_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestAvailabilityListValidation(t *testing.T) {
+	// Invalid ranges are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+	from := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("rejects reversed range", func(t *testing.T) {
+		res, err := svc.AvailabilityList(t.Context(), api.AvailabilityListParams{
+			From: from,
+			To:   from.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		problem, ok := res.(*api.ProblemDetails)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptInt(http.StatusBadRequest), problem.Status)
+	})
+
+	t.Run("rejects too long range", func(t *testing.T) {
+		res, err := svc.AvailabilityList(t.Context(), api.AvailabilityListParams{
+			From: from,
+			To:   from.AddDate(0, 2, 0),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+}
+
+func TestAvailabilityList(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	from := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	to := from.Add(4 * time.Hour)
+
+	// Reserve [from+1h, from+3h), leaving one hour free at each end.
+	_, err = svc.ReservationsCreate(userCtx, &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Workshop",
+		StartsAt:   from.Add(time.Hour),
+		EndsAt:     from.Add(3 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+		From:       from,
+		To:         to,
+		FacilityID: []int{facility.ID},
+	})
+	require.NoError(t, err)
+	list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+	require.True(t, ok, "unexpected response %T", res)
+	require.Len(t, *list, 1)
+
+	slots := (*list)[0].Slots
+	require.Len(t, slots, 2)
+	assert.True(t, from.Equal(slots[0].StartsAt))
+	assert.True(t, from.Add(time.Hour).Equal(slots[0].EndsAt))
+	assert.True(t, from.Add(3*time.Hour).Equal(slots[1].StartsAt))
+	assert.True(t, to.Equal(slots[1].EndsAt))
+
+	t.Run("min duration drops short slots", func(t *testing.T) {
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:               from,
+			To:                 to,
+			MinDurationMinutes: api.NewOptInt32(90),
+			FacilityID:         []int{facility.ID},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Empty(t, *list)
+	})
+}
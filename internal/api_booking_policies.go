@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// BookingPolicyRetrieve returns the organization-wide booking policy inherited by every facility.
+func (s *APIService) BookingPolicyRetrieve(ctx context.Context) (res *api.BookingPolicy, err error) {
+	defer derrors.Wrap(&err, "BookingPolicyRetrieve(ctx)")
+
+	defaults, err := s.ds.GetDefaultBookingPolicy(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get default booking policy: %w", err)
+	}
+
+	found := toAPIBookingPolicy(defaults)
+	return &found, nil
+}
+
+// BookingPolicyUpdate replaces the organization-wide booking policy. Only staff users are allowed.
+// Existing reservations are kept even if they violate the new policy.
+func (s *APIService) BookingPolicyUpdate(
+	ctx context.Context,
+	req *api.BookingPolicy,
+) (res api.BookingPolicyUpdateRes, err error) {
+	defer derrors.Wrap(&err, "BookingPolicyUpdate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.BookingPolicyUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.BookingPolicyUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem := validateBookingPolicy(req); problem != nil {
+		return (*api.BookingPolicyUpdateBadRequest)(problem), nil
+	}
+
+	defaults, err := s.ds.UpsertBookingPolicy(ctx, toUpsertBookingPolicyParams(req, nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert default booking policy: %w", err)
+	}
+
+	updated := toAPIBookingPolicy(defaults)
+	return &updated, nil
+}
+
+// FacilitiesBookingPolicyRetrieve returns the booking policy of a facility together with the rules in effect.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesBookingPolicyRetrieve(
+	ctx context.Context,
+	params api.FacilitiesBookingPolicyRetrieveParams,
+) (res api.FacilitiesBookingPolicyRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBookingPolicyRetrieve(ctx, %d)", params.ID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	own, defaults, err := loadBookingPolicies(ctx, s.ds, facility.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toFacilityBookingPolicy(own, defaults)
+	return &found, nil
+}
+
+// FacilitiesBookingPolicyUpdate replaces the booking policy of a facility. Rules left unset are inherited
+// from the organization-wide default. Only staff users are allowed.
+func (s *APIService) FacilitiesBookingPolicyUpdate(
+	ctx context.Context,
+	req *api.BookingPolicy,
+	params api.FacilitiesBookingPolicyUpdateParams,
+) (res api.FacilitiesBookingPolicyUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBookingPolicyUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesBookingPolicyUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesBookingPolicyUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem := validateBookingPolicy(req); problem != nil {
+		return (*api.FacilitiesBookingPolicyUpdateBadRequest)(problem), nil
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesBookingPolicyUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var own, defaults db.BookingPolicy
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+		if _, err := tx.UpsertBookingPolicy(ctx, toUpsertBookingPolicyParams(req, &id)); err != nil {
+			return fmt.Errorf("failed to upsert facility booking policy: %w", err)
+		}
+
+		var err error
+		own, defaults, err = loadBookingPolicies(ctx, tx, id)
+		return err
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesBookingPolicyUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	updated := toFacilityBookingPolicy(own, defaults)
+	return &updated, nil
+}
+
+// validateBookingPolicy checks the rules of a booking policy against each other.
+func validateBookingPolicy(req *api.BookingPolicy) *api.ProblemDetails {
+	minDuration, hasMinDuration := req.MinDurationMinutes.Get()
+	maxDuration, hasMaxDuration := req.MaxDurationMinutes.Get()
+	if hasMinDuration && hasMaxDuration && maxDuration < minDuration {
+		return newProblem(http.StatusBadRequest, "max_duration_minutes must not be less than min_duration_minutes.")
+	}
+	if granularity, ok := req.GranularityMinutes.Get(); ok && endOfDay%(time.Duration(granularity)*time.Minute) != 0 {
+		return newProblem(http.StatusBadRequest, "granularity_minutes must divide a day evenly.")
+	}
+	return nil
+}
+
+// toUpsertBookingPolicyParams converts a booking policy request into the arguments storing it.
+// A nil facility ID stores the organization-wide default.
+func toUpsertBookingPolicyParams(req *api.BookingPolicy, facilityID *int32) db.UpsertBookingPolicyParams {
+	var cutoff pgtype.Time
+	if v, ok := req.SameDayCutoff.Get(); ok {
+		cutoff = toPgTime(timeOfDay(v))
+	}
+	return db.UpsertBookingPolicyParams{
+		ID:                 uuid.Must(uuid.NewV7()),
+		FacilityID:         facilityID,
+		MinDurationMinutes: ptrOf(req.MinDurationMinutes),
+		MaxDurationMinutes: ptrOf(req.MaxDurationMinutes),
+		GranularityMinutes: ptrOf(req.GranularityMinutes),
+		MaxAdvanceDays:     ptrOf(req.MaxAdvanceDays),
+		SameDayCutoff:      cutoff,
+	}
+}
+
+// toAPIBookingPolicy converts a stored booking policy into its API representation.
+func toAPIBookingPolicy(p db.BookingPolicy) api.BookingPolicy {
+	res := api.BookingPolicy{
+		MinDurationMinutes: optInt32(p.MinDurationMinutes),
+		MaxDurationMinutes: optInt32(p.MaxDurationMinutes),
+		GranularityMinutes: optInt32(p.GranularityMinutes),
+		MaxAdvanceDays:     optInt32(p.MaxAdvanceDays),
+		SameDayCutoff:      api.OptTime{},
+	}
+	if p.SameDayCutoff.Valid {
+		res.SameDayCutoff = api.NewOptTime(toAPITime(dayOffset(p.SameDayCutoff)))
+	}
+	return res
+}
+
+// toFacilityBookingPolicy converts the booking policy of a facility and the organization-wide default
+// into the API representation of the facility policy.
+func toFacilityBookingPolicy(own, defaults db.BookingPolicy) api.FacilityBookingPolicy {
+	return api.FacilityBookingPolicy{
+		Policy:    toAPIBookingPolicy(own),
+		Effective: toAPIBookingPolicy(effectiveBookingPolicy(own, defaults)),
+	}
+}
@@ -0,0 +1,206 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestBookingPoliciesValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	staffCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+
+	tests := []struct {
+		name string
+		req  api.BookingPolicy
+	}{
+		{
+			name: "maximum below minimum duration",
+			req: api.BookingPolicy{
+				MinDurationMinutes: api.NewOptInt32(60),
+				MaxDurationMinutes: api.NewOptInt32(30),
+			},
+		},
+		{
+			name: "granularity not dividing a day",
+			req:  api.BookingPolicy{GranularityMinutes: api.NewOptInt32(7)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run("default update rejects "+tt.name, func(t *testing.T) {
+			res, err := svc.BookingPolicyUpdate(staffCtx, &tt.req)
+			require.NoError(t, err)
+			assert.IsType(t, &api.BookingPolicyUpdateBadRequest{}, res)
+		})
+
+		t.Run("facility update rejects "+tt.name, func(t *testing.T) {
+			res, err := svc.FacilitiesBookingPolicyUpdate(staffCtx, &tt.req,
+				api.FacilitiesBookingPolicyUpdateParams{ID: 1})
+			require.NoError(t, err)
+			assert.IsType(t, &api.FacilitiesBookingPolicyUpdateBadRequest{}, res)
+		})
+	}
+
+	t.Run("default update rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.BookingPolicyUpdate(t.Context(), &api.BookingPolicy{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.BookingPolicyUpdateUnauthorized{}, res)
+	})
+
+	t.Run("facility update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesBookingPolicyUpdate(userCtx, &api.BookingPolicy{},
+			api.FacilitiesBookingPolicyUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesBookingPolicyUpdateForbidden{}, res)
+	})
+}
+
+func TestBookingPolicies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	// The default policy is shared with other tests, so it only gets a rule no reservation there exceeds.
+	previous, err := svc.BookingPolicyRetrieve(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_, err := svc.BookingPolicyUpdate(staffCtx, previous)
+		assert.NoError(t, err)
+	}()
+	defaultRes, err := svc.BookingPolicyUpdate(staffCtx, &api.BookingPolicy{
+		MaxAdvanceDays: api.NewOptInt32(3650),
+	})
+	require.NoError(t, err)
+	require.IsType(t, &api.BookingPolicy{}, defaultRes)
+
+	t.Run("facility policy inherits the default", func(t *testing.T) {
+		res, err := svc.FacilitiesBookingPolicyUpdate(staffCtx, &api.BookingPolicy{
+			MinDurationMinutes: api.NewOptInt32(30),
+			MaxDurationMinutes: api.NewOptInt32(120),
+			GranularityMinutes: api.NewOptInt32(15),
+		}, api.FacilitiesBookingPolicyUpdateParams{ID: facility.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.FacilityBookingPolicy)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.False(t, updated.Policy.MaxAdvanceDays.IsSet())
+		assert.Equal(t, api.NewOptInt32(3650), updated.Effective.MaxAdvanceDays)
+		assert.Equal(t, api.NewOptInt32(15), updated.Effective.GranularityMinutes)
+	})
+
+	day := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+
+	t.Run("reservations violating several rules report all of them", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Stand-up",
+			StartsAt:   day.Add(9*time.Hour + 5*time.Minute),
+			EndsAt:     day.Add(9*time.Hour + 15*time.Minute),
+		})
+		require.NoError(t, err)
+		problem, ok := res.(*api.ReservationsCreateBadRequest)
+		require.True(t, ok, "unexpected response %T", res)
+
+		rules := make([]api.BookingPolicyRule, 0, len(problem.Violations))
+		for _, v := range problem.Violations {
+			rules = append(rules, v.Rule)
+		}
+		assert.Equal(t, []api.BookingPolicyRule{
+			api.BookingPolicyRuleMinDuration,
+			api.BookingPolicyRuleGranularity,
+		}, rules)
+	})
+
+	t.Run("series report each rule their occurrences violate once", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCreate(userCtx, &api.ReservationSeriesInput{
+			FacilityID: facility.ID,
+			Title:      "Stand-up",
+			StartsAt:   day.Add(9*time.Hour + 5*time.Minute),
+			EndsAt:     day.Add(9*time.Hour + 15*time.Minute),
+			Rrule:      "FREQ=DAILY;COUNT=3",
+			TimeZone:   api.NewOptString("UTC"),
+		}, api.ReservationSeriesCreateParams{
+			ConflictMode: api.NewOptConflictMode(api.ConflictModeSkip),
+		})
+		require.NoError(t, err)
+		problem, ok := res.(*api.ReservationSeriesCreateBadRequest)
+		require.True(t, ok, "unexpected response %T", res)
+
+		rules := make([]api.BookingPolicyRule, 0, len(problem.Violations))
+		for _, v := range problem.Violations {
+			rules = append(rules, v.Rule)
+		}
+		assert.Equal(t, []api.BookingPolicyRule{
+			api.BookingPolicyRuleMinDuration,
+			api.BookingPolicyRuleGranularity,
+		}, rules)
+	})
+
+	t.Run("updates exceeding the maximum duration are rejected", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Workshop",
+			StartsAt:   day.Add(9 * time.Hour),
+			EndsAt:     day.Add(10*time.Hour + 30*time.Minute),
+		})
+		require.NoError(t, err)
+		reservation, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+
+		updateRes, err := svc.ReservationsUpdate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Workshop",
+			StartsAt:   day.Add(9 * time.Hour),
+			EndsAt:     day.Add(12 * time.Hour),
+		}, api.ReservationsUpdateParams{ID: reservation.ID})
+		require.NoError(t, err)
+		problem, ok := updateRes.(*api.ReservationsUpdateBadRequest)
+		require.True(t, ok, "unexpected response %T", updateRes)
+		require.Len(t, problem.Violations, 1)
+		assert.Equal(t, api.BookingPolicyRuleMaxDuration, problem.Violations[0].Rule)
+	})
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// BookingQuotaRetrieve returns the organization-wide booking quota of every user, counted across all facilities.
+func (s *APIService) BookingQuotaRetrieve(ctx context.Context) (res *api.BookingQuota, err error) {
+	defer derrors.Wrap(&err, "BookingQuotaRetrieve(ctx)")
+
+	quota, err := s.ds.GetBookingQuota(ctx, db.GetBookingQuotaParams{FacilityID: nil, UserID: nil})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get booking quota: %w", err)
+	}
+
+	found := toAPIBookingQuota(quota)
+	return &found, nil
+}
+
+// BookingQuotaUpdate replaces the organization-wide booking quota of every user. Only staff users are allowed.
+// Existing reservations are kept even if they exceed the new quota.
+func (s *APIService) BookingQuotaUpdate(
+	ctx context.Context,
+	req *api.BookingQuota,
+) (res api.BookingQuotaUpdateRes, err error) {
+	defer derrors.Wrap(&err, "BookingQuotaUpdate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.BookingQuotaUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.BookingQuotaUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	quota, err := s.ds.UpsertBookingQuota(ctx, toUpsertBookingQuotaParams(req, nil, nil))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert booking quota: %w", err)
+	}
+
+	updated := toAPIBookingQuota(quota)
+	return &updated, nil
+}
+
+// FacilitiesBookingQuotaRetrieve returns the booking quota of every user of a facility.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesBookingQuotaRetrieve(
+	ctx context.Context,
+	params api.FacilitiesBookingQuotaRetrieveParams,
+) (res api.FacilitiesBookingQuotaRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBookingQuotaRetrieve(ctx, %d)", params.ID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	quota, err := s.ds.GetBookingQuota(ctx, db.GetBookingQuotaParams{FacilityID: &facility.ID, UserID: nil})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get booking quota: %w", err)
+	}
+
+	found := toAPIBookingQuota(quota)
+	return &found, nil
+}
+
+// FacilitiesBookingQuotaUpdate replaces the booking quota of every user of a facility. It applies in addition
+// to the organization-wide quota. Only staff users are allowed.
+func (s *APIService) FacilitiesBookingQuotaUpdate(
+	ctx context.Context,
+	req *api.BookingQuota,
+	params api.FacilitiesBookingQuotaUpdateParams,
+) (res api.FacilitiesBookingQuotaUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBookingQuotaUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesBookingQuotaUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesBookingQuotaUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesBookingQuotaUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var quota db.BookingQuota
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+
+		var err error
+		quota, err = tx.UpsertBookingQuota(ctx, toUpsertBookingQuotaParams(req, &id, nil))
+		if err != nil {
+			return fmt.Errorf("failed to upsert booking quota: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesBookingQuotaUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	updated := toAPIBookingQuota(quota)
+	return &updated, nil
+}
+
+// toUpsertBookingQuotaParams converts a booking quota request into the arguments storing it.
+// A nil facility ID stores an organization-wide quota and a nil user ID the quota of every user.
+func toUpsertBookingQuotaParams(
+	req *api.BookingQuota,
+	facilityID *int32,
+	userID *uuid.UUID,
+) db.UpsertBookingQuotaParams {
+	return db.UpsertBookingQuotaParams{
+		ID:                      uuid.Must(uuid.NewV7()),
+		FacilityID:              facilityID,
+		UserID:                  userID,
+		MaxHoursPerWeek:         ptrOf(req.MaxHoursPerWeek),
+		MaxUpcomingReservations: ptrOf(req.MaxUpcomingReservations),
+	}
+}
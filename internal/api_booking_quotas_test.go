@@ -0,0 +1,198 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestBookingQuotasValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+
+	t.Run("default update rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.BookingQuotaUpdate(t.Context(), &api.BookingQuota{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.BookingQuotaUpdateUnauthorized{}, res)
+	})
+
+	t.Run("facility update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesBookingQuotaUpdate(userCtx, &api.BookingQuota{},
+			api.FacilitiesBookingQuotaUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesBookingQuotaUpdateForbidden{}, res)
+	})
+
+	t.Run("usage list rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminUsersQuotasList(userCtx, api.AdminUsersQuotasListParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersQuotasListForbidden{}, res)
+	})
+
+	t.Run("override rejects non-staff users", func(t *testing.T) {
+		res, err := svc.AdminUsersQuotasUpdate(userCtx, &api.BookingQuota{},
+			api.AdminUsersQuotasUpdateParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersQuotasUpdateForbidden{}, res)
+	})
+
+	t.Run("override removal rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.AdminUsersQuotasDestroy(t.Context(),
+			api.AdminUsersQuotasDestroyParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.AdminUsersQuotasDestroyUnauthorized{}, res)
+	})
+}
+
+func TestBookingQuotas(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	// The organization-wide quota is shared with other tests, so only the facility gets a quota.
+	quotaRes, err := svc.FacilitiesBookingQuotaUpdate(staffCtx, &api.BookingQuota{
+		MaxHoursPerWeek:         api.NewOptInt32(40),
+		MaxUpcomingReservations: api.NewOptInt32(1),
+	}, api.FacilitiesBookingQuotaUpdateParams{ID: facility.ID})
+	require.NoError(t, err)
+	require.IsType(t, &api.BookingQuota{}, quotaRes)
+
+	day := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	reserve := func(hour int) (api.ReservationsCreateRes, error) {
+		return svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   day.Add(time.Duration(hour) * time.Hour),
+			EndsAt:     day.Add(time.Duration(hour+1) * time.Hour),
+		})
+	}
+
+	res, err := reserve(9)
+	require.NoError(t, err)
+	require.IsType(t, &api.Reservation{}, res)
+
+	t.Run("reservations beyond the quota are rejected", func(t *testing.T) {
+		res, err := reserve(11)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("an override raises the quota of the user", func(t *testing.T) {
+		overrideRes, err := svc.AdminUsersQuotasUpdate(staffCtx, &api.BookingQuota{
+			MaxUpcomingReservations: api.NewOptInt32(2),
+		}, api.AdminUsersQuotasUpdateParams{ID: created.User.ID, FacilityID: api.NewOptInt(facility.ID)})
+		require.NoError(t, err)
+		usage, ok := overrideRes.(*api.BookingQuotaUsage)
+		require.True(t, ok, "unexpected response %T", overrideRes)
+		assert.True(t, usage.IsOverride)
+		assert.EqualValues(t, 1, usage.UpcomingReservations)
+
+		res, err := reserve(11)
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, res)
+	})
+
+	t.Run("staff users see the usage of every quota", func(t *testing.T) {
+		listRes, err := svc.AdminUsersQuotasList(staffCtx, api.AdminUsersQuotasListParams{ID: created.User.ID})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.AdminUsersQuotasListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+
+		var found bool
+		for _, usage := range *list {
+			if usage.FacilityID.Or(0) == facility.ID {
+				found = true
+				assert.True(t, usage.IsOverride)
+				assert.EqualValues(t, 2, usage.UpcomingReservations)
+			}
+		}
+		assert.True(t, found, "facility quota not listed")
+	})
+
+	t.Run("removing the override restores the facility quota", func(t *testing.T) {
+		destroyRes, err := svc.AdminUsersQuotasDestroy(staffCtx, api.AdminUsersQuotasDestroyParams{
+			ID:         created.User.ID,
+			FacilityID: api.NewOptInt(facility.ID),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &api.AdminUsersQuotasDestroyNoContent{}, destroyRes)
+
+		res, err := reserve(13)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("series occurrences beyond the quota are rejected or skipped", func(t *testing.T) {
+		facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+		require.NoError(t, err)
+		other, ok := facilityRes.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", facilityRes)
+		quotaRes, err := svc.FacilitiesBookingQuotaUpdate(staffCtx, &api.BookingQuota{
+			MaxUpcomingReservations: api.NewOptInt32(2),
+		}, api.FacilitiesBookingQuotaUpdateParams{ID: other.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.BookingQuota{}, quotaRes)
+
+		input := &api.ReservationSeriesInput{
+			FacilityID: other.ID,
+			Title:      "Daily sync",
+			StartsAt:   day.Add(9 * time.Hour),
+			EndsAt:     day.Add(10 * time.Hour),
+			Rrule:      "FREQ=DAILY;COUNT=3",
+			TimeZone:   api.NewOptString("UTC"),
+		}
+		res, err := svc.ReservationSeriesCreate(userCtx, input, api.ReservationSeriesCreateParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCreateConflict{}, res)
+
+		res, err = svc.ReservationSeriesCreate(userCtx, input, api.ReservationSeriesCreateParams{
+			ConflictMode: api.NewOptConflictMode(api.ConflictModeSkip),
+		})
+		require.NoError(t, err)
+		series, ok := res.(*api.ReservationSeriesWithSkipped)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, series.Occurrences, 2)
+		require.Len(t, series.Skipped, 1)
+		assert.True(t, day.AddDate(0, 0, 2).Add(9*time.Hour).Equal(series.Skipped[0].StartsAt))
+	})
+}
@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// DelegationGrantsList returns delegation grants in the order they were made.
+// Staff users see all grants, other users the grants they gave and received.
+func (s *APIService) DelegationGrantsList(ctx context.Context) (res api.DelegationGrantsListRes, err error) {
+	defer derrors.Wrap(&err, "DelegationGrantsList(ctx)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return unauthenticatedProblem(), nil
+	}
+
+	var userID *uuid.UUID
+	if !caller.IsStaff {
+		id, err := uuid.Parse(caller.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+		}
+		userID = &id
+	}
+
+	grants, err := s.ds.ListDelegationGrants(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delegation grants: %w", err)
+	}
+
+	list := make(api.DelegationGrantsListOKApplicationJSON, 0, len(grants))
+	for _, g := range grants {
+		list = append(list, toDelegationGrant(g))
+	}
+	return &list, nil
+}
+
+// DelegationGrantsCreate lets another user book and manage reservations on behalf of the authenticated user.
+func (s *APIService) DelegationGrantsCreate(
+	ctx context.Context,
+	req *api.DelegationGrantInput,
+) (res api.DelegationGrantsCreateRes, err error) {
+	defer derrors.Wrap(&err, "DelegationGrantsCreate(ctx, req)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.DelegationGrantsCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	grantorID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+	if req.DelegateID == grantorID {
+		problem := newProblem(http.StatusBadRequest, "delegate_id must identify another user.")
+		return (*api.DelegationGrantsCreateBadRequest)(problem), nil
+	}
+
+	_, err = s.ds.GetUserByID(ctx, req.DelegateID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		problem := newProblem(http.StatusBadRequest, "delegate_id does not identify a user.")
+		return (*api.DelegationGrantsCreateBadRequest)(problem), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	grant, err := s.ds.CreateDelegationGrant(ctx, db.CreateDelegationGrantParams{
+		ID:         uuid.Must(uuid.NewV7()),
+		GrantorID:  grantorID,
+		DelegateID: req.DelegateID,
+	})
+	if isUniqueViolation(err) {
+		problem := newProblem(http.StatusConflict, "Delegation has already been granted to the user.")
+		return (*api.DelegationGrantsCreateConflict)(problem), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create delegation grant: %w", err)
+	}
+
+	created := toDelegationGrant(grant)
+	return &created, nil
+}
+
+// DelegationGrantsDestroy revokes a delegation grant. Reservations booked by the delegate are kept.
+// Only its grantor, its delegate and staff users are allowed.
+func (s *APIService) DelegationGrantsDestroy(
+	ctx context.Context,
+	params api.DelegationGrantsDestroyParams,
+) (res api.DelegationGrantsDestroyRes, err error) {
+	defer derrors.Wrap(&err, "DelegationGrantsDestroy(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.DelegationGrantsDestroyUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	grant, err := s.ds.GetDelegationGrantByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.DelegationGrantsDestroyNotFound)(delegationGrantNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegation grant: %w", err)
+	}
+	if !caller.IsStaff && caller.ID != grant.GrantorID.String() && caller.ID != grant.DelegateID.String() {
+		return (*api.DelegationGrantsDestroyNotFound)(delegationGrantNotFoundProblem()), nil
+	}
+
+	if err := s.ds.DeleteDelegationGrant(ctx, grant.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete delegation grant: %w", err)
+	}
+	return &api.DelegationGrantsDestroyNoContent{}, nil
+}
+
+// toDelegationGrant converts a database delegation grant into its API representation.
+func toDelegationGrant(g db.DelegationGrant) api.DelegationGrant {
+	return api.DelegationGrant{
+		ID:         g.ID,
+		GrantorID:  g.GrantorID,
+		DelegateID: g.DelegateID,
+		CreatedAt:  g.CreatedAt,
+	}
+}
+
+func delegationGrantNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Delegation grant not found.")
+}
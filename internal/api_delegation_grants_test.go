@@ -0,0 +1,162 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestDelegationGrantsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userID := uuid.Must(uuid.NewV7())
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       userID.String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.DelegationGrantsList(t.Context())
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.DelegationGrantsCreate(t.Context(), &api.DelegationGrantInput{DelegateID: userID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.DelegationGrantsCreateUnauthorized{}, res)
+	})
+
+	t.Run("create rejects granting delegation to oneself", func(t *testing.T) {
+		res, err := svc.DelegationGrantsCreate(userCtx, &api.DelegationGrantInput{DelegateID: userID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.DelegationGrantsCreateBadRequest{}, res)
+	})
+
+	t.Run("destroy rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.DelegationGrantsDestroy(t.Context(), api.DelegationGrantsDestroyParams{ID: userID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.DelegationGrantsDestroyUnauthorized{}, res)
+	})
+}
+
+func TestDelegationGrants(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUser := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	owner, delegate := newUser(t), newUser(t)
+	ownerID, delegateID := uuid.MustParse(owner.ID), uuid.MustParse(delegate.ID)
+	ownerCtx := internal.WithAuthenticatedUser(ctx, owner)
+	delegateCtx := internal.WithAuthenticatedUser(ctx, delegate)
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	bookForOwner := func(offset time.Duration) (api.ReservationsCreateRes, error) {
+		return svc.ReservationsCreate(delegateCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Board meeting",
+			StartsAt:   startsAt.Add(offset),
+			EndsAt:     startsAt.Add(offset + time.Hour),
+			UserID:     api.NewOptUUID(ownerID),
+		})
+	}
+
+	t.Run("booking for another user requires a grant", func(t *testing.T) {
+		res, err := bookForOwner(0)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateForbidden{}, res)
+	})
+
+	grantRes, err := svc.DelegationGrantsCreate(ownerCtx, &api.DelegationGrantInput{DelegateID: delegateID})
+	require.NoError(t, err)
+	grant, ok := grantRes.(*api.DelegationGrant)
+	require.True(t, ok, "unexpected response %T", grantRes)
+
+	t.Run("granting twice conflicts", func(t *testing.T) {
+		res, err := svc.DelegationGrantsCreate(ownerCtx, &api.DelegationGrantInput{DelegateID: delegateID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.DelegationGrantsCreateConflict{}, res)
+	})
+
+	t.Run("delegates see the grants they received", func(t *testing.T) {
+		res, err := svc.DelegationGrantsList(delegateCtx)
+		require.NoError(t, err)
+		list, ok := res.(*api.DelegationGrantsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, grant.ID, (*list)[0].ID)
+	})
+
+	var reservation *api.Reservation
+	t.Run("delegates book and manage reservations for the grantor", func(t *testing.T) {
+		res, err := bookForOwner(0)
+		require.NoError(t, err)
+		reservation, ok = res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, ownerID, reservation.UserID)
+		assert.Equal(t, api.NewOptUUID(delegateID), reservation.BookedBy)
+
+		retrieveRes, err := svc.ReservationsRetrieve(ownerCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, retrieveRes)
+
+		listRes, err := svc.ReservationsList(delegateCtx, api.ReservationsListParams{})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.ReservationsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		require.Len(t, *list, 1)
+		assert.Equal(t, reservation.ID, (*list)[0].ID)
+	})
+
+	t.Run("revoking the grant takes effect immediately", func(t *testing.T) {
+		res, err := svc.DelegationGrantsDestroy(ownerCtx, api.DelegationGrantsDestroyParams{ID: grant.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.DelegationGrantsDestroyNoContent{}, res)
+
+		retrieveRes, err := svc.ReservationsRetrieve(delegateCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsRetrieveNotFound{}, retrieveRes)
+
+		createRes, err := bookForOwner(2 * time.Hour)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateForbidden{}, createRes)
+	})
+}
@@ -0,0 +1,571 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+const (
+	defaultFacilityPriority         int64 = 0
+	defaultFacilityIsActive               = true
+	defaultFacilityBufferMinutes    int32 = 0
+	defaultFacilityRequiresApproval       = false
+
+	defaultFacilityPageLimit int32 = 50
+	// lastFacilityRank is the rank of facilities without a priority, which are listed after every other facility.
+	lastFacilityRank int64 = math.MaxInt64
+)
+
+// facilityCursor is the position in a listing of facilities after which the next page starts.
+type facilityCursor struct {
+	Sort api.FacilitySort `json:"s"`
+	Rank int64            `json:"r"`
+	Name string           `json:"n"`
+	ID   int32            `json:"i"`
+}
+
+// FacilitiesList returns a page of facilities ordered by priority and name, or by name alone.
+// Staff see inactive facilities too, other users only active ones.
+// Facilities filtered by amenities offer every one of them; slugs of amenities that do not exist match nothing.
+// Facilities filtered by location are located in it or in any location within it.
+func (s *APIService) FacilitiesList(
+	ctx context.Context,
+	params api.FacilitiesListParams,
+) (res api.FacilitiesListRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesList(ctx, params)")
+
+	sort := params.Sort.Or(api.FacilitySortPriority)
+	limit := params.Limit.Or(defaultFacilityPageLimit)
+	arg := db.ListFacilitiesPageByPriorityParams{
+		IsActive:   ptrOf(params.IsActive),
+		Amenities:  amenitySlugs(params.Amenity),
+		LocationID: ptrOf(params.LocationID),
+		AfterID:    nil,
+		AfterRank:  nil,
+		AfterName:  nil,
+		// One more facility than requested tells whether there is a next page.
+		PageLimit: limit + 1,
+	}
+	if checkStaffAccess(ctx) != staffAccessGranted {
+		if !params.IsActive.Or(true) {
+			return &api.FacilityPage{Items: []api.PublicFacility{}, NextCursor: api.OptString{}}, nil
+		}
+		active := true
+		arg.IsActive = &active
+	}
+	if v, ok := params.Cursor.Get(); ok {
+		cursor, err := decodeFacilityCursor(v)
+		if err != nil || cursor.Sort != sort {
+			detail := "cursor must be the next_cursor of a listing in the same sort."
+			return newProblem(http.StatusBadRequest, detail), nil
+		}
+		arg.AfterRank, arg.AfterName, arg.AfterID = &cursor.Rank, &cursor.Name, &cursor.ID
+	}
+
+	facilities, err := listFacilitiesPage(ctx, s.ds, sort, arg)
+	if err != nil {
+		return nil, err
+	}
+	page := &api.FacilityPage{Items: nil, NextCursor: api.OptString{}}
+	if len(facilities) > int(limit) {
+		facilities = facilities[:limit]
+		page.NextCursor = api.NewOptString(encodeFacilityCursor(sort, facilities[limit-1]))
+	}
+
+	facilityIDs := make([]int32, 0, len(facilities))
+	for _, f := range facilities {
+		facilityIDs = append(facilityIDs, f.ID)
+	}
+	amenities, err := facilityAmenities(ctx, s.ds, facilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := facilityTimeZones(ctx, s.ds, facilityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Items = make([]api.PublicFacility, 0, len(facilities))
+	for _, f := range facilities {
+		page.Items = append(page.Items, toPublicFacility(f, amenities[f.ID], zones[f.ID]))
+	}
+	return page, nil
+}
+
+// FacilitiesCreate creates a new facility. Only staff users are allowed.
+func (s *APIService) FacilitiesCreate(
+	ctx context.Context,
+	req *api.PublicFacility,
+) (res api.FacilitiesCreateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesCreate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesCreateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesCreateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem, found := timeZoneProblem(ptrOf(req.TimeZone)); found {
+		return (*api.FacilitiesCreateBadRequest)(problem), nil
+	}
+
+	priority := req.Priority.Or(defaultFacilityPriority)
+	facility, err := s.ds.CreateFacility(ctx, db.CreateFacilityParams{
+		Name:                  req.Name,
+		Description:           ptrOf(req.Description),
+		Location:              ptrOf(req.Location),
+		Priority:              &priority,
+		IsActive:              req.IsActive.Or(defaultFacilityIsActive),
+		SetupBufferMinutes:    req.SetupBufferMinutes.Or(defaultFacilityBufferMinutes),
+		TeardownBufferMinutes: req.TeardownBufferMinutes.Or(defaultFacilityBufferMinutes),
+		RequiresApproval:      req.RequiresApproval.Or(defaultFacilityRequiresApproval),
+		CheckInGraceMinutes:   ptrOf(req.CheckInGraceMinutes),
+		Capacity:              ptrOf(req.Capacity),
+		LocationID:            ptrOf(req.LocationID),
+		TimeZone:              ptrOf(req.TimeZone),
+	})
+	if isForeignKeyViolation(err) {
+		return (*api.FacilitiesCreateBadRequest)(unknownLocationProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create facility: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, facility.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new facility offers no amenities until they are set through its amenities endpoint.
+	created := toPublicFacility(facility, nil, loc)
+	return &created, nil
+}
+
+// FacilitiesRetrieve returns a single facility.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesRetrieve(
+	ctx context.Context,
+	params api.FacilitiesRetrieveParams,
+) (res api.FacilitiesRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesRetrieve(ctx, %d)", params.ID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	amenities, err := facilityAmenities(ctx, s.ds, []int32{facility.ID})
+	if err != nil {
+		return nil, err
+	}
+	loc, err := facilityTimeZone(ctx, s.ds, facility.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toPublicFacility(facility, amenities[facility.ID], loc)
+	return &found, nil
+}
+
+// FacilitiesUpdate replaces all writable fields of a facility. Only staff users are allowed.
+func (s *APIService) FacilitiesUpdate(
+	ctx context.Context,
+	req *api.PublicFacility,
+	params api.FacilitiesUpdateParams,
+) (res api.FacilitiesUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem, found := timeZoneProblem(ptrOf(req.TimeZone)); found {
+		return (*api.FacilitiesUpdateBadRequest)(problem), nil
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	priority := req.Priority.Or(defaultFacilityPriority)
+	facility, err := s.ds.UpdateFacility(ctx, db.UpdateFacilityParams{
+		ID:                    id,
+		Name:                  req.Name,
+		Description:           ptrOf(req.Description),
+		Location:              ptrOf(req.Location),
+		Priority:              &priority,
+		IsActive:              req.IsActive.Or(defaultFacilityIsActive),
+		SetupBufferMinutes:    req.SetupBufferMinutes.Or(defaultFacilityBufferMinutes),
+		TeardownBufferMinutes: req.TeardownBufferMinutes.Or(defaultFacilityBufferMinutes),
+		RequiresApproval:      req.RequiresApproval.Or(defaultFacilityRequiresApproval),
+		CheckInGraceMinutes:   ptrOf(req.CheckInGraceMinutes),
+		Capacity:              ptrOf(req.Capacity),
+		LocationID:            ptrOf(req.LocationID),
+		TimeZone:              ptrOf(req.TimeZone),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if isForeignKeyViolation(err) {
+		return (*api.FacilitiesUpdateBadRequest)(unknownLocationProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update facility: %w", err)
+	}
+
+	amenities, err := facilityAmenities(ctx, s.ds, []int32{facility.ID})
+	if err != nil {
+		return nil, err
+	}
+	loc, err := facilityTimeZone(ctx, s.ds, facility.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toPublicFacility(facility, amenities[facility.ID], loc)
+	return &updated, nil
+}
+
+// FacilitiesPartialUpdate applies a JSON merge patch to a facility. Only staff users are allowed.
+func (s *APIService) FacilitiesPartialUpdate(
+	ctx context.Context,
+	req *api.PublicFacilityMergePatchUpdate,
+	params api.FacilitiesPartialUpdateParams,
+) (res api.FacilitiesPartialUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesPartialUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesPartialUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesPartialUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if v, ok := req.IsActive.Get(); ok && v.IsNull() {
+		problem := newProblem(http.StatusBadRequest, "is_active must not be null.")
+		return (*api.FacilitiesPartialUpdateBadRequest)(problem), nil
+	}
+	if v, ok := req.SetupBufferMinutes.Get(); ok && v.IsNull() {
+		problem := newProblem(http.StatusBadRequest, "setup_buffer_minutes must not be null.")
+		return (*api.FacilitiesPartialUpdateBadRequest)(problem), nil
+	}
+	if v, ok := req.TeardownBufferMinutes.Get(); ok && v.IsNull() {
+		problem := newProblem(http.StatusBadRequest, "teardown_buffer_minutes must not be null.")
+		return (*api.FacilitiesPartialUpdateBadRequest)(problem), nil
+	}
+	if v, ok := req.RequiresApproval.Get(); ok && v.IsNull() {
+		problem := newProblem(http.StatusBadRequest, "requires_approval must not be null.")
+		return (*api.FacilitiesPartialUpdateBadRequest)(problem), nil
+	}
+	if v, ok := req.TimeZone.Get(); ok {
+		if timeZone, ok := v.GetString(); ok {
+			if problem, found := timeZoneProblem(&timeZone); found {
+				return (*api.FacilitiesPartialUpdateBadRequest)(problem), nil
+			}
+		}
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesPartialUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var facility db.Facility
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := tx.GetFacilityByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+
+		facility, err = tx.UpdateFacility(ctx, applyFacilityPatch(current, req))
+		if err != nil {
+			return fmt.Errorf("failed to update facility: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesPartialUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if isForeignKeyViolation(err) {
+		return (*api.FacilitiesPartialUpdateBadRequest)(unknownLocationProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	amenities, err := facilityAmenities(ctx, s.ds, []int32{facility.ID})
+	if err != nil {
+		return nil, err
+	}
+	loc, err := facilityTimeZone(ctx, s.ds, facility.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toPublicFacility(facility, amenities[facility.ID], loc)
+	return &updated, nil
+}
+
+// FacilitiesDestroy deletes a facility. Only staff users are allowed.
+func (s *APIService) FacilitiesDestroy(
+	ctx context.Context,
+	params api.FacilitiesDestroyParams,
+) (res api.FacilitiesDestroyRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesDestroy(ctx, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesDestroyNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	deleted, err := s.ds.DeleteFacility(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete facility: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.FacilitiesDestroyNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	return &api.FacilitiesDestroyNoContent{}, nil
+}
+
+// visibleFacility returns the facility identified by an API path ID.
+// It reports false when the facility does not exist, or is inactive and the caller is not a staff user.
+func (s *APIService) visibleFacility(ctx context.Context, id int) (db.Facility, bool, error) {
+	facilityID, ok := toFacilityID(id)
+	if !ok {
+		return db.Facility{}, false, nil
+	}
+
+	facility, err := s.ds.GetFacilityByID(ctx, facilityID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Facility{}, false, nil
+	}
+	if err != nil {
+		return db.Facility{}, false, fmt.Errorf("failed to get facility: %w", err)
+	}
+	if !facility.IsActive && checkStaffAccess(ctx) != staffAccessGranted {
+		return db.Facility{}, false, nil
+	}
+	return facility, true, nil
+}
+
+// applyFacilityPatch merges the fields present in req over the current facility row.
+// Fields explicitly set to null are cleared.
+func applyFacilityPatch(current db.Facility, req *api.PublicFacilityMergePatchUpdate) db.UpdateFacilityParams {
+	arg := db.UpdateFacilityParams{
+		ID:                    current.ID,
+		Name:                  current.Name,
+		Description:           current.Description,
+		Location:              current.Location,
+		Priority:              current.Priority,
+		IsActive:              current.IsActive,
+		SetupBufferMinutes:    current.SetupBufferMinutes,
+		TeardownBufferMinutes: current.TeardownBufferMinutes,
+		RequiresApproval:      current.RequiresApproval,
+		CheckInGraceMinutes:   current.CheckInGraceMinutes,
+		Capacity:              current.Capacity,
+		LocationID:            current.LocationID,
+		TimeZone:              current.TimeZone,
+	}
+
+	if v, ok := req.Name.Get(); ok {
+		arg.Name = v
+	}
+	if v, ok := req.Description.Get(); ok {
+		arg.Description = nil
+		if description, ok := v.GetString(); ok {
+			arg.Description = &description
+		}
+	}
+	if v, ok := req.Location.Get(); ok {
+		arg.Location = nil
+		if location, ok := v.GetString(); ok {
+			arg.Location = &location
+		}
+	}
+	if v, ok := req.Priority.Get(); ok {
+		arg.Priority = nil
+		if priority, ok := v.GetInt64(); ok {
+			arg.Priority = &priority
+		}
+	}
+	if v, ok := req.IsActive.Get(); ok {
+		if isActive, ok := v.GetBool(); ok {
+			arg.IsActive = isActive
+		}
+	}
+	if v, ok := req.SetupBufferMinutes.Get(); ok {
+		if minutes, ok := v.GetInt32(); ok {
+			arg.SetupBufferMinutes = minutes
+		}
+	}
+	if v, ok := req.TeardownBufferMinutes.Get(); ok {
+		if minutes, ok := v.GetInt32(); ok {
+			arg.TeardownBufferMinutes = minutes
+		}
+	}
+	if v, ok := req.RequiresApproval.Get(); ok {
+		if requiresApproval, ok := v.GetBool(); ok {
+			arg.RequiresApproval = requiresApproval
+		}
+	}
+	if v, ok := req.CheckInGraceMinutes.Get(); ok {
+		arg.CheckInGraceMinutes = nil
+		if minutes, ok := v.GetInt32(); ok {
+			arg.CheckInGraceMinutes = &minutes
+		}
+	}
+	if v, ok := req.Capacity.Get(); ok {
+		arg.Capacity = nil
+		if capacity, ok := v.GetInt32(); ok {
+			arg.Capacity = &capacity
+		}
+	}
+	if v, ok := req.LocationID.Get(); ok {
+		arg.LocationID = nil
+		if locationID, ok := v.GetUUID(); ok {
+			arg.LocationID = &locationID
+		}
+	}
+	if v, ok := req.TimeZone.Get(); ok {
+		arg.TimeZone = nil
+		if timeZone, ok := v.GetString(); ok {
+			arg.TimeZone = &timeZone
+		}
+	}
+
+	return arg
+}
+
+// listFacilitiesPage returns a page of facilities in the given sort, using the query whose order has an index.
+// The rank in arg is ignored when sorting by name.
+func listFacilitiesPage(
+	ctx context.Context,
+	q db.Querier,
+	sort api.FacilitySort,
+	arg db.ListFacilitiesPageByPriorityParams,
+) ([]db.Facility, error) {
+	var (
+		facilities []db.Facility
+		err        error
+	)
+	switch sort {
+	case api.FacilitySortName:
+		facilities, err = q.ListFacilitiesPageByName(ctx, db.ListFacilitiesPageByNameParams{
+			IsActive:   arg.IsActive,
+			Amenities:  arg.Amenities,
+			LocationID: arg.LocationID,
+			AfterID:    arg.AfterID,
+			AfterName:  arg.AfterName,
+			PageLimit:  arg.PageLimit,
+		})
+	case api.FacilitySortPriority:
+		facilities, err = q.ListFacilitiesPageByPriority(ctx, arg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facilities: %w", err)
+	}
+	return facilities, nil
+}
+
+// encodeFacilityCursor returns the opaque cursor of the page following the facility f in a listing in the given sort.
+func encodeFacilityCursor(sort api.FacilitySort, f db.Facility) string {
+	rank := lastFacilityRank
+	switch {
+	case sort == api.FacilitySortName:
+		rank = 0
+	case f.Priority != nil:
+		rank = *f.Priority
+	}
+
+	b, err := json.Marshal(facilityCursor{Sort: sort, Rank: rank, Name: f.Name, ID: f.ID})
+	if err != nil {
+		// A cursor holds only strings and numbers, which always marshal.
+		panic(fmt.Sprintf("failed to marshal facility cursor: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeFacilityCursor parses a cursor returned by encodeFacilityCursor.
+func decodeFacilityCursor(s string) (facilityCursor, error) {
+	var cursor facilityCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, fmt.Errorf("failed to decode facility cursor: %w", err)
+	}
+	if err := json.Unmarshal(b, &cursor); err != nil {
+		return cursor, fmt.Errorf("failed to unmarshal facility cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// toPublicFacility converts a database facility, the slugs of its amenities and the time zone it is evaluated in
+// into its API representation.
+func toPublicFacility(f db.Facility, amenities []string, loc *time.Location) api.PublicFacility {
+	if amenities == nil {
+		amenities = []string{}
+	}
+	return api.PublicFacility{
+		ID:                    int(f.ID),
+		Name:                  f.Name,
+		Description:           optString(f.Description),
+		Location:              optString(f.Location),
+		LocationID:            optUUID(f.LocationID),
+		TimeZone:              optString(f.TimeZone),
+		EffectiveTimeZone:     api.NewOptString(loc.String()),
+		Priority:              optInt64(f.Priority),
+		IsActive:              api.NewOptBool(f.IsActive),
+		SetupBufferMinutes:    api.NewOptInt32(f.SetupBufferMinutes),
+		TeardownBufferMinutes: api.NewOptInt32(f.TeardownBufferMinutes),
+		RequiresApproval:      api.NewOptBool(f.RequiresApproval),
+		CheckInGraceMinutes:   optInt32(f.CheckInGraceMinutes),
+		Capacity:              optInt32(f.Capacity),
+		Amenities:             amenities,
+		CreatedAt:             api.NewOptDateTime(f.CreatedAt),
+		UpdatedAt:             api.NewOptDateTime(f.UpdatedAt),
+	}
+}
+
+// toFacilityID converts an API path ID into a facilities primary key.
+// It reports false when the value cannot identify any facility.
+func toFacilityID(id int) (int32, bool) {
+	if id < 1 || id > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+func facilityNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Facility not found.")
+}
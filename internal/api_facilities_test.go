@@ -0,0 +1,296 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+// listFacilityIDs returns the IDs of the facilities listed with params, following next_cursor across pages.
+func listFacilityIDs(
+	ctx context.Context,
+	t *testing.T,
+	svc *internal.APIService,
+	params api.FacilitiesListParams,
+) []int {
+	t.Helper()
+	var ids []int
+	for {
+		res, err := svc.FacilitiesList(ctx, params)
+		require.NoError(t, err)
+		page, ok := res.(*api.FacilityPage)
+		require.True(t, ok, "unexpected response %T", res)
+		for _, f := range page.Items {
+			ids = append(ids, f.ID)
+		}
+		if !page.NextCursor.Set {
+			return ids
+		}
+		params.Cursor = page.NextCursor
+	}
+}
+
+func TestFacilitiesStaffOnlyOperations(t *testing.T) {
+	// Access checks run before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	regularUser := &internal.AuthenticatedUser{
+		ID:       "regular-user-id",
+		Username: "regular-user",
+		IsStaff:  false,
+	}
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.FacilitiesCreate(t.Context(), &api.PublicFacility{Name: "Room A"})
+		require.NoError(t, err)
+
+		problem, ok := res.(*api.FacilitiesCreateUnauthorized)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptInt(http.StatusUnauthorized), problem.Status)
+	})
+
+	t.Run("create rejects non-staff users", func(t *testing.T) {
+		ctx := internal.WithAuthenticatedUser(t.Context(), regularUser)
+
+		res, err := svc.FacilitiesCreate(ctx, &api.PublicFacility{Name: "Room A"})
+		require.NoError(t, err)
+
+		problem, ok := res.(*api.FacilitiesCreateForbidden)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptInt(http.StatusForbidden), problem.Status)
+	})
+
+	t.Run("update rejects non-staff users", func(t *testing.T) {
+		ctx := internal.WithAuthenticatedUser(t.Context(), regularUser)
+
+		res, err := svc.FacilitiesUpdate(ctx, &api.PublicFacility{Name: "Room A"}, api.FacilitiesUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesUpdateForbidden{}, res)
+	})
+
+	t.Run("partial update rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.FacilitiesPartialUpdate(
+			t.Context(), &api.PublicFacilityMergePatchUpdate{}, api.FacilitiesPartialUpdateParams{ID: 1},
+		)
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesPartialUpdateUnauthorized{}, res)
+	})
+
+	t.Run("destroy rejects non-staff users", func(t *testing.T) {
+		ctx := internal.WithAuthenticatedUser(t.Context(), regularUser)
+
+		res, err := svc.FacilitiesDestroy(ctx, api.FacilitiesDestroyParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesDestroyForbidden{}, res)
+	})
+}
+
+func TestAPIService_NewError(t *testing.T) {
+	svc := internal.NewAPIService(nil)
+
+	res := svc.NewError(t.Context(), errors.New("boom"))
+
+	require.NotNil(t, res)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	assert.Equal(t, api.UnexpectedErrorCodeINTERNALSERVERERROR, res.Response.Code)
+}
+
+func TestFacilitiesCRUD(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	createRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+		Name:        gofakeit.Company(),
+		Description: api.NewOptString("Large meeting room"),
+		Location:    api.NewOptString("2F"),
+	})
+	require.NoError(t, err)
+	created, ok := createRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", createRes)
+	assert.Positive(t, created.ID)
+	assert.Equal(t, api.NewOptInt64(0), created.Priority)
+	assert.Equal(t, api.NewOptBool(true), created.IsActive)
+
+	t.Run("list includes the created facility", func(t *testing.T) {
+		ids := listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{Limit: api.NewOptInt32(100)})
+		assert.Contains(t, ids, created.ID)
+	})
+
+	t.Run("retrieve returns the facility anonymously", func(t *testing.T) {
+		res, err := svc.FacilitiesRetrieve(ctx, api.FacilitiesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+
+		found, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, created.Name, found.Name)
+	})
+
+	t.Run("partial update clears nullable fields", func(t *testing.T) {
+		res, err := svc.FacilitiesPartialUpdate(staffCtx, &api.PublicFacilityMergePatchUpdate{
+			Location: api.NewOptPublicFacilityMergePatchUpdateLocation(
+				api.NewNullPublicFacilityMergePatchUpdateLocation(struct{}{}),
+			),
+			Priority: api.NewOptPublicFacilityMergePatchUpdatePriority(
+				api.NewInt64PublicFacilityMergePatchUpdatePriority(5),
+			),
+		}, api.FacilitiesPartialUpdateParams{ID: created.ID})
+		require.NoError(t, err)
+
+		updated, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, created.Name, updated.Name)
+		assert.Equal(t, created.Description, updated.Description)
+		assert.False(t, updated.Location.IsSet())
+		assert.Equal(t, api.NewOptInt64(5), updated.Priority)
+	})
+
+	t.Run("update hides inactive facility from anonymous users", func(t *testing.T) {
+		res, err := svc.FacilitiesUpdate(staffCtx, &api.PublicFacility{
+			Name:     created.Name,
+			IsActive: api.NewOptBool(false),
+		}, api.FacilitiesUpdateParams{ID: created.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.PublicFacility{}, res)
+
+		retrieveRes, err := svc.FacilitiesRetrieve(ctx, api.FacilitiesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, retrieveRes)
+
+		retrieveRes, err = svc.FacilitiesRetrieve(staffCtx, api.FacilitiesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.PublicFacility{}, retrieveRes)
+	})
+
+	t.Run("destroy removes the facility", func(t *testing.T) {
+		res, err := svc.FacilitiesDestroy(staffCtx, api.FacilitiesDestroyParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesDestroyNoContent{}, res)
+
+		res, err = svc.FacilitiesDestroy(staffCtx, api.FacilitiesDestroyParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesDestroyNotFound{}, res)
+	})
+}
+
+func TestFacilitiesListValidation(t *testing.T) {
+	// These requests are answered before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	t.Run("list rejects a malformed cursor", func(t *testing.T) {
+		res, err := svc.FacilitiesList(t.Context(), api.FacilitiesListParams{Cursor: api.NewOptString("not a cursor")})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+
+	t.Run("list returns no inactive facilities to anonymous users", func(t *testing.T) {
+		res, err := svc.FacilitiesList(t.Context(), api.FacilitiesListParams{IsActive: api.NewOptBool(false)})
+		require.NoError(t, err)
+		page, ok := res.(*api.FacilityPage)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Empty(t, page.Items)
+		assert.False(t, page.NextCursor.Set)
+	})
+}
+
+func TestFacilitiesListPagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	// The database is shared between runs, so the facilities are placed in a site of their own.
+	siteRes, err := svc.LocationsCreate(staffCtx, &api.Location{
+		Kind: api.LocationKindSite,
+		Name: fmt.Sprintf("Campus %d", gofakeit.Number(1, math.MaxInt32)),
+	})
+	require.NoError(t, err)
+	site, ok := siteRes.(*api.Location)
+	require.True(t, ok, "unexpected response %T", siteRes)
+
+	newFacility := func(t *testing.T, name string, priority int64, active bool) int {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:       name,
+			LocationID: api.NewOptUUID(site.ID),
+			Priority:   api.NewOptInt64(priority),
+			IsActive:   api.NewOptBool(active),
+		})
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		return facility.ID
+	}
+	roomC := newFacility(t, "Room C", 0, true)
+	roomA := newFacility(t, "Room A", 1, true)
+	roomB := newFacility(t, "Room B", 0, true)
+	closed := newFacility(t, "Room D", 0, false)
+
+	params := api.FacilitiesListParams{LocationID: api.NewOptUUID(site.ID), Limit: api.NewOptInt32(2)}
+
+	t.Run("list pages through facilities by priority", func(t *testing.T) {
+		res, err := svc.FacilitiesList(ctx, params)
+		require.NoError(t, err)
+		page, ok := res.(*api.FacilityPage)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, page.Items, 2)
+		assert.True(t, page.NextCursor.Set)
+
+		assert.Equal(t, []int{roomB, roomC, roomA}, listFacilityIDs(ctx, t, svc, params))
+	})
+
+	t.Run("list pages through facilities by name", func(t *testing.T) {
+		byName := params
+		byName.Sort = api.NewOptFacilitySort(api.FacilitySortName)
+		assert.Equal(t, []int{roomA, roomB, roomC}, listFacilityIDs(ctx, t, svc, byName))
+	})
+
+	t.Run("list rejects a cursor of another sort", func(t *testing.T) {
+		res, err := svc.FacilitiesList(ctx, params)
+		require.NoError(t, err)
+		page, ok := res.(*api.FacilityPage)
+		require.True(t, ok, "unexpected response %T", res)
+
+		byName := params
+		byName.Sort = api.NewOptFacilitySort(api.FacilitySortName)
+		byName.Cursor = page.NextCursor
+		res, err = svc.FacilitiesList(ctx, byName)
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+
+	t.Run("list includes inactive facilities for staff only", func(t *testing.T) {
+		assert.Equal(t, []int{roomB, closed, roomC, roomA}, listFacilityIDs(staffCtx, t, svc, params))
+
+		inactive := params
+		inactive.IsActive = api.NewOptBool(false)
+		assert.Equal(t, []int{closed}, listFacilityIDs(staffCtx, t, svc, inactive))
+		assert.Empty(t, listFacilityIDs(ctx, t, svc, inactive))
+	})
+}
@@ -0,0 +1,332 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// FacilitiesBlackoutsList returns the blackouts of a facility ordered by their first occurrence.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesBlackoutsList(
+	ctx context.Context,
+	params api.FacilitiesBlackoutsListParams,
+) (res api.FacilitiesBlackoutsListRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBlackoutsList(ctx, %d)", params.ID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	blackouts, err := s.ds.ListBlackouts(ctx, facility.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blackouts: %w", err)
+	}
+
+	list := make(api.FacilitiesBlackoutsListOKApplicationJSON, 0, len(blackouts))
+	for _, b := range blackouts {
+		list = append(list, toBlackout(b))
+	}
+	return &list, nil
+}
+
+// FacilitiesBlackoutsCreate blocks a facility for a one-off or recurring window. Only staff users are allowed.
+// Existing reservations are kept; when requested, the confirmed ones overlapping the blackout are returned.
+func (s *APIService) FacilitiesBlackoutsCreate(
+	ctx context.Context,
+	req *api.BlackoutInput,
+	params api.FacilitiesBlackoutsCreateParams,
+) (res api.FacilitiesBlackoutsCreateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBlackoutsCreate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesBlackoutsCreateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesBlackoutsCreateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	timeZone, err := blackoutTimeZone(ctx, s.ds, req, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, problem := expandBlackoutInput(req, timeZone)
+	if problem != nil {
+		return (*api.FacilitiesBlackoutsCreateBadRequest)(problem), nil
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesBlackoutsCreateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var blackout db.FacilityBlackout
+	var conflicts []db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		// Reservations lock the facility for share while they check its blackouts, so locking it keeps them
+		// from being created while the blackout is checked against them.
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+
+		var err error
+		blackout, err = tx.CreateBlackout(ctx, db.CreateBlackoutParams{
+			ID:         uuid.Must(uuid.NewV7()),
+			FacilityID: id,
+			Reason:     req.Reason,
+			StartsAt:   req.StartsAt,
+			EndsAt:     req.EndsAt,
+			Rrule:      ptrOf(req.Rrule),
+			TimeZone:   timeZone,
+			LastEndsAt: occurrences[len(occurrences)-1].EndsAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create blackout: %w", err)
+		}
+
+		if params.IncludeConflicts.Or(false) {
+			conflicts, err = blackoutConflicts(ctx, tx, id, occurrences)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesBlackoutsCreateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, id)
+	if err != nil {
+		return nil, err
+	}
+
+	created := toBlackoutWithConflicts(blackout, conflicts, params.IncludeConflicts.Or(false), loc)
+	return &created, nil
+}
+
+// FacilitiesBlackoutsRetrieve returns a blackout of a facility.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesBlackoutsRetrieve(
+	ctx context.Context,
+	params api.FacilitiesBlackoutsRetrieveParams,
+) (res api.FacilitiesBlackoutsRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBlackoutsRetrieve(ctx, %d, %s)", params.ID, params.BlackoutID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	blackout, err := s.ds.GetBlackoutByID(ctx, db.GetBlackoutByIDParams{
+		ID:         params.BlackoutID,
+		FacilityID: facility.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return blackoutNotFoundProblem(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blackout: %w", err)
+	}
+
+	found := toBlackout(blackout)
+	return &found, nil
+}
+
+// FacilitiesBlackoutsDestroy removes a blackout so that its windows can be reserved again.
+// Only staff users are allowed.
+func (s *APIService) FacilitiesBlackoutsDestroy(
+	ctx context.Context,
+	params api.FacilitiesBlackoutsDestroyParams,
+) (res api.FacilitiesBlackoutsDestroyRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesBlackoutsDestroy(ctx, %d, %s)", params.ID, params.BlackoutID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesBlackoutsDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesBlackoutsDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesBlackoutsDestroyNotFound)(blackoutNotFoundProblem()), nil
+	}
+
+	deleted, err := s.ds.DeleteBlackout(ctx, db.DeleteBlackoutParams{
+		ID:         params.BlackoutID,
+		FacilityID: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete blackout: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.FacilitiesBlackoutsDestroyNotFound)(blackoutNotFoundProblem()), nil
+	}
+
+	return &api.FacilitiesBlackoutsDestroyNoContent{}, nil
+}
+
+// blackoutTimeZone returns the name of the time zone in which the rule of a recurring blackout request is expanded,
+// defaulting to the time zone of the facility identified by the API path ID. A one-off blackout keeps the
+// requested time zone, which is rejected when set.
+func blackoutTimeZone(ctx context.Context, q db.Querier, req *api.BlackoutInput, id int) (*string, error) {
+	if req.TimeZone.IsSet() || !req.Rrule.IsSet() {
+		return ptrOf(req.TimeZone), nil
+	}
+
+	name := defaultTimeZone.String()
+	// Requests for facilities that do not exist are rejected once the facility is looked up.
+	if facilityID, ok := toFacilityID(id); ok {
+		loc, err := facilityTimeZone(ctx, q, facilityID)
+		if err != nil {
+			return nil, err
+		}
+		name = loc.String()
+	}
+	return &name, nil
+}
+
+// expandBlackoutInput validates a blackout request whose rule is expanded in the named time zone and returns its
+// occurrences.
+func expandBlackoutInput(req *api.BlackoutInput, timeZone *string) ([]occurrence, *api.ProblemDetails) {
+	if !req.StartsAt.Before(req.EndsAt) {
+		return nil, newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+	}
+
+	rrule, hasRrule := req.Rrule.Get()
+	if !hasRrule {
+		if timeZone != nil {
+			return nil, newProblem(http.StatusBadRequest, "time_zone must only be set together with rrule.")
+		}
+		return []occurrence{{StartsAt: req.StartsAt, EndsAt: req.EndsAt}}, nil
+	}
+
+	loc, err := loadSeriesLocation(*timeZone)
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, err.Error()+".")
+	}
+	occurrences, err := expandRecurrence(rrule, req.StartsAt, req.EndsAt, loc)
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, err.Error()+".")
+	}
+	return occurrences, nil
+}
+
+// blackoutConflicts returns the confirmed reservations of the facility overlapping any of the occurrences.
+func blackoutConflicts(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+	occurrences []occurrence,
+) ([]db.Reservation, error) {
+	from, to := occurrences[0].StartsAt, occurrences[len(occurrences)-1].EndsAt
+	reservations, err := q.ListReservations(ctx, db.ListReservationsParams{
+		UserID:           nil,
+		FacilityID:       &facilityID,
+		From:             &from,
+		To:               &to,
+		IncludeCancelled: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+
+	conflicts := make([]db.Reservation, 0)
+	for _, r := range reservations {
+		for _, o := range occurrences {
+			if o.StartsAt.Before(r.Period.Upper.Time) && o.EndsAt.After(r.Period.Lower.Time) {
+				conflicts = append(conflicts, r)
+				break
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// toBlackout converts a database blackout into its API representation.
+func toBlackout(b db.FacilityBlackout) api.Blackout {
+	return api.Blackout{
+		ID:         b.ID,
+		FacilityID: int(b.FacilityID),
+		StartsAt:   b.StartsAt,
+		EndsAt:     b.EndsAt,
+		Reason:     b.Reason,
+		Rrule:      optString(b.Rrule),
+		TimeZone:   optString(b.TimeZone),
+		CreatedAt:  b.CreatedAt,
+	}
+}
+
+// toBlackoutWithConflicts converts a created blackout and the reservations it conflicts with
+// into their API representation in loc, the time zone of the facility. Conflicts are omitted unless they were
+// requested.
+func toBlackoutWithConflicts(
+	b db.FacilityBlackout,
+	conflicts []db.Reservation,
+	includeConflicts bool,
+	loc *time.Location,
+) api.BlackoutWithConflicts {
+	var list []api.Reservation
+	if includeConflicts {
+		list = make([]api.Reservation, 0, len(conflicts))
+		for _, r := range conflicts {
+			list = append(list, toReservation(r, loc))
+		}
+	}
+
+	return api.BlackoutWithConflicts{
+		ID:         b.ID,
+		FacilityID: int(b.FacilityID),
+		StartsAt:   b.StartsAt,
+		EndsAt:     b.EndsAt,
+		Reason:     b.Reason,
+		Rrule:      optString(b.Rrule),
+		TimeZone:   optString(b.TimeZone),
+		CreatedAt:  b.CreatedAt,
+		Conflicts:  list,
+	}
+}
+
+// toBlackoutPeriod converts a blackout occurrence into its API representation in UTC and in loc, the time zone of
+// its facility.
+func toBlackoutPeriod(p blackoutPeriod, loc *time.Location) api.BlackoutPeriod {
+	return api.BlackoutPeriod{
+		BlackoutID:    p.blackout.ID,
+		StartsAt:      p.start.UTC(),
+		EndsAt:        p.end.UTC(),
+		LocalStartsAt: p.start.In(loc),
+		LocalEndsAt:   p.end.In(loc),
+		Reason:        p.blackout.Reason,
+	}
+}
+
+func blackoutNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Blackout not found.")
+}
+
+func blackoutConflictProblem(p blackoutPeriod) *api.ProblemDetails {
+	return newProblem(http.StatusConflict, fmt.Sprintf(
+		"The facility is blacked out from %s to %s: %s.",
+		p.start.Format(time.RFC3339), p.end.Format(time.RFC3339), p.blackout.Reason))
+}
@@ -0,0 +1,239 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestFacilityBlackoutsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	staffCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		req  api.BlackoutInput
+	}{
+		{
+			name: "reversed period",
+			req:  api.BlackoutInput{StartsAt: start, EndsAt: start.Add(-time.Hour), Reason: "Cleaning"},
+		},
+		{
+			name: "time zone without rrule",
+			req: api.BlackoutInput{
+				StartsAt: start,
+				EndsAt:   start.Add(time.Hour),
+				Reason:   "Cleaning",
+				TimeZone: api.NewOptString("Asia/Tokyo"),
+			},
+		},
+		{
+			name: "unbounded rule",
+			req: api.BlackoutInput{
+				StartsAt: start,
+				EndsAt:   start.Add(time.Hour),
+				Reason:   "Cleaning",
+				Rrule:    api.NewOptString("FREQ=WEEKLY"),
+				TimeZone: api.NewOptString("Asia/Tokyo"),
+			},
+		},
+		{
+			name: "unknown time zone",
+			req: api.BlackoutInput{
+				StartsAt: start,
+				EndsAt:   start.Add(time.Hour),
+				Reason:   "Cleaning",
+				Rrule:    api.NewOptString("FREQ=WEEKLY;COUNT=4"),
+				TimeZone: api.NewOptString("Mars/Olympus"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run("create rejects "+tt.name, func(t *testing.T) {
+			res, err := svc.FacilitiesBlackoutsCreate(staffCtx, &tt.req, api.FacilitiesBlackoutsCreateParams{ID: 1})
+			require.NoError(t, err)
+			assert.IsType(t, &api.FacilitiesBlackoutsCreateBadRequest{}, res)
+		})
+	}
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.FacilitiesBlackoutsCreate(t.Context(), &api.BlackoutInput{},
+			api.FacilitiesBlackoutsCreateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesBlackoutsCreateUnauthorized{}, res)
+	})
+
+	t.Run("create rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesBlackoutsCreate(userCtx, &api.BlackoutInput{},
+			api.FacilitiesBlackoutsCreateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesBlackoutsCreateForbidden{}, res)
+	})
+
+	t.Run("destroy rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesBlackoutsDestroy(userCtx, api.FacilitiesBlackoutsDestroyParams{
+			ID:         1,
+			BlackoutID: uuid.Must(uuid.NewV7()),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesBlackoutsDestroyForbidden{}, res)
+	})
+}
+
+func TestFacilityBlackouts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	day := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+
+	reservationRes, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Meeting",
+		StartsAt:   day.Add(10 * time.Hour),
+		EndsAt:     day.Add(11 * time.Hour),
+	})
+	require.NoError(t, err)
+	reservation, ok := reservationRes.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", reservationRes)
+
+	var blackout *api.BlackoutWithConflicts
+	t.Run("create reports conflicting reservations", func(t *testing.T) {
+		res, err := svc.FacilitiesBlackoutsCreate(staffCtx, &api.BlackoutInput{
+			StartsAt: day.Add(10*time.Hour + 30*time.Minute),
+			EndsAt:   day.Add(12 * time.Hour),
+			Reason:   "Floor cleaning",
+			Rrule:    api.NewOptString("FREQ=DAILY;COUNT=3"),
+			TimeZone: api.NewOptString("UTC"),
+		}, api.FacilitiesBlackoutsCreateParams{ID: facility.ID, IncludeConflicts: api.NewOptBool(true)})
+		require.NoError(t, err)
+		blackout, ok = res.(*api.BlackoutWithConflicts)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, blackout.Conflicts, 1)
+		assert.Equal(t, reservation.ID, blackout.Conflicts[0].ID)
+	})
+
+	t.Run("reservations overlapping an occurrence are rejected", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Lunch",
+			StartsAt:   day.AddDate(0, 0, 2).Add(11 * time.Hour),
+			EndsAt:     day.AddDate(0, 0, 2).Add(13 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("reservations made before a blackout can be edited but not moved into it", func(t *testing.T) {
+		res, err := svc.ReservationsUpdate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Renamed meeting",
+			StartsAt:   reservation.StartsAt,
+			EndsAt:     reservation.EndsAt,
+		}, api.ReservationsUpdateParams{ID: reservation.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, "Renamed meeting", updated.Title)
+
+		res, err = svc.ReservationsUpdate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Renamed meeting",
+			StartsAt:   reservation.StartsAt.Add(15 * time.Minute),
+			EndsAt:     reservation.EndsAt.Add(15 * time.Minute),
+		}, api.ReservationsUpdateParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsUpdateConflict{}, res)
+	})
+
+	t.Run("availability reports blackouts", func(t *testing.T) {
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:       day.AddDate(0, 0, 1),
+			To:         day.AddDate(0, 0, 2),
+			FacilityID: []int{facility.ID},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+
+		entry := (*list)[0]
+		require.Len(t, entry.Blackouts, 1)
+		assert.Equal(t, blackout.ID, entry.Blackouts[0].BlackoutID)
+		assert.Equal(t, "Floor cleaning", entry.Blackouts[0].Reason)
+		require.Len(t, entry.Slots, 2)
+		assert.True(t, day.AddDate(0, 0, 1).Add(10*time.Hour+30*time.Minute).Equal(entry.Slots[0].EndsAt))
+		assert.True(t, day.AddDate(0, 0, 1).Add(12*time.Hour).Equal(entry.Slots[1].StartsAt))
+	})
+
+	t.Run("destroy releases the windows", func(t *testing.T) {
+		res, err := svc.FacilitiesBlackoutsDestroy(staffCtx, api.FacilitiesBlackoutsDestroyParams{
+			ID:         facility.ID,
+			BlackoutID: blackout.ID,
+		})
+		require.NoError(t, err)
+		require.IsType(t, &api.FacilitiesBlackoutsDestroyNoContent{}, res)
+
+		retrieveRes, err := svc.FacilitiesBlackoutsRetrieve(ctx, api.FacilitiesBlackoutsRetrieveParams{
+			ID:         facility.ID,
+			BlackoutID: blackout.ID,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, retrieveRes)
+
+		createRes, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Lunch",
+			StartsAt:   day.AddDate(0, 0, 2).Add(11 * time.Hour),
+			EndsAt:     day.AddDate(0, 0, 2).Add(13 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, createRes)
+	})
+}
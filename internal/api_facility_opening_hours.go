@@ -0,0 +1,311 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// FacilitiesOpeningHoursRetrieve returns the weekly opening hours of a facility.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesOpeningHoursRetrieve(
+	ctx context.Context,
+	params api.FacilitiesOpeningHoursRetrieveParams,
+) (res api.FacilitiesOpeningHoursRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesOpeningHoursRetrieve(ctx, %d)", params.ID)
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return facilityNotFoundProblem(), nil
+	}
+
+	rules, err := s.ds.ListOpeningHours(ctx, []int32{facility.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	found := toOpeningHours(rules)
+	return &found, nil
+}
+
+// FacilitiesOpeningHoursUpdate replaces the weekly opening hours of a facility. Only staff users are allowed.
+// Existing reservations are kept even if they fall outside the new opening hours.
+func (s *APIService) FacilitiesOpeningHoursUpdate(
+	ctx context.Context,
+	req *api.OpeningHours,
+	params api.FacilitiesOpeningHoursUpdateParams,
+) (res api.FacilitiesOpeningHoursUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesOpeningHoursUpdate(ctx, req, %d)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesOpeningHoursUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesOpeningHoursUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	for i, rule := range req.Rules {
+		if timeOfDay(rule.OpensAt) >= closingTimeOfDay(rule.ClosesAt) {
+			problem := newProblem(http.StatusBadRequest, fmt.Sprintf("rules[%d].closes_at must be after opens_at.", i))
+			return (*api.FacilitiesOpeningHoursUpdateBadRequest)(problem), nil
+		}
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesOpeningHoursUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var rules []db.FacilityOpeningHour
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		// Locking the facility serializes concurrent replacements of its rules.
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+		if err := tx.DeleteOpeningHours(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete opening hours: %w", err)
+		}
+
+		for _, rule := range req.Rules {
+			_, err := tx.CreateOpeningHours(ctx, db.CreateOpeningHoursParams{
+				ID:         uuid.Must(uuid.NewV7()),
+				FacilityID: id,
+				Weekday:    int16(rule.Weekday),
+				OpensAt:    toPgTime(timeOfDay(rule.OpensAt)),
+				ClosesAt:   toPgTime(closingTimeOfDay(rule.ClosesAt)),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create opening hours: %w", err)
+			}
+		}
+
+		var err error
+		rules, err = tx.ListOpeningHours(ctx, []int32{id})
+		if err != nil {
+			return fmt.Errorf("failed to list opening hours: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesOpeningHoursUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	updated := toOpeningHours(rules)
+	return &updated, nil
+}
+
+// FacilitiesOpeningHoursOverridesList returns the opening hours overrides of a facility within the given dates.
+// Inactive facilities are only visible to staff users.
+func (s *APIService) FacilitiesOpeningHoursOverridesList(
+	ctx context.Context,
+	params api.FacilitiesOpeningHoursOverridesListParams,
+) (res api.FacilitiesOpeningHoursOverridesListRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesOpeningHoursOverridesList(ctx, %d)", params.ID)
+
+	from, to := ptrOf(params.From), ptrOf(params.To)
+	if from != nil && to != nil && from.After(*to) {
+		problem := newProblem(http.StatusBadRequest, "from must not be after to.")
+		return (*api.FacilitiesOpeningHoursOverridesListBadRequest)(problem), nil
+	}
+
+	facility, ok, err := s.visibleFacility(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.FacilitiesOpeningHoursOverridesListNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	overrides, err := s.ds.ListOpeningHourOverrides(ctx, db.ListOpeningHourOverridesParams{
+		FacilityIds: []int32{facility.ID},
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening hour overrides: %w", err)
+	}
+
+	list := make(api.FacilitiesOpeningHoursOverridesListOKApplicationJSON, 0, len(overrides))
+	for _, o := range overrides {
+		list = append(list, toOpeningHoursOverride(o))
+	}
+	return &list, nil
+}
+
+// FacilitiesOpeningHoursOverridesUpdate sets the opening hours of a facility on a date,
+// replacing its weekly rules for that day. Only staff users are allowed.
+func (s *APIService) FacilitiesOpeningHoursOverridesUpdate(
+	ctx context.Context,
+	req *api.OpeningHoursOverrideInput,
+	params api.FacilitiesOpeningHoursOverridesUpdateParams,
+) (res api.FacilitiesOpeningHoursOverridesUpdateRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesOpeningHoursOverridesUpdate(ctx, req, %d, %s)",
+		params.ID, params.Date.Format(time.DateOnly))
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesOpeningHoursOverridesUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesOpeningHoursOverridesUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	// Leaving both times NULL closes the facility for the whole day.
+	var opens, closes pgtype.Time
+	opensAt, hasOpensAt := req.OpensAt.Get()
+	closesAt, hasClosesAt := req.ClosesAt.Get()
+	switch {
+	case hasOpensAt != hasClosesAt:
+		problem := newProblem(http.StatusBadRequest, "opens_at and closes_at must be set together.")
+		return (*api.FacilitiesOpeningHoursOverridesUpdateBadRequest)(problem), nil
+	case hasOpensAt && timeOfDay(opensAt) >= closingTimeOfDay(closesAt):
+		problem := newProblem(http.StatusBadRequest, "closes_at must be after opens_at.")
+		return (*api.FacilitiesOpeningHoursOverridesUpdateBadRequest)(problem), nil
+	case hasOpensAt:
+		opens, closes = toPgTime(timeOfDay(opensAt)), toPgTime(closingTimeOfDay(closesAt))
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesOpeningHoursOverridesUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+
+	var override db.FacilityOpeningHourOverride
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		if _, err := tx.GetFacilityByIDForUpdate(ctx, id); err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+
+		var err error
+		override, err = tx.UpsertOpeningHourOverride(ctx, db.UpsertOpeningHourOverrideParams{
+			FacilityID: id,
+			Date:       params.Date,
+			OpensAt:    opens,
+			ClosesAt:   closes,
+			Reason:     ptrOf(req.Reason),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert opening hour override: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.FacilitiesOpeningHoursOverridesUpdateNotFound)(facilityNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	updated := toOpeningHoursOverride(override)
+	return &updated, nil
+}
+
+// FacilitiesOpeningHoursOverridesDestroy removes the opening hours override of a date
+// so that the weekly rules apply again. Only staff users are allowed.
+func (s *APIService) FacilitiesOpeningHoursOverridesDestroy(
+	ctx context.Context,
+	params api.FacilitiesOpeningHoursOverridesDestroyParams,
+) (res api.FacilitiesOpeningHoursOverridesDestroyRes, err error) {
+	defer derrors.Wrap(&err, "FacilitiesOpeningHoursOverridesDestroy(ctx, %d, %s)",
+		params.ID, params.Date.Format(time.DateOnly))
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.FacilitiesOpeningHoursOverridesDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.FacilitiesOpeningHoursOverridesDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	id, ok := toFacilityID(params.ID)
+	if !ok {
+		return (*api.FacilitiesOpeningHoursOverridesDestroyNotFound)(openingHoursOverrideNotFoundProblem()), nil
+	}
+
+	deleted, err := s.ds.DeleteOpeningHourOverride(ctx, db.DeleteOpeningHourOverrideParams{
+		FacilityID: id,
+		Date:       params.Date,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete opening hour override: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.FacilitiesOpeningHoursOverridesDestroyNotFound)(openingHoursOverrideNotFoundProblem()), nil
+	}
+
+	return &api.FacilitiesOpeningHoursOverridesDestroyNoContent{}, nil
+}
+
+// timeOfDay converts an API time into an offset from midnight.
+func timeOfDay(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
+}
+
+// closingTimeOfDay converts an API closing time into an offset from midnight, where 00:00:00 closes at the end
+// of the day.
+func closingTimeOfDay(t time.Time) time.Duration {
+	if offset := timeOfDay(t); offset > 0 {
+		return offset
+	}
+	return endOfDay
+}
+
+// toAPITime converts an offset from midnight into an API time. The end of the day is represented as 00:00:00.
+func toAPITime(offset time.Duration) time.Time {
+	return time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(offset % endOfDay)
+}
+
+// toOpeningHours converts the weekly rules of a facility into their API representation.
+func toOpeningHours(rules []db.FacilityOpeningHour) api.OpeningHours {
+	list := make([]api.OpeningHoursRule, 0, len(rules))
+	for _, r := range rules {
+		list = append(list, api.OpeningHoursRule{
+			Weekday:  int32(r.Weekday),
+			OpensAt:  toAPITime(dayOffset(r.OpensAt)),
+			ClosesAt: toAPITime(dayOffset(r.ClosesAt)),
+		})
+	}
+	return api.OpeningHours{Rules: list}
+}
+
+// toOpeningHoursOverride converts a database override into its API representation.
+func toOpeningHoursOverride(o db.FacilityOpeningHourOverride) api.OpeningHoursOverride {
+	res := api.OpeningHoursOverride{
+		Date:      o.Date,
+		OpensAt:   api.OptTime{},
+		ClosesAt:  api.OptTime{},
+		Reason:    optString(o.Reason),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+	if o.OpensAt.Valid && o.ClosesAt.Valid {
+		res.OpensAt = api.NewOptTime(toAPITime(dayOffset(o.OpensAt)))
+		res.ClosesAt = api.NewOptTime(toAPITime(dayOffset(o.ClosesAt)))
+	}
+	return res
+}
+
+func openingHoursOverrideNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Opening hours override not found.")
+}
+
+func outsideOpeningHoursProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "The reservation is outside the opening hours of the facility.")
+}
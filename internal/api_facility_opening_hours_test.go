@@ -0,0 +1,263 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+// clock returns a time of day as decoded from an API time.
+func clock(hour, minute int) time.Time {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func TestFacilityOpeningHoursValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	staffCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	date := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("update rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursUpdate(t.Context(), &api.OpeningHours{},
+			api.FacilitiesOpeningHoursUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursUpdateUnauthorized{}, res)
+	})
+
+	t.Run("update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursUpdate(userCtx, &api.OpeningHours{},
+			api.FacilitiesOpeningHoursUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursUpdateForbidden{}, res)
+	})
+
+	t.Run("update rejects reversed rule", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursUpdate(staffCtx, &api.OpeningHours{Rules: []api.OpeningHoursRule{
+			{Weekday: 1, OpensAt: clock(18, 0), ClosesAt: clock(9, 0)},
+		}}, api.FacilitiesOpeningHoursUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursUpdateBadRequest{}, res)
+	})
+
+	t.Run("overrides list rejects reversed range", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursOverridesList(t.Context(), api.FacilitiesOpeningHoursOverridesListParams{
+			ID:   1,
+			From: api.NewOptDate(date),
+			To:   api.NewOptDate(date.AddDate(0, 0, -1)),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursOverridesListBadRequest{}, res)
+	})
+
+	t.Run("override update rejects a single time", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursOverridesUpdate(staffCtx, &api.OpeningHoursOverrideInput{
+			OpensAt: api.NewOptTime(clock(9, 0)),
+		}, api.FacilitiesOpeningHoursOverridesUpdateParams{ID: 1, Date: date})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursOverridesUpdateBadRequest{}, res)
+	})
+
+	t.Run("override update rejects reversed period", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursOverridesUpdate(staffCtx, &api.OpeningHoursOverrideInput{
+			OpensAt:  api.NewOptTime(clock(12, 0)),
+			ClosesAt: api.NewOptTime(clock(12, 0)),
+		}, api.FacilitiesOpeningHoursOverridesUpdateParams{ID: 1, Date: date})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursOverridesUpdateBadRequest{}, res)
+	})
+
+	t.Run("override destroy rejects non-staff users", func(t *testing.T) {
+		res, err := svc.FacilitiesOpeningHoursOverridesDestroy(userCtx,
+			api.FacilitiesOpeningHoursOverridesDestroyParams{ID: 1, Date: date})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesOpeningHoursOverridesDestroyForbidden{}, res)
+	})
+}
+
+func TestFacilityOpeningHours(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	// Open on weekdays from 09:00 to 18:00.
+	rules := make([]api.OpeningHoursRule, 0, 5)
+	for weekday := int32(1); weekday <= 5; weekday++ {
+		rules = append(rules, api.OpeningHoursRule{Weekday: weekday, OpensAt: clock(9, 0), ClosesAt: clock(18, 0)})
+	}
+	updateRes, err := svc.FacilitiesOpeningHoursUpdate(staffCtx, &api.OpeningHours{Rules: rules},
+		api.FacilitiesOpeningHoursUpdateParams{ID: facility.ID})
+	require.NoError(t, err)
+	require.IsType(t, &api.OpeningHours{}, updateRes)
+
+	retrieveRes, err := svc.FacilitiesOpeningHoursRetrieve(ctx,
+		api.FacilitiesOpeningHoursRetrieveParams{ID: facility.ID})
+	require.NoError(t, err)
+	hours, ok := retrieveRes.(*api.OpeningHours)
+	require.True(t, ok, "unexpected response %T", retrieveRes)
+	assert.Equal(t, rules, hours.Rules)
+
+	monday := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	for monday.Weekday() != time.Monday {
+		monday = monday.AddDate(0, 0, 1)
+	}
+
+	availability := func(t *testing.T, date time.Time) []api.AvailabilitySlot {
+		t.Helper()
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:       date,
+			To:         date.AddDate(0, 0, 1),
+			FacilityID: []int{facility.ID},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		if len(*list) == 0 {
+			return nil
+		}
+		return (*list)[0].Slots
+	}
+
+	t.Run("create rejects periods outside opening hours", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Early meeting",
+			StartsAt:   monday.Add(8 * time.Hour),
+			EndsAt:     monday.Add(10 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	t.Run("availability is narrowed to opening hours", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   monday.Add(10 * time.Hour),
+			EndsAt:     monday.Add(11 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &api.Reservation{}, res)
+
+		slots := availability(t, monday)
+		require.Len(t, slots, 2)
+		assert.True(t, monday.Add(9*time.Hour).Equal(slots[0].StartsAt))
+		assert.True(t, monday.Add(10*time.Hour).Equal(slots[0].EndsAt))
+		assert.True(t, monday.Add(11*time.Hour).Equal(slots[1].StartsAt))
+		assert.True(t, monday.Add(18*time.Hour).Equal(slots[1].EndsAt))
+
+		assert.Empty(t, availability(t, monday.AddDate(0, 0, 5)), "closed on Saturday")
+	})
+
+	t.Run("override closes the facility for the day", func(t *testing.T) {
+		tuesday := monday.AddDate(0, 0, 1)
+		res, err := svc.FacilitiesOpeningHoursOverridesUpdate(staffCtx, &api.OpeningHoursOverrideInput{
+			Reason: api.NewOptString("Maintenance"),
+		}, api.FacilitiesOpeningHoursOverridesUpdateParams{ID: facility.ID, Date: tuesday})
+		require.NoError(t, err)
+		override, ok := res.(*api.OpeningHoursOverride)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.False(t, override.OpensAt.Set)
+
+		assert.Empty(t, availability(t, tuesday))
+		createRes, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   tuesday.Add(10 * time.Hour),
+			EndsAt:     tuesday.Add(11 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, createRes)
+
+		destroyRes, err := svc.FacilitiesOpeningHoursOverridesDestroy(staffCtx,
+			api.FacilitiesOpeningHoursOverridesDestroyParams{ID: facility.ID, Date: tuesday})
+		require.NoError(t, err)
+		require.IsType(t, &api.FacilitiesOpeningHoursOverridesDestroyNoContent{}, destroyRes)
+		assert.Len(t, availability(t, tuesday), 1)
+	})
+
+	t.Run("override opens the facility until the end of the day", func(t *testing.T) {
+		saturday := monday.AddDate(0, 0, 5)
+		res, err := svc.FacilitiesOpeningHoursOverridesUpdate(staffCtx, &api.OpeningHoursOverrideInput{
+			OpensAt:  api.NewOptTime(clock(13, 0)),
+			ClosesAt: api.NewOptTime(clock(0, 0)),
+		}, api.FacilitiesOpeningHoursOverridesUpdateParams{ID: facility.ID, Date: saturday})
+		require.NoError(t, err)
+		require.IsType(t, &api.OpeningHoursOverride{}, res)
+
+		slots := availability(t, saturday)
+		require.Len(t, slots, 1)
+		assert.True(t, saturday.Add(13*time.Hour).Equal(slots[0].StartsAt))
+		assert.True(t, saturday.AddDate(0, 0, 1).Equal(slots[0].EndsAt))
+
+		listRes, err := svc.FacilitiesOpeningHoursOverridesList(ctx, api.FacilitiesOpeningHoursOverridesListParams{
+			ID:   facility.ID,
+			From: api.NewOptDate(monday),
+			To:   api.NewOptDate(monday.AddDate(0, 0, 6)),
+		})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.FacilitiesOpeningHoursOverridesListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		require.Len(t, *list, 1)
+		assert.Equal(t, api.NewOptTime(clock(0, 0)), (*list)[0].ClosesAt)
+	})
+
+	t.Run("series skips occurrences outside opening hours", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCreate(userCtx, &api.ReservationSeriesInput{
+			FacilityID: facility.ID,
+			Title:      "Daily standup",
+			StartsAt:   monday.AddDate(0, 0, 7).Add(9 * time.Hour),
+			EndsAt:     monday.AddDate(0, 0, 7).Add(9*time.Hour + 15*time.Minute),
+			Rrule:      "FREQ=DAILY;COUNT=7",
+			TimeZone:   api.NewOptString("UTC"),
+		}, api.ReservationSeriesCreateParams{ConflictMode: api.NewOptConflictMode(api.ConflictModeSkip)})
+		require.NoError(t, err)
+		series, ok := res.(*api.ReservationSeriesWithSkipped)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, series.Occurrences, 5)
+		assert.Len(t, series.Skipped, 2, "weekend occurrences are skipped")
+	})
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+const defaultFacilitySearchLimit int32 = 20
+
+// FacilitiesSearchList returns active facilities whose name, location or description contains any word of the query,
+// or whose name is similar to it, most relevant first. The fields matching the query are returned highlighted.
+func (s *APIService) FacilitiesSearchList(
+	ctx context.Context,
+	params api.FacilitiesSearchListParams,
+) (res []api.FacilitySearchResult, err error) {
+	defer derrors.Wrap(&err, "FacilitiesSearchList(ctx, params)")
+
+	matches, err := s.ds.SearchFacilities(ctx, db.SearchFacilitiesParams{
+		Query:       params.Q,
+		ResultLimit: params.Limit.Or(defaultFacilitySearchLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search facilities: %w", err)
+	}
+
+	facilityIDs := make([]int32, 0, len(matches))
+	for _, m := range matches {
+		facilityIDs = append(facilityIDs, m.ID)
+	}
+	rows, err := s.ds.ListFacilitiesByIDs(ctx, facilityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facilities: %w", err)
+	}
+	facilities := make(map[int32]db.Facility, len(rows))
+	for _, f := range rows {
+		facilities[f.ID] = f
+	}
+	amenities, err := facilityAmenities(ctx, s.ds, facilityIDs)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := facilityTimeZones(ctx, s.ds, facilityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res = make([]api.FacilitySearchResult, 0, len(matches))
+	for _, m := range matches {
+		f, ok := facilities[m.ID]
+		if !ok {
+			// The facility was deleted after it was searched.
+			continue
+		}
+		res = append(res, toFacilitySearchResult(m, f, amenities[f.ID], zones[f.ID]))
+	}
+	return res, nil
+}
+
+// toFacilitySearchResult converts a search match and its facility into its API representation.
+// Headlines are only returned for the fields the facility sets.
+func toFacilitySearchResult(
+	m db.SearchFacilitiesRow,
+	f db.Facility,
+	amenities []string,
+	loc *time.Location,
+) api.FacilitySearchResult {
+	highlights := api.FacilitySearchHighlights{
+		Name:        m.NameHeadline,
+		Location:    api.OptString{},
+		Description: api.OptString{},
+	}
+	if f.Location != nil {
+		highlights.Location = api.NewOptString(m.LocationHeadline)
+	}
+	if f.Description != nil {
+		highlights.Description = api.NewOptString(m.DescriptionHeadline)
+	}
+
+	return api.FacilitySearchResult{
+		Facility:   toPublicFacility(f, amenities, loc),
+		Rank:       m.Rank,
+		Highlights: highlights,
+	}
+}
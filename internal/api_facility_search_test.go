@@ -0,0 +1,83 @@
+package internal_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestFacilitiesSearch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	newFacility := func(t *testing.T, req *api.PublicFacility) *api.PublicFacility {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, req)
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		return facility
+	}
+	// The database is shared between runs, so names get a random word.
+	word := fmt.Sprintf("Quasar%d", gofakeit.Number(1, math.MaxInt32))
+	hall := newFacility(t, &api.PublicFacility{
+		Name:        word + " Hall",
+		Location:    api.NewOptString("3F"),
+		Description: api.NewOptString("Big room with a projector"),
+	})
+	lounge := newFacility(t, &api.PublicFacility{Name: word + " Lounge"})
+	closed := newFacility(t, &api.PublicFacility{Name: word + " Annex", IsActive: api.NewOptBool(false)})
+
+	search := func(t *testing.T, q string) []api.FacilitySearchResult {
+		t.Helper()
+		res, err := svc.FacilitiesSearchList(ctx, api.FacilitiesSearchListParams{Q: q, Limit: api.NewOptInt32(50)})
+		require.NoError(t, err)
+		return res
+	}
+	resultIDs := func(results []api.FacilitySearchResult) []int {
+		ids := make([]int, 0, len(results))
+		for _, r := range results {
+			ids = append(ids, r.Facility.ID)
+		}
+		return ids
+	}
+
+	t.Run("search ranks facilities matching more words first", func(t *testing.T) {
+		results := search(t, word+" big room projector")
+		require.NotEmpty(t, results)
+		assert.Equal(t, hall.ID, results[0].Facility.ID)
+		ids := resultIDs(results)
+		assert.Contains(t, ids, lounge.ID)
+		assert.NotContains(t, ids, closed.ID)
+	})
+
+	t.Run("search highlights the matched words", func(t *testing.T) {
+		results := search(t, word+" projector 3F")
+		require.NotEmpty(t, results)
+		highlights := results[0].Highlights
+		assert.Equal(t, "<mark>"+word+"</mark> Hall", highlights.Name)
+		assert.Equal(t, api.NewOptString("<mark>3F</mark>"), highlights.Location)
+		assert.Contains(t, highlights.Description.Or(""), "<mark>projector</mark>")
+	})
+
+	t.Run("search tolerates typos in names", func(t *testing.T) {
+		results := search(t, word[:3]+word[4:]+" Lounge")
+		assert.Contains(t, resultIDs(results), lounge.ID)
+	})
+}
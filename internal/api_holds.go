@@ -0,0 +1,314 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// defaultHoldTTLSeconds is how long a hold blocks its period unless the request sets a TTL.
+const defaultHoldTTLSeconds int32 = 300
+
+var (
+	// errHoldNotFound is returned inside transactions when the hold is missing, not visible to the caller
+	// or already confirmed.
+	errHoldNotFound = errors.New("hold not found")
+	// errHoldExpired is returned inside transactions when the TTL of the hold has passed.
+	errHoldExpired = errors.New("hold has expired")
+)
+
+// HoldsCreate holds a period of a facility for the authenticated user while the reservation details are
+// filled out. The period is checked like a new reservation and counts against the booking quotas of the user.
+// It blocks other reservations until the hold is confirmed, dropped or expires.
+func (s *APIService) HoldsCreate(ctx context.Context, req *api.HoldInput) (res api.HoldsCreateRes, err error) {
+	defer derrors.Wrap(&err, "HoldsCreate(ctx, req)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.HoldsCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	if !req.StartsAt.Before(req.EndsAt) {
+		problem := newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+		return (*api.HoldsCreateBadRequest)(problem), nil
+	}
+
+	userID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.HoldsCreateBadRequest)(facilityUnavailableProblem()), nil
+	}
+
+	violations, err := evaluateBookingPolicy(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return (*api.HoldsCreateBadRequest)(bookingPolicyViolationProblem(violations)), nil
+	}
+
+	isOpen, err := withinOpeningHours(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if !isOpen {
+		return (*api.HoldsCreateBadRequest)(outsideOpeningHoursProblem()), nil
+	}
+	blackout, found, err := findBlackout(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return (*api.HoldsCreateConflict)(blackoutConflictProblem(blackout)), nil
+	}
+
+	var hold db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		err := checkReservationLocked(ctx, tx, userID, facility.ID, req.StartsAt, req.EndsAt, nil)
+		if err != nil {
+			return err
+		}
+
+		ttl := time.Duration(req.TTLSeconds.Or(defaultHoldTTLSeconds)) * time.Second
+		blockedStartsAt, blockedEndsAt := blockedPeriod(facility, req.StartsAt, req.EndsAt)
+		hold, err = tx.CreateHold(ctx, db.CreateHoldParams{
+			ID:              uuid.Must(uuid.NewV7()),
+			FacilityID:      facility.ID,
+			UserID:          userID,
+			StartsAt:        req.StartsAt,
+			EndsAt:          req.EndsAt,
+			BlockedStartsAt: blockedStartsAt,
+			BlockedEndsAt:   blockedEndsAt,
+			ExpiresAt:       time.Now().Add(ttl),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create hold: %w", err)
+		}
+		return nil
+	})
+	var (
+		quotaErr    *quotaExceededError
+		blackoutErr *blackoutConflictError
+	)
+	switch {
+	case errors.As(err, &quotaErr):
+		return (*api.HoldsCreateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.As(err, &blackoutErr):
+		return (*api.HoldsCreateConflict)(blackoutConflictProblem(blackoutErr.period)), nil
+	case isExclusionViolation(err):
+		return (*api.HoldsCreateConflict)(reservationConflictProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, hold.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	created := toHold(hold, loc)
+	return &created, nil
+}
+
+// HoldsRetrieve returns a hold that has not expired. Only its owner and staff users are allowed.
+func (s *APIService) HoldsRetrieve(
+	ctx context.Context,
+	params api.HoldsRetrieveParams,
+) (res api.HoldsRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "HoldsRetrieve(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.HoldsRetrieveUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	hold, err := s.ds.GetReservationByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.HoldsRetrieveNotFound)(holdNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+	if !canAccessReservation(caller, hold) || !isLiveHold(hold, time.Now()) {
+		return (*api.HoldsRetrieveNotFound)(holdNotFoundProblem()), nil
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, hold.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toHold(hold, loc)
+	return &found, nil
+}
+
+// HoldsDestroy drops a hold, releasing its period for other reservations.
+// Only its owner and staff users are allowed.
+func (s *APIService) HoldsDestroy(
+	ctx context.Context,
+	params api.HoldsDestroyParams,
+) (res api.HoldsDestroyRes, err error) {
+	defer derrors.Wrap(&err, "HoldsDestroy(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.HoldsDestroyUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		if _, err := lockHold(ctx, tx, caller, params.ID); err != nil {
+			return err
+		}
+		if err := tx.DeleteReservation(ctx, params.ID); err != nil {
+			return fmt.Errorf("failed to delete hold: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, errHoldNotFound) {
+		return (*api.HoldsDestroyNotFound)(holdNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return &api.HoldsDestroyNoContent{}, nil
+}
+
+// HoldsConfirm turns a hold into a reservation with the given details. The period was checked when the hold
+// was placed and has been blocked since. Holds of facilities requiring approval become pending requests
+// unless confirmed by a staff user. Only its owner and staff users are allowed.
+func (s *APIService) HoldsConfirm(
+	ctx context.Context,
+	req *api.HoldConfirmation,
+	params api.HoldsConfirmParams,
+) (res api.HoldsConfirmRes, err error) {
+	defer derrors.Wrap(&err, "HoldsConfirm(ctx, req, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.HoldsConfirmUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var reservation db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		hold, err := lockHold(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		if !isLiveHold(hold, time.Now()) {
+			return errHoldExpired
+		}
+
+		facility, err := tx.GetFacilityByID(ctx, hold.FacilityID)
+		if err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+
+		startsAt, endsAt := hold.Period.Lower.Time, hold.Period.Upper.Time
+		reservation, err = tx.ConfirmHold(ctx, db.ConfirmHoldParams{
+			Title:       req.Title,
+			Description: ptrOf(req.Description),
+			Status:      reservationStatus(caller, facility, nil, startsAt, endsAt),
+			ID:          hold.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to confirm hold: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errHoldNotFound):
+		return (*api.HoldsConfirmNotFound)(holdNotFoundProblem()), nil
+	case errors.Is(err, errHoldExpired):
+		return (*api.HoldsConfirmConflict)(holdExpiredProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed := toReservation(reservation, loc)
+	return &confirmed, nil
+}
+
+// lockHold locks a hold visible to the caller for modification and returns it.
+// It returns errHoldNotFound when there is no such hold, including holds that were already confirmed.
+func lockHold(ctx context.Context, tx *Transaction, caller *AuthenticatedUser, id uuid.UUID) (db.Reservation, error) {
+	hold, err := tx.GetReservationByIDForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Reservation{}, errHoldNotFound
+	}
+	if err != nil {
+		return db.Reservation{}, fmt.Errorf("failed to get hold: %w", err)
+	}
+	if !canAccessReservation(caller, hold) || hold.Status != db.ReservationStatusHeld {
+		return db.Reservation{}, errHoldNotFound
+	}
+	return hold, nil
+}
+
+// isLiveHold reports whether r is a hold whose TTL has not passed at now.
+// Expired holds keep blocking their period until the sweeper or deleteExpiredHolds removes them.
+func isLiveHold(r db.Reservation, now time.Time) bool {
+	return r.Status == db.ReservationStatusHeld && r.HoldExpiresAt != nil && r.HoldExpiresAt.After(now)
+}
+
+// deleteExpiredHolds deletes the holds of the facility expired by now, so that they do not reject reservations
+// written afterwards in the same transaction before the sweeper removes them.
+func deleteExpiredHolds(ctx context.Context, tx *Transaction, facilityID int32) error {
+	err := tx.DeleteExpiredFacilityHolds(ctx, db.DeleteExpiredFacilityHoldsParams{
+		FacilityID: facilityID,
+		Now:        time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete expired holds: %w", err)
+	}
+	return nil
+}
+
+// toHold converts a held reservation into its API representation with its period both in UTC and in loc,
+// the time zone of its facility.
+func toHold(r db.Reservation, loc *time.Location) api.Hold {
+	hold := api.Hold{
+		ID:            r.ID,
+		UserID:        r.UserID,
+		FacilityID:    int(r.FacilityID),
+		StartsAt:      r.Period.Lower.Time.UTC(),
+		EndsAt:        r.Period.Upper.Time.UTC(),
+		TimeZone:      loc.String(),
+		LocalStartsAt: r.Period.Lower.Time.In(loc),
+		LocalEndsAt:   r.Period.Upper.Time.In(loc),
+		ExpiresAt:     time.Time{},
+		CreatedAt:     r.CreatedAt,
+	}
+	if r.HoldExpiresAt != nil {
+		hold.ExpiresAt = *r.HoldExpiresAt
+	}
+	return hold
+}
+
+func holdNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Hold not found.")
+}
+
+func holdExpiredProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The hold has expired. Place a new hold to reserve the period.")
+}
@@ -0,0 +1,184 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestHoldsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	startsAt := time.Now().Add(time.Hour)
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.HoldsCreate(t.Context(), &api.HoldInput{
+			FacilityID: 1,
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsCreateUnauthorized{}, res)
+	})
+
+	t.Run("create rejects reversed periods", func(t *testing.T) {
+		res, err := svc.HoldsCreate(userCtx, &api.HoldInput{
+			FacilityID: 1,
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsCreateBadRequest{}, res)
+	})
+
+	t.Run("confirm rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.HoldsConfirm(t.Context(), &api.HoldConfirmation{Title: "Meeting"},
+			api.HoldsConfirmParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsConfirmUnauthorized{}, res)
+	})
+
+	t.Run("destroy rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.HoldsDestroy(t.Context(), api.HoldsDestroyParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsDestroyUnauthorized{}, res)
+	})
+}
+
+func TestHolds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUserCtx := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	ownerCtx := internal.WithAuthenticatedUser(ctx, newUserCtx(t))
+	otherCtx := internal.WithAuthenticatedUser(ctx, newUserCtx(t))
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	hold := func(t *testing.T, offset time.Duration) *api.Hold {
+		t.Helper()
+		res, err := svc.HoldsCreate(ownerCtx, &api.HoldInput{
+			FacilityID: facility.ID,
+			StartsAt:   startsAt.Add(offset),
+			EndsAt:     startsAt.Add(offset + time.Hour),
+		})
+		require.NoError(t, err)
+		created, ok := res.(*api.Hold)
+		require.True(t, ok, "unexpected response %T", res)
+		return created
+	}
+
+	t.Run("holds block their period", func(t *testing.T) {
+		held := hold(t, 0)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), held.ExpiresAt, time.Minute)
+
+		res, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+
+		retrieveRes, err := svc.HoldsRetrieve(otherCtx, api.HoldsRetrieveParams{ID: held.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsRetrieveNotFound{}, retrieveRes)
+	})
+
+	t.Run("confirming a hold creates a reservation", func(t *testing.T) {
+		held := hold(t, 2*time.Hour)
+
+		res, err := svc.HoldsConfirm(ownerCtx, &api.HoldConfirmation{Title: "Workshop"},
+			api.HoldsConfirmParams{ID: held.ID})
+		require.NoError(t, err)
+		reservation, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, held.ID, reservation.ID)
+		assert.Equal(t, api.ReservationStatusConfirmed, reservation.Status)
+		assert.Equal(t, "Workshop", reservation.Title)
+
+		res, err = svc.HoldsConfirm(ownerCtx, &api.HoldConfirmation{Title: "Workshop"},
+			api.HoldsConfirmParams{ID: held.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsConfirmNotFound{}, res)
+	})
+
+	t.Run("dropping a hold releases the period", func(t *testing.T) {
+		held := hold(t, 4*time.Hour)
+
+		res, err := svc.HoldsDestroy(ownerCtx, api.HoldsDestroyParams{ID: held.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.HoldsDestroyNoContent{}, res)
+
+		createRes, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   held.StartsAt,
+			EndsAt:     held.EndsAt,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, createRes)
+	})
+
+	t.Run("the sweeper removes expired holds", func(t *testing.T) {
+		held := hold(t, 6*time.Hour)
+
+		err := internal.NewSweeper(ds, time.Minute).Sweep(ctx, held.ExpiresAt.Add(time.Second))
+		require.NoError(t, err)
+
+		res, err := svc.HoldsRetrieve(ownerCtx, api.HoldsRetrieveParams{ID: held.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.HoldsRetrieveNotFound{}, res)
+
+		createRes, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   held.StartsAt,
+			EndsAt:     held.EndsAt,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, createRes)
+	})
+}
@@ -0,0 +1,315 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// LocationsList returns all locations ordered by name.
+func (s *APIService) LocationsList(ctx context.Context) (res []api.Location, err error) {
+	defer derrors.Wrap(&err, "LocationsList(ctx)")
+
+	locations, err := s.ds.ListLocations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+
+	res = make([]api.Location, 0, len(locations))
+	for _, l := range locations {
+		res = append(res, toLocation(l))
+	}
+	return res, nil
+}
+
+// LocationsCreate creates a site, building or floor. Only staff users are allowed.
+func (s *APIService) LocationsCreate(ctx context.Context, req *api.Location) (res api.LocationsCreateRes, err error) {
+	defer derrors.Wrap(&err, "LocationsCreate(ctx, req)")
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.LocationsCreateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.LocationsCreateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem, found := timeZoneProblem(ptrOf(req.TimeZone)); found {
+		return (*api.LocationsCreateBadRequest)(problem), nil
+	}
+
+	parentID := ptrOf(req.ParentID)
+	problem, found, err := locationParentProblem(ctx, s.ds, db.LocationKind(req.Kind), parentID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return (*api.LocationsCreateBadRequest)(problem), nil
+	}
+
+	location, err := s.ds.CreateLocation(ctx, db.CreateLocationParams{
+		ID:       uuid.Must(uuid.NewV7()),
+		ParentID: parentID,
+		Kind:     db.LocationKind(req.Kind),
+		Name:     req.Name,
+		TimeZone: ptrOf(req.TimeZone),
+	})
+	switch {
+	case isUniqueViolation(err):
+		return (*api.LocationsCreateConflict)(locationNameTakenProblem()), nil
+	case isForeignKeyViolation(err):
+		// The parent was deleted after it was checked.
+		return (*api.LocationsCreateBadRequest)(unknownParentLocationProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to create location: %w", err)
+	}
+
+	created := toLocation(location)
+	return &created, nil
+}
+
+// LocationsRetrieve returns a single location.
+func (s *APIService) LocationsRetrieve(
+	ctx context.Context,
+	params api.LocationsRetrieveParams,
+) (res api.LocationsRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "LocationsRetrieve(ctx, %s)", params.ID)
+
+	location, err := s.ds.GetLocationByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return locationNotFoundProblem(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location: %w", err)
+	}
+
+	found := toLocation(location)
+	return &found, nil
+}
+
+// LocationsUpdate renames a location, changes its time zone or moves it to another parent of the same kind.
+// Only staff users are allowed.
+// The kind of a location cannot be changed, so moving a location never creates a cycle.
+func (s *APIService) LocationsUpdate(
+	ctx context.Context,
+	req *api.Location,
+	params api.LocationsUpdateParams,
+) (res api.LocationsUpdateRes, err error) {
+	defer derrors.Wrap(&err, "LocationsUpdate(ctx, req, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.LocationsUpdateUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.LocationsUpdateForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	if problem, found := timeZoneProblem(ptrOf(req.TimeZone)); found {
+		return (*api.LocationsUpdateBadRequest)(problem), nil
+	}
+
+	current, err := s.ds.GetLocationByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.LocationsUpdateNotFound)(locationNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location: %w", err)
+	}
+	if db.LocationKind(req.Kind) != current.Kind {
+		problem := newProblem(http.StatusBadRequest, "kind of a location cannot be changed.")
+		return (*api.LocationsUpdateBadRequest)(problem), nil
+	}
+
+	parentID := ptrOf(req.ParentID)
+	problem, found, err := locationParentProblem(ctx, s.ds, current.Kind, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return (*api.LocationsUpdateBadRequest)(problem), nil
+	}
+
+	location, err := s.ds.UpdateLocation(ctx, db.UpdateLocationParams{
+		ID:       current.ID,
+		ParentID: parentID,
+		Name:     req.Name,
+		TimeZone: ptrOf(req.TimeZone),
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return (*api.LocationsUpdateNotFound)(locationNotFoundProblem()), nil
+	case isUniqueViolation(err):
+		return (*api.LocationsUpdateConflict)(locationNameTakenProblem()), nil
+	case isForeignKeyViolation(err):
+		return (*api.LocationsUpdateBadRequest)(unknownParentLocationProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to update location: %w", err)
+	}
+
+	updated := toLocation(location)
+	return &updated, nil
+}
+
+// LocationsDestroy deletes a location that contains neither other locations nor facilities.
+// Only staff users are allowed.
+func (s *APIService) LocationsDestroy(
+	ctx context.Context,
+	params api.LocationsDestroyParams,
+) (res api.LocationsDestroyRes, err error) {
+	defer derrors.Wrap(&err, "LocationsDestroy(ctx, %s)", params.ID)
+
+	switch checkStaffAccess(ctx) {
+	case staffAccessUnauthenticated:
+		return (*api.LocationsDestroyUnauthorized)(unauthenticatedProblem()), nil
+	case staffAccessForbidden:
+		return (*api.LocationsDestroyForbidden)(forbiddenProblem()), nil
+	case staffAccessGranted:
+	}
+
+	deleted, err := s.ds.DeleteLocation(ctx, params.ID)
+	if isForeignKeyViolation(err) {
+		problem := newProblem(http.StatusConflict,
+			"Locations containing other locations or facilities cannot be deleted.")
+		return (*api.LocationsDestroyConflict)(problem), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete location: %w", err)
+	}
+	if deleted == 0 {
+		return (*api.LocationsDestroyNotFound)(locationNotFoundProblem()), nil
+	}
+
+	return &api.LocationsDestroyNoContent{}, nil
+}
+
+// LocationsTreeList returns the sites ordered by name, each with the locations and active facilities it contains.
+func (s *APIService) LocationsTreeList(ctx context.Context) (res []api.LocationTreeNode, err error) {
+	defer derrors.Wrap(&err, "LocationsTreeList(ctx)")
+
+	locations, err := s.ds.ListLocations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+	facilities, err := s.ds.ListFacilities(ctx, db.ListFacilitiesParams{Amenities: nil, LocationID: nil})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facilities: %w", err)
+	}
+
+	// Locations and facilities are listed in display order, so grouping them keeps that order.
+	children := make(map[uuid.UUID][]db.Location, len(locations))
+	var sites []db.Location
+	for _, l := range locations {
+		if l.ParentID == nil {
+			sites = append(sites, l)
+			continue
+		}
+		children[*l.ParentID] = append(children[*l.ParentID], l)
+	}
+	facilityIDs := make(map[uuid.UUID][]int, len(locations))
+	for _, f := range facilities {
+		if f.LocationID != nil {
+			facilityIDs[*f.LocationID] = append(facilityIDs[*f.LocationID], int(f.ID))
+		}
+	}
+
+	return toLocationTreeNodes(sites, children, facilityIDs), nil
+}
+
+// locationParentProblem checks that a location of the given kind may be placed in the parent identified by parentID:
+// sites have no parent, the parent of a building is a site and the parent of a floor is a building.
+// It reports false when the parent is valid.
+func locationParentProblem(
+	ctx context.Context,
+	q db.Querier,
+	kind db.LocationKind,
+	parentID *uuid.UUID,
+) (*api.ProblemDetails, bool, error) {
+	var parentKind db.LocationKind
+	switch kind {
+	case db.LocationKindSite:
+		if parentID != nil {
+			return newProblem(http.StatusBadRequest, "A site cannot have parent_id."), true, nil
+		}
+		return nil, false, nil
+	case db.LocationKindBuilding:
+		parentKind = db.LocationKindSite
+	case db.LocationKindFloor:
+		parentKind = db.LocationKindBuilding
+	}
+
+	if parentID == nil {
+		return newProblem(http.StatusBadRequest, fmt.Sprintf("A %s must have parent_id.", kind)), true, nil
+	}
+	parent, err := q.GetLocationByID(ctx, *parentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return unknownParentLocationProblem(), true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get parent location: %w", err)
+	}
+	if parent.Kind != parentKind {
+		detail := fmt.Sprintf("The parent of a %s must be a %s.", kind, parentKind)
+		return newProblem(http.StatusBadRequest, detail), true, nil
+	}
+	return nil, false, nil
+}
+
+// toLocationTreeNodes converts locations into tree nodes holding the locations and facilities they contain.
+func toLocationTreeNodes(
+	locations []db.Location,
+	children map[uuid.UUID][]db.Location,
+	facilityIDs map[uuid.UUID][]int,
+) []api.LocationTreeNode {
+	nodes := make([]api.LocationTreeNode, 0, len(locations))
+	for _, l := range locations {
+		ids := facilityIDs[l.ID]
+		if ids == nil {
+			ids = []int{}
+		}
+		nodes = append(nodes, api.LocationTreeNode{
+			ID:          l.ID,
+			Kind:        api.LocationKind(l.Kind),
+			Name:        l.Name,
+			Children:    toLocationTreeNodes(children[l.ID], children, facilityIDs),
+			FacilityIds: ids,
+		})
+	}
+	return nodes
+}
+
+// toLocation converts a database location into its API representation.
+func toLocation(l db.Location) api.Location {
+	return api.Location{
+		ID:        l.ID,
+		Kind:      api.LocationKind(l.Kind),
+		Name:      l.Name,
+		ParentID:  optUUID(l.ParentID),
+		TimeZone:  optString(l.TimeZone),
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+func locationNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Location not found.")
+}
+
+func locationNameTakenProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "A location with that name already exists in its parent.")
+}
+
+func unknownParentLocationProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "parent_id does not identify a location.")
+}
+
+func unknownLocationProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "location_id does not identify a location.")
+}
@@ -0,0 +1,215 @@
+package internal_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestLocationsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	regularCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       "regular-user-id",
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	staffCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.LocationsCreate(t.Context(), &api.Location{Kind: api.LocationKindSite, Name: "Campus"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateUnauthorized{}, res)
+	})
+
+	t.Run("create rejects non-staff users", func(t *testing.T) {
+		res, err := svc.LocationsCreate(regularCtx, &api.Location{Kind: api.LocationKindSite, Name: "Campus"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateForbidden{}, res)
+	})
+
+	t.Run("create rejects a site with a parent", func(t *testing.T) {
+		res, err := svc.LocationsCreate(staffCtx, &api.Location{
+			Kind:     api.LocationKindSite,
+			Name:     "Campus",
+			ParentID: api.NewOptUUID(id),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateBadRequest{}, res)
+	})
+
+	t.Run("create rejects a floor without a parent", func(t *testing.T) {
+		res, err := svc.LocationsCreate(staffCtx, &api.Location{Kind: api.LocationKindFloor, Name: "3F"})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateBadRequest{}, res)
+	})
+
+	t.Run("update rejects non-staff users", func(t *testing.T) {
+		res, err := svc.LocationsUpdate(regularCtx, &api.Location{Kind: api.LocationKindSite, Name: "Campus"},
+			api.LocationsUpdateParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsUpdateForbidden{}, res)
+	})
+
+	t.Run("destroy rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.LocationsDestroy(t.Context(), api.LocationsDestroyParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsDestroyUnauthorized{}, res)
+	})
+}
+
+func TestLocations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	svc := internal.NewAPIService(internal.NewDataStore(setupTestDatabase(ctx, t)))
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	newLocation := func(t *testing.T, kind api.LocationKind, name string, parent *api.Location) *api.Location {
+		t.Helper()
+		req := &api.Location{Kind: kind, Name: name}
+		if parent != nil {
+			req.ParentID = api.NewOptUUID(parent.ID)
+		}
+		res, err := svc.LocationsCreate(staffCtx, req)
+		require.NoError(t, err)
+		location, ok := res.(*api.Location)
+		require.True(t, ok, "unexpected response %T", res)
+		return location
+	}
+	// The database is shared between runs, so sites get a random suffix.
+	site := newLocation(t, api.LocationKindSite, fmt.Sprintf("Campus %d", gofakeit.Number(1, math.MaxInt32)), nil)
+	buildingA := newLocation(t, api.LocationKindBuilding, "Building A", site)
+	buildingB := newLocation(t, api.LocationKindBuilding, "Building B", site)
+	floor3 := newLocation(t, api.LocationKindFloor, "3F", buildingB)
+
+	newFacility := func(t *testing.T, location *api.Location) *api.PublicFacility {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:       gofakeit.Company(),
+			LocationID: api.NewOptUUID(location.ID),
+		})
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		return facility
+	}
+	inBuildingA := newFacility(t, buildingA)
+	onFloor3 := newFacility(t, floor3)
+
+	t.Run("create rejects a floor within a site", func(t *testing.T) {
+		res, err := svc.LocationsCreate(staffCtx, &api.Location{
+			Kind:     api.LocationKindFloor,
+			Name:     "1F",
+			ParentID: api.NewOptUUID(site.ID),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateBadRequest{}, res)
+	})
+
+	t.Run("create rejects a name taken within the parent", func(t *testing.T) {
+		res, err := svc.LocationsCreate(staffCtx, &api.Location{
+			Kind:     api.LocationKindBuilding,
+			Name:     buildingA.Name,
+			ParentID: api.NewOptUUID(site.ID),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateConflict{}, res)
+	})
+
+	t.Run("list filters facilities by any ancestor", func(t *testing.T) {
+		ids := listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{LocationID: api.NewOptUUID(site.ID)})
+		assert.ElementsMatch(t, []int{inBuildingA.ID, onFloor3.ID}, ids)
+
+		ids = listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{LocationID: api.NewOptUUID(buildingB.ID)})
+		assert.Equal(t, []int{onFloor3.ID}, ids)
+	})
+
+	t.Run("availability filters facilities by any ancestor", func(t *testing.T) {
+		from := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:       from,
+			To:         from.Add(time.Hour),
+			LocationID: api.NewOptUUID(buildingB.ID),
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, onFloor3.ID, (*list)[0].FacilityID)
+	})
+
+	t.Run("tree nests locations and facilities", func(t *testing.T) {
+		tree, err := svc.LocationsTreeList(ctx)
+		require.NoError(t, err)
+
+		var found *api.LocationTreeNode
+		for i := range tree {
+			if tree[i].ID == site.ID {
+				found = &tree[i]
+			}
+		}
+		require.NotNil(t, found)
+		require.Len(t, found.Children, 2)
+		assert.Equal(t, buildingA.ID, found.Children[0].ID)
+		assert.Equal(t, []int{inBuildingA.ID}, found.Children[0].FacilityIds)
+		require.Len(t, found.Children[1].Children, 1)
+		assert.Equal(t, []int{onFloor3.ID}, found.Children[1].Children[0].FacilityIds)
+	})
+
+	t.Run("update rejects a change of kind", func(t *testing.T) {
+		res, err := svc.LocationsUpdate(staffCtx, &api.Location{
+			Kind:     api.LocationKindFloor,
+			Name:     buildingA.Name,
+			ParentID: api.NewOptUUID(buildingB.ID),
+		}, api.LocationsUpdateParams{ID: buildingA.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsUpdateBadRequest{}, res)
+	})
+
+	t.Run("update moves a floor to another building", func(t *testing.T) {
+		res, err := svc.LocationsUpdate(staffCtx, &api.Location{
+			Kind:     api.LocationKindFloor,
+			Name:     floor3.Name,
+			ParentID: api.NewOptUUID(buildingA.ID),
+		}, api.LocationsUpdateParams{ID: floor3.ID})
+		require.NoError(t, err)
+		moved, ok := res.(*api.Location)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptUUID(buildingA.ID), moved.ParentID)
+
+		ids := listFacilityIDs(ctx, t, svc, api.FacilitiesListParams{LocationID: api.NewOptUUID(buildingB.ID)})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("destroy rejects locations still in use", func(t *testing.T) {
+		res, err := svc.LocationsDestroy(staffCtx, api.LocationsDestroyParams{ID: site.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsDestroyConflict{}, res)
+
+		res, err = svc.LocationsDestroy(staffCtx, api.LocationsDestroyParams{ID: buildingB.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsDestroyNoContent{}, res)
+	})
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// MeRetrieve returns the profile of the authenticated user together with metadata of its tokens.
+func (s *APIService) MeRetrieve(ctx context.Context) (res api.MeRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "MeRetrieve(ctx)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return unauthenticatedProblem(), nil
+	}
+
+	userID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+
+	user, err := s.ds.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The user was deleted after the token had been resolved.
+		return unauthenticatedProblem(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	tokens, err := s.ds.ListUserTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user tokens: %w", err)
+	}
+
+	current := toCurrentUser(user, tokens)
+	return &current, nil
+}
+
+// toCurrentUser converts a database user and its tokens into the profile representation.
+// Token secrets are intentionally dropped.
+func toCurrentUser(u db.User, tokens []db.UserToken) api.CurrentUser {
+	metadata := make([]api.TokenMetadata, 0, len(tokens))
+	for _, t := range tokens {
+		metadata = append(metadata, api.TokenMetadata{
+			ID:        t.ID,
+			Name:      t.Name,
+			CreatedAt: t.CreatedAt,
+			ExpiresAt: optDateTime(t.ExpiresAt),
+		})
+	}
+
+	return api.CurrentUser{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     optString(u.Email),
+		IsStaff:   u.IsStaff,
+		CreatedAt: u.CreatedAt,
+		Tokens:    metadata,
+	}
+}
@@ -0,0 +1,72 @@
+package internal_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestMeRetrieve(t *testing.T) {
+	t.Run("rejects anonymous requests", func(t *testing.T) {
+		svc := internal.NewAPIService(nil)
+
+		res, err := svc.MeRetrieve(t.Context())
+		require.NoError(t, err)
+
+		problem, ok := res.(*api.ProblemDetails)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptInt(http.StatusUnauthorized), problem.Status)
+	})
+
+	t.Run("returns profile and token metadata", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("Skipping integration test in short mode")
+		}
+
+		ctx := t.Context()
+		ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+		svc := internal.NewAPIService(ds)
+
+		staffUser := &internal.AuthenticatedUser{
+			ID:       "staff-user-id",
+			Username: "staff-user",
+			IsStaff:  true,
+		}
+		email := "me@example.com"
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  true,
+			Email:    &email,
+		})
+		require.NoError(t, err)
+
+		userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		})
+
+		res, err := svc.MeRetrieve(userCtx)
+		require.NoError(t, err)
+		me, ok := res.(*api.CurrentUser)
+		require.True(t, ok, "unexpected response %T", res)
+
+		assert.Equal(t, created.User.ID, me.ID)
+		assert.Equal(t, api.NewOptString(email), me.Email)
+		assert.True(t, me.IsStaff)
+		assert.False(t, me.CreatedAt.IsZero())
+		require.Len(t, me.Tokens, 1)
+		assert.Equal(t, created.Token.ID, me.Tokens[0].ID)
+		assert.Equal(t, "Default Token", me.Tokens[0].Name)
+		assert.False(t, me.Tokens[0].ExpiresAt.IsSet())
+
+		body, err := me.MarshalJSON()
+		require.NoError(t, err)
+		assert.NotContains(t, string(body), created.Token.Token)
+	})
+}
@@ -0,0 +1,577 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+var (
+	// errReservationBundleNotFound is returned inside transactions when the bundle is missing
+	// or not visible to the caller.
+	errReservationBundleNotFound = errors.New("reservation bundle not found")
+	// errReservationBundleCancelled is returned inside transactions when the bundle was already cancelled.
+	errReservationBundleCancelled = errors.New("reservation bundle is cancelled")
+	// errBundleNotReschedulable is returned inside transactions when a bundle without confirmed reservations,
+	// or with reservations that have already started, is rescheduled.
+	errBundleNotReschedulable = errors.New("reservation bundle cannot be rescheduled")
+)
+
+// bundleComponentError is returned inside transactions when a component of a bundle cannot be reserved,
+// rolling back the whole bundle.
+type bundleComponentError struct {
+	index   int
+	problem *api.ProblemDetails
+}
+
+func (e *bundleComponentError) Error() string {
+	return fmt.Sprintf("bundle component %d: %s", e.index, e.problem.Detail.Or(""))
+}
+
+// componentProblem returns the problem of the component, identifying the component it applies to.
+func (e *bundleComponentError) componentProblem() *api.ProblemDetails {
+	problem := *e.problem
+	problem.Detail = api.NewOptString(fmt.Sprintf("Component %d: %s", e.index, e.problem.Detail.Or("")))
+	problem.Component = api.NewOptInt(e.index)
+	return &problem
+}
+
+// isConflict reports whether the component was rejected with 409 Conflict rather than 400 Bad Request.
+func (e *bundleComponentError) isConflict() bool {
+	return isConflictProblem(e.problem)
+}
+
+// ReservationBundlesList returns reservation bundles in the order they were made.
+// Staff users see all bundles, other users their own and those of the users who granted them delegation.
+func (s *APIService) ReservationBundlesList(ctx context.Context) (res api.ReservationBundlesListRes, err error) {
+	defer derrors.Wrap(&err, "ReservationBundlesList(ctx)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return unauthenticatedProblem(), nil
+	}
+
+	var userID *uuid.UUID
+	if !caller.IsStaff {
+		id, err := uuid.Parse(caller.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+		}
+		userID = &id
+	}
+
+	bundles, err := s.ds.ListReservationBundles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservation bundles: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(bundles))
+	for _, b := range bundles {
+		ids = append(ids, b.ID)
+	}
+	reservations, err := s.ds.ListReservationsByBundleIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle reservations: %w", err)
+	}
+
+	byBundle := make(map[uuid.UUID][]db.Reservation, len(bundles))
+	for _, r := range reservations {
+		if r.BundleID != nil {
+			byBundle[*r.BundleID] = append(byBundle[*r.BundleID], r)
+		}
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(api.ReservationBundlesListOKApplicationJSON, 0, len(bundles))
+	for _, b := range bundles {
+		list = append(list, toReservationBundle(b, byBundle[b.ID], zones))
+	}
+	return &list, nil
+}
+
+// ReservationBundlesCreate reserves several facilities for the authenticated user within a single transaction.
+// Every component is checked like a new reservation, and the first one that cannot be reserved rejects
+// the whole bundle with a problem identifying it. Facilities requiring approval cannot be reserved by bundle.
+func (s *APIService) ReservationBundlesCreate(
+	ctx context.Context,
+	req *api.ReservationBundleInput,
+) (res api.ReservationBundlesCreateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationBundlesCreate(ctx, req)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationBundlesCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	userID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+
+	var (
+		bundle       db.ReservationBundle
+		reservations []db.Reservation
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		var err error
+		bundle, err = tx.CreateReservationBundle(ctx, db.CreateReservationBundleParams{
+			ID:          uuid.Must(uuid.NewV7()),
+			UserID:      userID,
+			Title:       req.Title,
+			Description: ptrOf(req.Description),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create reservation bundle: %w", err)
+		}
+
+		for i, c := range req.Components {
+			if err := reserveBundleComponent(ctx, tx, bundle, i, c); err != nil {
+				return err
+			}
+		}
+
+		reservations, err = tx.ListReservationsByBundleIDs(ctx, []uuid.UUID{bundle.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list bundle reservations: %w", err)
+		}
+		return nil
+	})
+	var componentErr *bundleComponentError
+	switch {
+	case errors.As(err, &componentErr) && componentErr.isConflict():
+		return (*api.ReservationBundlesCreateConflict)(componentErr.componentProblem()), nil
+	case errors.As(err, &componentErr):
+		return (*api.ReservationBundlesCreateBadRequest)(componentErr.componentProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	created := toReservationBundle(bundle, reservations, zones)
+	return &created, nil
+}
+
+// ReservationBundlesRetrieve returns a single bundle with its reservations.
+// Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationBundlesRetrieve(
+	ctx context.Context,
+	params api.ReservationBundlesRetrieveParams,
+) (res api.ReservationBundlesRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "ReservationBundlesRetrieve(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationBundlesRetrieveUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	bundle, err := s.ds.GetReservationBundleByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.ReservationBundlesRetrieveNotFound)(reservationBundleNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation bundle: %w", err)
+	}
+	allowed, err := canActFor(ctx, s.ds, caller, bundle.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return (*api.ReservationBundlesRetrieveNotFound)(reservationBundleNotFoundProblem()), nil
+	}
+
+	reservations, err := s.ds.ListReservationsByBundleIDs(ctx, []uuid.UUID{bundle.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle reservations: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toReservationBundle(bundle, reservations, zones)
+	return &found, nil
+}
+
+// ReservationBundlesCancel cancels a confirmed bundle together with its confirmed reservations.
+// Waitlist entries fitting the released periods are promoted in the same transaction,
+// and their users are notified once it commits. Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationBundlesCancel(
+	ctx context.Context,
+	params api.ReservationBundlesCancelParams,
+) (res api.ReservationBundlesCancelRes, err error) {
+	defer derrors.Wrap(&err, "ReservationBundlesCancel(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationBundlesCancelUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var (
+		bundle       db.ReservationBundle
+		reservations []db.Reservation
+		promoted     []db.WaitlistEntry
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		_, err := lockConfirmedReservationBundle(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+
+		bundle, err = tx.CancelReservationBundle(ctx, params.ID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel reservation bundle: %w", err)
+		}
+		cancelled, err := tx.CancelBundleReservations(ctx, bundle.ID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel bundle reservations: %w", err)
+		}
+		promoted, err = promoteWaitlists(ctx, tx, cancelled)
+		if err != nil {
+			return err
+		}
+
+		reservations, err = tx.ListReservationsByBundleIDs(ctx, []uuid.UUID{bundle.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list bundle reservations: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errReservationBundleNotFound):
+		return (*api.ReservationBundlesCancelNotFound)(reservationBundleNotFoundProblem()), nil
+	case errors.Is(err, errReservationBundleCancelled):
+		return (*api.ReservationBundlesCancelConflict)(reservationBundleCancelledProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := toReservationBundle(bundle, reservations, zones)
+	return &cancelled, nil
+}
+
+// ReservationBundlesReschedule moves every confirmed reservation of a bundle by the distance between
+// the start of its earliest reservation and the requested start. Every moved reservation is checked like
+// a changed reservation, and the first one that cannot be moved keeps the whole bundle unchanged.
+// Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationBundlesReschedule(
+	ctx context.Context,
+	req *api.ReservationBundleReschedule,
+	params api.ReservationBundlesRescheduleParams,
+) (res api.ReservationBundlesRescheduleRes, err error) {
+	defer derrors.Wrap(&err, "ReservationBundlesReschedule(ctx, req, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationBundlesRescheduleUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var (
+		bundle       db.ReservationBundle
+		reservations []db.Reservation
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := lockConfirmedReservationBundle(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		if err := shiftBundle(ctx, tx, current, req.StartsAt, time.Now()); err != nil {
+			return err
+		}
+
+		bundle, err = tx.TouchReservationBundle(ctx, current.ID)
+		if err != nil {
+			return fmt.Errorf("failed to update reservation bundle: %w", err)
+		}
+		reservations, err = tx.ListReservationsByBundleIDs(ctx, []uuid.UUID{bundle.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list bundle reservations: %w", err)
+		}
+		return nil
+	})
+	var componentErr *bundleComponentError
+	switch {
+	case errors.Is(err, errReservationBundleNotFound):
+		return (*api.ReservationBundlesRescheduleNotFound)(reservationBundleNotFoundProblem()), nil
+	case errors.Is(err, errReservationBundleCancelled):
+		return (*api.ReservationBundlesRescheduleConflict)(reservationBundleCancelledProblem()), nil
+	case errors.Is(err, errBundleNotReschedulable):
+		return (*api.ReservationBundlesRescheduleConflict)(bundleNotReschedulableProblem()), nil
+	case errors.As(err, &componentErr) && componentErr.isConflict():
+		return (*api.ReservationBundlesRescheduleConflict)(componentErr.componentProblem()), nil
+	case errors.As(err, &componentErr):
+		return (*api.ReservationBundlesRescheduleBadRequest)(componentErr.componentProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	rescheduled := toReservationBundle(bundle, reservations, zones)
+	return &rescheduled, nil
+}
+
+// reserveBundleComponent reserves the facility of the component with the given index for the bundle.
+// It returns a *bundleComponentError when the component cannot be reserved.
+func reserveBundleComponent(
+	ctx context.Context,
+	tx *Transaction,
+	bundle db.ReservationBundle,
+	index int,
+	c api.ReservationBundleComponent,
+) error {
+	if !c.StartsAt.Before(c.EndsAt) {
+		problem := newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+		return &bundleComponentError{index: index, problem: problem}
+	}
+
+	facility, err := bundleFacility(ctx, tx, index, c.FacilityID)
+	if err != nil {
+		return err
+	}
+	err = checkBundlePeriod(ctx, tx, index, bundle.UserID, facility, c.StartsAt, c.EndsAt, nil)
+	if err != nil {
+		return err
+	}
+
+	blockedStartsAt, blockedEndsAt := blockedPeriod(facility, c.StartsAt, c.EndsAt)
+	_, err = tx.CreateBundleReservation(ctx, db.CreateBundleReservationParams{
+		ID:              uuid.Must(uuid.NewV7()),
+		FacilityID:      facility.ID,
+		UserID:          bundle.UserID,
+		Title:           bundle.Title,
+		Description:     bundle.Description,
+		StartsAt:        c.StartsAt,
+		EndsAt:          c.EndsAt,
+		BlockedStartsAt: blockedStartsAt,
+		BlockedEndsAt:   blockedEndsAt,
+		BundleID:        bundle.ID,
+	})
+	if isExclusionViolation(err) {
+		return &bundleComponentError{index: index, problem: reservationConflictProblem()}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create bundle reservation: %w", err)
+	}
+	return nil
+}
+
+// shiftBundle moves the confirmed reservations of a bundle so that the earliest one starts at startsAt.
+// Components are numbered by the start of their reservation. It returns errBundleNotReschedulable
+// when the bundle has no confirmed reservations or one of them has started by now.
+func shiftBundle(
+	ctx context.Context,
+	tx *Transaction,
+	bundle db.ReservationBundle,
+	startsAt time.Time,
+	now time.Time,
+) error {
+	reservations, err := tx.ListActiveBundleReservationsForUpdate(ctx, bundle.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list bundle reservations: %w", err)
+	}
+	if len(reservations) == 0 || reservations[0].Period.Lower.Time.Before(now) {
+		return errBundleNotReschedulable
+	}
+
+	// Moving the latest reservation first when moving later, and the earliest first when moving earlier,
+	// keeps reservations of the same facility from overlapping each other on the way.
+	offset := startsAt.Sub(reservations[0].Period.Lower.Time)
+	order := make([]int, 0, len(reservations))
+	for i := range reservations {
+		order = append(order, i)
+	}
+	if offset > 0 {
+		slices.Reverse(order)
+	}
+
+	for _, i := range order {
+		r := reservations[i]
+		facility, err := bundleFacility(ctx, tx, i, int(r.FacilityID))
+		if err != nil {
+			return err
+		}
+		newStartsAt, newEndsAt := r.Period.Lower.Time.Add(offset), r.Period.Upper.Time.Add(offset)
+		err = checkBundlePeriod(ctx, tx, i, r.UserID, facility, newStartsAt, newEndsAt, &r.ID)
+		if err != nil {
+			return err
+		}
+
+		blockedStartsAt, blockedEndsAt := blockedPeriod(facility, newStartsAt, newEndsAt)
+		_, err = tx.UpdateReservation(ctx, db.UpdateReservationParams{
+			FacilityID:      r.FacilityID,
+			Title:           r.Title,
+			Description:     r.Description,
+			StartsAt:        newStartsAt,
+			EndsAt:          newEndsAt,
+			BlockedStartsAt: blockedStartsAt,
+			BlockedEndsAt:   blockedEndsAt,
+			Status:          r.Status,
+			AttendeeUserIds: nil,
+			AttendeeEmails:  nil,
+			ID:              r.ID,
+		})
+		if isExclusionViolation(err) {
+			return &bundleComponentError{index: i, problem: reservationConflictProblem()}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move bundle reservation: %w", err)
+		}
+	}
+	return nil
+}
+
+// bundleFacility returns the facility of the component with the given index.
+// It returns a *bundleComponentError when the facility is inactive, missing or requires approval.
+func bundleFacility(ctx context.Context, tx *Transaction, index int, id int) (db.Facility, error) {
+	facilityID, ok := toFacilityID(id)
+	if !ok {
+		return db.Facility{}, &bundleComponentError{index: index, problem: facilityUnavailableProblem()}
+	}
+
+	// The facility is locked for share before its blackouts are checked, see GetFacilityByIDForShare.
+	facility, err := tx.GetFacilityByIDForShare(ctx, facilityID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !facility.IsActive) {
+		return db.Facility{}, &bundleComponentError{index: index, problem: facilityUnavailableProblem()}
+	}
+	if err != nil {
+		return db.Facility{}, fmt.Errorf("failed to get facility: %w", err)
+	}
+	if facility.RequiresApproval {
+		return db.Facility{}, &bundleComponentError{index: index, problem: bundleApprovalProblem()}
+	}
+	return facility, nil
+}
+
+// checkBundlePeriod checks a period of a facility reserved by the component with the given index like
+// a reservation of the user: it must satisfy the booking policy of the facility, lie within its opening hours
+// and outside its blackouts, and keep the user within their booking quotas. excludeID identifies
+// the reservation being moved, if any. It returns a *bundleComponentError when the period cannot be reserved.
+// Expired holds of the facility are deleted so that they do not conflict with the reservation.
+func checkBundlePeriod(
+	ctx context.Context,
+	tx *Transaction,
+	index int,
+	userID uuid.UUID,
+	facility db.Facility,
+	startsAt, endsAt time.Time,
+	excludeID *uuid.UUID,
+) error {
+	problem, found, err := reservationPeriodProblem(ctx, tx, facility.ID, startsAt, endsAt)
+	if err != nil {
+		return err
+	}
+	if found {
+		return &bundleComponentError{index: index, problem: problem}
+	}
+
+	err = enforceBookingQuotas(ctx, tx, userID, facility.ID, startsAt, endsAt, excludeID)
+	var quotaErr *quotaExceededError
+	if errors.As(err, &quotaErr) {
+		return &bundleComponentError{index: index, problem: quotaExceededProblem(quotaErr)}
+	}
+	if err != nil {
+		return err
+	}
+	return deleteExpiredHolds(ctx, tx, facility.ID)
+}
+
+// lockConfirmedReservationBundle locks a bundle visible to the caller for modification.
+// It returns errReservationBundleNotFound or errReservationBundleCancelled when the bundle cannot be modified.
+func lockConfirmedReservationBundle(
+	ctx context.Context,
+	tx *Transaction,
+	caller *AuthenticatedUser,
+	id uuid.UUID,
+) (db.ReservationBundle, error) {
+	bundle, err := tx.GetReservationBundleByIDForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.ReservationBundle{}, errReservationBundleNotFound
+	}
+	if err != nil {
+		return db.ReservationBundle{}, fmt.Errorf("failed to get reservation bundle: %w", err)
+	}
+	allowed, err := canActFor(ctx, tx, caller, bundle.UserID)
+	if err != nil {
+		return db.ReservationBundle{}, err
+	}
+	if !allowed {
+		return db.ReservationBundle{}, errReservationBundleNotFound
+	}
+	if bundle.Status == db.ReservationStatusCancelled {
+		return db.ReservationBundle{}, errReservationBundleCancelled
+	}
+	return bundle, nil
+}
+
+// toReservationBundle converts a database bundle and its reservations into the API representation,
+// given the time zones of the facilities of the reservations.
+func toReservationBundle(
+	b db.ReservationBundle,
+	reservations []db.Reservation,
+	zones map[int32]*time.Location,
+) api.ReservationBundle {
+	list := make([]api.Reservation, 0, len(reservations))
+	for _, r := range reservations {
+		list = append(list, toReservation(r, zones[r.FacilityID]))
+	}
+
+	return api.ReservationBundle{
+		ID:           b.ID,
+		UserID:       b.UserID,
+		Title:        b.Title,
+		Description:  optString(b.Description),
+		Status:       api.ReservationStatus(b.Status),
+		CancelledAt:  optDateTime(b.CancelledAt),
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
+		Reservations: list,
+	}
+}
+
+func reservationBundleNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Reservation bundle not found.")
+}
+
+func reservationBundleCancelledProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation bundle has already been cancelled.")
+}
+
+func bundleNotReschedulableProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"Only bundles with confirmed reservations that have not started yet can be rescheduled.")
+}
+
+func bundleApprovalProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest,
+		"Facilities requiring approval cannot be reserved by bundle. Request each reservation individually.")
+}
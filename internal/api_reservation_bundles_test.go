@@ -0,0 +1,204 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestReservationBundlesValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	id := uuid.Must(uuid.NewV7())
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationBundlesList(t.Context())
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationBundlesCreate(t.Context(), &api.ReservationBundleInput{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesCreateUnauthorized{}, res)
+	})
+
+	t.Run("retrieve rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationBundlesRetrieve(t.Context(), api.ReservationBundlesRetrieveParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesRetrieveUnauthorized{}, res)
+	})
+
+	t.Run("cancel rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationBundlesCancel(t.Context(), api.ReservationBundlesCancelParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesCancelUnauthorized{}, res)
+	})
+
+	t.Run("reschedule rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationBundlesReschedule(t.Context(), &api.ReservationBundleReschedule{},
+			api.ReservationBundlesRescheduleParams{ID: id})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesRescheduleUnauthorized{}, res)
+	})
+}
+
+func TestReservationBundlesLifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUser := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	ownerCtx := internal.WithAuthenticatedUser(ctx, newUser(t))
+	otherCtx := internal.WithAuthenticatedUser(ctx, newUser(t))
+
+	newFacility := func(t *testing.T) *api.PublicFacility {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		return facility
+	}
+	room, projector, catering := newFacility(t), newFacility(t), newFacility(t)
+
+	startsAt := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	component := func(facility *api.PublicFacility, offset time.Duration) api.ReservationBundleComponent {
+		return api.ReservationBundleComponent{
+			FacilityID: facility.ID,
+			StartsAt:   startsAt.Add(offset),
+			EndsAt:     startsAt.Add(offset + time.Hour),
+		}
+	}
+	input := &api.ReservationBundleInput{
+		Title:       "Offsite",
+		Description: api.OptString{},
+		Components: []api.ReservationBundleComponent{
+			component(room, 0),
+			component(projector, 0),
+			component(catering, time.Hour),
+		},
+	}
+
+	// Occupy the catering slot so that the last component conflicts with it.
+	blockingRes, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+		FacilityID: catering.ID,
+		Title:      "Blocking",
+		StartsAt:   startsAt.Add(time.Hour),
+		EndsAt:     startsAt.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	blocking, ok := blockingRes.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", blockingRes)
+
+	t.Run("create reports the conflicting component and reserves nothing", func(t *testing.T) {
+		res, err := svc.ReservationBundlesCreate(ownerCtx, input)
+		require.NoError(t, err)
+		conflict, ok := res.(*api.ReservationBundlesCreateConflict)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptInt(2), conflict.Component)
+
+		listRes, err := svc.ReservationsList(ownerCtx, api.ReservationsListParams{})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.ReservationsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		assert.Empty(t, *list)
+
+		bundlesRes, err := svc.ReservationBundlesList(ownerCtx)
+		require.NoError(t, err)
+		bundles, ok := bundlesRes.(*api.ReservationBundlesListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", bundlesRes)
+		assert.Empty(t, *bundles)
+	})
+
+	cancelRes, err := svc.ReservationsCancel(otherCtx, api.ReservationsCancelParams{ID: blocking.ID})
+	require.NoError(t, err)
+	require.IsType(t, &api.Reservation{}, cancelRes)
+
+	res, err := svc.ReservationBundlesCreate(ownerCtx, input)
+	require.NoError(t, err)
+	bundle, ok := res.(*api.ReservationBundle)
+	require.True(t, ok, "unexpected response %T", res)
+	require.Len(t, bundle.Reservations, 3)
+	for _, r := range bundle.Reservations {
+		assert.Equal(t, api.NewOptUUID(bundle.ID), r.BundleID)
+		assert.Equal(t, api.ReservationStatusConfirmed, r.Status)
+	}
+
+	t.Run("bundle reservations have no attendees", func(t *testing.T) {
+		res, err := svc.ReservationBundlesRetrieve(ownerCtx, api.ReservationBundlesRetrieveParams{ID: bundle.ID})
+		require.NoError(t, err)
+		retrieved, ok := res.(*api.ReservationBundle)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, retrieved.Reservations, 3)
+		for _, r := range retrieved.Reservations {
+			assert.Empty(t, r.Attendees)
+		}
+	})
+
+	t.Run("other users cannot see the bundle", func(t *testing.T) {
+		res, err := svc.ReservationBundlesRetrieve(otherCtx, api.ReservationBundlesRetrieveParams{ID: bundle.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesRetrieveNotFound{}, res)
+	})
+
+	t.Run("reschedule moves every reservation by the same offset", func(t *testing.T) {
+		res, err := svc.ReservationBundlesReschedule(ownerCtx, &api.ReservationBundleReschedule{
+			StartsAt: startsAt.Add(30 * time.Minute),
+		}, api.ReservationBundlesRescheduleParams{ID: bundle.ID})
+		require.NoError(t, err)
+		rescheduled, ok := res.(*api.ReservationBundle)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, rescheduled.Reservations, 3)
+		for i, r := range rescheduled.Reservations {
+			assert.True(t, bundle.Reservations[i].StartsAt.Add(30*time.Minute).Equal(r.StartsAt))
+			assert.True(t, bundle.Reservations[i].EndsAt.Add(30*time.Minute).Equal(r.EndsAt))
+		}
+	})
+
+	t.Run("cancel cancels every reservation", func(t *testing.T) {
+		res, err := svc.ReservationBundlesCancel(ownerCtx, api.ReservationBundlesCancelParams{ID: bundle.ID})
+		require.NoError(t, err)
+		cancelled, ok := res.(*api.ReservationBundle)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusCancelled, cancelled.Status)
+		for _, r := range cancelled.Reservations {
+			assert.Equal(t, api.ReservationStatusCancelled, r.Status)
+		}
+
+		again, err := svc.ReservationBundlesCancel(ownerCtx, api.ReservationBundlesCancelParams{ID: bundle.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationBundlesCancelConflict{}, again)
+	})
+}
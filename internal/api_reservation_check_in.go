@@ -0,0 +1,221 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// checkInOpensBefore is how long before its start a reservation can be checked in.
+const checkInOpensBefore = 15 * time.Minute
+
+var (
+	// errReservationNotConfirmed is returned inside transactions when a pending request is checked in.
+	errReservationNotConfirmed = errors.New("reservation is not confirmed")
+	// errCheckInClosed is returned inside transactions when a reservation is checked in outside its check-in window.
+	errCheckInClosed = errors.New("check-in is closed")
+	// errReservationCheckedIn is returned inside transactions when the reservation was already checked in.
+	errReservationCheckedIn = errors.New("reservation is checked in")
+	// errReservationNotCheckedIn is returned inside transactions when a reservation not checked in is checked out.
+	errReservationNotCheckedIn = errors.New("reservation is not checked in")
+	// errReservationCheckedOut is returned inside transactions when the reservation was already checked out.
+	errReservationCheckedOut = errors.New("reservation is checked out")
+	// errCheckOutClosed is returned inside transactions when a reservation is checked out before it started
+	// or after it ended.
+	errCheckOutClosed = errors.New("check-out is closed")
+)
+
+// ReservationsCheckIn records that the user of a confirmed reservation showed up.
+// Check-in opens shortly before the reservation starts and closes when it ends, or at the grace deadline
+// of facilities requiring check-in, after which the reservation is released as a no-show.
+// Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationsCheckIn(
+	ctx context.Context,
+	params api.ReservationsCheckInParams,
+) (res api.ReservationsCheckInRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsCheckIn(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsCheckInUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var reservation db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := lockActiveReservation(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status != db.ReservationStatusConfirmed {
+			return errReservationNotConfirmed
+		}
+		if current.CheckedInAt != nil {
+			return errReservationCheckedIn
+		}
+
+		facility, err := tx.GetFacilityByID(ctx, current.FacilityID)
+		if err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+		if !withinCheckInWindow(facility, current, time.Now()) {
+			return errCheckInClosed
+		}
+
+		reservation, err = tx.CheckInReservation(ctx, current.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check in reservation: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.ReservationsCheckInNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationsCheckInConflict)(reservationCancelledProblem()), nil
+	case errors.Is(err, errReservationClosed):
+		return (*api.ReservationsCheckInConflict)(reservationClosedProblem()), nil
+	case errors.Is(err, errReservationNotConfirmed):
+		return (*api.ReservationsCheckInConflict)(reservationNotConfirmedProblem()), nil
+	case errors.Is(err, errReservationCheckedIn):
+		return (*api.ReservationsCheckInConflict)(reservationCheckedInProblem()), nil
+	case errors.Is(err, errCheckInClosed):
+		return (*api.ReservationsCheckInConflict)(checkInClosedProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedIn := toReservation(reservation, loc)
+	return &checkedIn, nil
+}
+
+// ReservationsCheckOut ends a checked-in reservation early, releasing the rest of its period,
+// including the teardown buffer, for other reservations. Waitlist entries fitting the released period are
+// promoted to reservations in the same transaction, and their users are notified once it commits.
+// Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationsCheckOut(
+	ctx context.Context,
+	params api.ReservationsCheckOutParams,
+) (res api.ReservationsCheckOutRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsCheckOut(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsCheckOutUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var (
+		reservation db.Reservation
+		promoted    []db.WaitlistEntry
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := lockActiveReservation(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		if current.CheckedInAt == nil {
+			return errReservationNotCheckedIn
+		}
+		if current.CheckedOutAt != nil {
+			return errReservationCheckedOut
+		}
+
+		// Reservations that have not started yet are cancelled instead.
+		now := time.Now()
+		if !now.After(current.Period.Lower.Time) || !now.Before(current.Period.Upper.Time) {
+			return errCheckOutClosed
+		}
+
+		facility, err := tx.GetFacilityByID(ctx, current.FacilityID)
+		if err != nil {
+			return fmt.Errorf("failed to get facility: %w", err)
+		}
+		_, blockedEndsAt := blockedPeriod(facility, current.Period.Lower.Time, now)
+		reservation, err = tx.CheckOutReservation(ctx, db.CheckOutReservationParams{
+			EndsAt:        now,
+			BlockedEndsAt: blockedEndsAt,
+			ID:            current.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check out reservation: %w", err)
+		}
+		promoted, err = promoteWaitlist(ctx, tx, current)
+		return err
+	})
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.ReservationsCheckOutNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationsCheckOutConflict)(reservationCancelledProblem()), nil
+	case errors.Is(err, errReservationClosed):
+		return (*api.ReservationsCheckOutConflict)(reservationClosedProblem()), nil
+	case errors.Is(err, errReservationNotCheckedIn):
+		return (*api.ReservationsCheckOutConflict)(reservationNotCheckedInProblem()), nil
+	case errors.Is(err, errReservationCheckedOut):
+		return (*api.ReservationsCheckOutConflict)(reservationCheckedOutProblem()), nil
+	case errors.Is(err, errCheckOutClosed):
+		return (*api.ReservationsCheckOutConflict)(checkOutClosedProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedOut := toReservation(reservation, loc)
+	return &checkedOut, nil
+}
+
+// withinCheckInWindow reports whether the reservation of the facility can be checked in at now.
+func withinCheckInWindow(f db.Facility, r db.Reservation, now time.Time) bool {
+	closesAt := r.Period.Upper.Time
+	if f.CheckInGraceMinutes != nil {
+		deadline := r.Period.Lower.Time.Add(time.Duration(*f.CheckInGraceMinutes) * time.Minute)
+		if deadline.Before(closesAt) {
+			closesAt = deadline
+		}
+	}
+	return !now.Before(r.Period.Lower.Time.Add(-checkInOpensBefore)) && now.Before(closesAt)
+}
+
+func reservationNotConfirmedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation request has not been approved yet.")
+}
+
+func reservationCheckedInProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation has already been checked in.")
+}
+
+func checkInClosedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"Check-in opens 15 minutes before the reservation starts and closes at its grace deadline or end.")
+}
+
+func reservationNotCheckedInProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation has not been checked in.")
+}
+
+func reservationCheckedOutProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation has already been checked out.")
+}
+
+func checkOutClosedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"Only reservations in progress can be checked out. Cancel reservations that have not started instead.")
+}
@@ -0,0 +1,173 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestReservationCheckInValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	t.Run("check-in rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationsCheckIn(t.Context(), api.ReservationsCheckInParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCheckInUnauthorized{}, res)
+	})
+
+	t.Run("check-out rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationsCheckOut(t.Context(), api.ReservationsCheckOutParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCheckOutUnauthorized{}, res)
+	})
+}
+
+func TestReservationCheckIn(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	ownerCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  created.User.IsStaff,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+		Name:                gofakeit.Company(),
+		CheckInGraceMinutes: api.NewOptInt32(10),
+	})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	reserve := func(t *testing.T, startsAt, endsAt time.Time) *api.Reservation {
+		t.Helper()
+		res, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     endsAt,
+		})
+		require.NoError(t, err)
+		reservation, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		return reservation
+	}
+
+	t.Run("check-in does not open long before the start", func(t *testing.T) {
+		startsAt := time.Now().UTC().Add(3 * time.Hour).Truncate(time.Minute)
+		reservation := reserve(t, startsAt, startsAt.Add(time.Hour))
+
+		res, err := svc.ReservationsCheckIn(ownerCtx, api.ReservationsCheckInParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCheckInConflict{}, res)
+	})
+
+	t.Run("checking out early releases the rest of the period", func(t *testing.T) {
+		startsAt := time.Now().UTC().Add(-5 * time.Minute).Truncate(time.Minute)
+		endsAt := startsAt.Add(time.Hour)
+		reservation := reserve(t, startsAt, endsAt)
+
+		res, err := svc.ReservationsCheckOut(ownerCtx, api.ReservationsCheckOutParams{ID: reservation.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.ReservationsCheckOutConflict{}, res)
+
+		checkInRes, err := svc.ReservationsCheckIn(ownerCtx, api.ReservationsCheckInParams{ID: reservation.ID})
+		require.NoError(t, err)
+		checkedIn, ok := checkInRes.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", checkInRes)
+		assert.True(t, checkedIn.CheckedInAt.IsSet())
+
+		checkInRes, err = svc.ReservationsCheckIn(ownerCtx, api.ReservationsCheckInParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCheckInConflict{}, checkInRes)
+
+		res, err = svc.ReservationsCheckOut(ownerCtx, api.ReservationsCheckOutParams{ID: reservation.ID})
+		require.NoError(t, err)
+		checkedOut, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.True(t, checkedOut.CheckedOutAt.IsSet())
+		assert.WithinDuration(t, time.Now(), checkedOut.EndsAt, time.Minute)
+
+		reserve(t, checkedOut.EndsAt, endsAt)
+	})
+
+	t.Run("the sweeper releases reservations not checked in as no-shows", func(t *testing.T) {
+		startsAt := time.Now().UTC().Add(6 * time.Hour).Truncate(time.Hour)
+		reservation := reserve(t, startsAt, startsAt.Add(time.Hour))
+
+		err := internal.NewSweeper(ds, time.Minute).Sweep(ctx, startsAt.Add(11*time.Minute))
+		require.NoError(t, err)
+
+		res, err := svc.ReservationsRetrieve(ownerCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		released, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusNoShow, released.Status)
+		assert.True(t, startsAt.Add(11*time.Minute).Equal(released.EndsAt))
+
+		reserve(t, startsAt, startsAt.Add(time.Hour))
+	})
+
+	t.Run("the sweeper releases reservations without a grace period only after they start", func(t *testing.T) {
+		noGraceRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:                gofakeit.Company(),
+			CheckInGraceMinutes: api.NewOptInt32(0),
+		})
+		require.NoError(t, err)
+		noGrace, ok := noGraceRes.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", noGraceRes)
+
+		startsAt := time.Now().UTC().Add(8 * time.Hour).Truncate(time.Hour)
+		createRes, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+			FacilityID: noGrace.ID,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		reservation, ok := createRes.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", createRes)
+
+		sweeper := internal.NewSweeper(ds, time.Minute)
+		require.NoError(t, sweeper.Sweep(ctx, startsAt))
+		res, err := svc.ReservationsRetrieve(ownerCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		retrieved, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusConfirmed, retrieved.Status)
+
+		require.NoError(t, sweeper.Sweep(ctx, startsAt.Add(time.Minute)))
+		res, err = svc.ReservationsRetrieve(ownerCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		released, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusNoShow, released.Status)
+		assert.True(t, startsAt.Add(time.Minute).Equal(released.EndsAt))
+	})
+}
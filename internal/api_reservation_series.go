@@ -0,0 +1,759 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+	"github.com/thara/facility_reservation_go/internal/policy"
+)
+
+var (
+	// errReservationSeriesNotFound is returned inside transactions when the series is missing
+	// or not visible to the caller.
+	errReservationSeriesNotFound = errors.New("reservation series not found")
+	// errReservationSeriesCancelled is returned inside transactions when the series was already cancelled.
+	errReservationSeriesCancelled = errors.New("reservation series is cancelled")
+	// errSeriesConflict is returned inside transactions to roll back a series whose occurrences conflict
+	// in reject mode.
+	errSeriesConflict = errors.New("series occurrences conflict with existing reservations")
+)
+
+// ReservationSeriesList returns reservation series ordered by their first occurrence.
+// Staff users see all series, other users their own and those of the users who granted them delegation.
+func (s *APIService) ReservationSeriesList(ctx context.Context) (res api.ReservationSeriesListRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesList(ctx)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return unauthenticatedProblem(), nil
+	}
+
+	var userID *uuid.UUID
+	if !caller.IsStaff {
+		id, err := uuid.Parse(caller.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+		}
+		userID = &id
+	}
+
+	series, err := s.ds.ListReservationSeries(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservation series: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(series))
+	for _, rs := range series {
+		ids = append(ids, rs.ID)
+	}
+	occurrences, err := s.ds.ListReservationsBySeriesIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series occurrences: %w", err)
+	}
+
+	bySeries := make(map[uuid.UUID][]db.Reservation, len(series))
+	for _, r := range occurrences {
+		if r.SeriesID != nil {
+			bySeries[*r.SeriesID] = append(bySeries[*r.SeriesID], r)
+		}
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(api.ReservationSeriesListOKApplicationJSON, 0, len(series))
+	for _, rs := range series {
+		list = append(list, toReservationSeries(rs, bySeries[rs.ID], zones))
+	}
+	return &list, nil
+}
+
+// ReservationSeriesCreate creates a recurring series for the authenticated user and expands it into reservations
+// within a single transaction. Conflicting occurrences either reject the whole series or are skipped.
+// Every rule of the booking policy of the facility an occurrence violates is reported with 400 Bad Request.
+// Occurrences exceeding a booking quota of the user are rejected with 409 Conflict unless skipped.
+// Facilities requiring approval cannot be reserved by series.
+func (s *APIService) ReservationSeriesCreate(
+	ctx context.Context,
+	req *api.ReservationSeriesInput,
+	params api.ReservationSeriesCreateParams,
+) (res api.ReservationSeriesCreateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesCreate(ctx, req, params)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	timeZone, err := seriesTimeZone(ctx, s.ds, req)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, problem := expandSeriesInput(req, timeZone)
+	if problem != nil {
+		return (*api.ReservationSeriesCreateBadRequest)(problem), nil
+	}
+
+	userID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.ReservationSeriesCreateBadRequest)(facilityUnavailableProblem()), nil
+	}
+	if facility.RequiresApproval {
+		return (*api.ReservationSeriesCreateBadRequest)(seriesApprovalProblem()), nil
+	}
+
+	var result seriesResult
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		series, err := tx.CreateReservationSeries(ctx, db.CreateReservationSeriesParams{
+			ID:          uuid.Must(uuid.NewV7()),
+			FacilityID:  facility.ID,
+			UserID:      userID,
+			Title:       req.Title,
+			Description: ptrOf(req.Description),
+			Rrule:       req.Rrule,
+			TimeZone:    timeZone,
+			StartsAt:    req.StartsAt,
+			EndsAt:      req.EndsAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create reservation series: %w", err)
+		}
+
+		result, err = materializeSeries(ctx, tx, series, occurrences, params.ConflictMode.Or(api.ConflictModeReject))
+		return err
+	})
+	var (
+		policyErr *bookingPolicyError
+		quotaErr  *quotaExceededError
+	)
+	switch {
+	case errors.As(err, &policyErr):
+		return (*api.ReservationSeriesCreateBadRequest)(bookingPolicyViolationProblem(policyErr.violations)), nil
+	case errors.As(err, &quotaErr):
+		return (*api.ReservationSeriesCreateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.Is(err, errSeriesConflict):
+		return (*api.ReservationSeriesCreateConflict)(seriesConflictProblem(result)), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, result.occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	created := toReservationSeriesWithSkipped(result, zones)
+	return &created, nil
+}
+
+// ReservationSeriesRetrieve returns a single series with its confirmed occurrences.
+// Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationSeriesRetrieve(
+	ctx context.Context,
+	params api.ReservationSeriesRetrieveParams,
+) (res api.ReservationSeriesRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesRetrieve(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesRetrieveUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	series, err := s.ds.GetReservationSeriesByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.ReservationSeriesRetrieveNotFound)(reservationSeriesNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation series: %w", err)
+	}
+	allowed, err := canActFor(ctx, s.ds, caller, series.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return (*api.ReservationSeriesRetrieveNotFound)(reservationSeriesNotFoundProblem()), nil
+	}
+
+	occurrences, err := s.ds.ListReservationsBySeriesIDs(ctx, []uuid.UUID{series.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series occurrences: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toReservationSeries(series, occurrences, zones)
+	return &found, nil
+}
+
+// ReservationSeriesUpdate replaces a confirmed series and regenerates its occurrences that have not started yet.
+// Occurrences that have already started are kept unchanged. Only its owner, their delegates and staff users are
+// allowed.
+func (s *APIService) ReservationSeriesUpdate(
+	ctx context.Context,
+	req *api.ReservationSeriesInput,
+	params api.ReservationSeriesUpdateParams,
+) (res api.ReservationSeriesUpdateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesUpdate(ctx, req, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesUpdateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	timeZone, err := seriesTimeZone(ctx, s.ds, req)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, problem := expandSeriesInput(req, timeZone)
+	if problem != nil {
+		return (*api.ReservationSeriesUpdateBadRequest)(problem), nil
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.ReservationSeriesUpdateBadRequest)(facilityUnavailableProblem()), nil
+	}
+	if facility.RequiresApproval {
+		return (*api.ReservationSeriesUpdateBadRequest)(seriesApprovalProblem()), nil
+	}
+
+	var result seriesResult
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		_, err := lockConfirmedReservationSeries(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err = replaceUpcomingOccurrences(ctx, tx, db.UpdateReservationSeriesParams{
+			ID:          params.ID,
+			FacilityID:  facility.ID,
+			Title:       req.Title,
+			Description: ptrOf(req.Description),
+			Rrule:       req.Rrule,
+			TimeZone:    timeZone,
+			StartsAt:    req.StartsAt,
+			EndsAt:      req.EndsAt,
+		}, occurrences, params.ConflictMode.Or(api.ConflictModeReject), time.Now())
+		return err
+	})
+	var (
+		policyErr *bookingPolicyError
+		quotaErr  *quotaExceededError
+	)
+	switch {
+	case errors.Is(err, errReservationSeriesNotFound):
+		return (*api.ReservationSeriesUpdateNotFound)(reservationSeriesNotFoundProblem()), nil
+	case errors.Is(err, errReservationSeriesCancelled):
+		return (*api.ReservationSeriesUpdateConflict)(reservationSeriesCancelledProblem()), nil
+	case errors.As(err, &policyErr):
+		return (*api.ReservationSeriesUpdateBadRequest)(bookingPolicyViolationProblem(policyErr.violations)), nil
+	case errors.As(err, &quotaErr):
+		return (*api.ReservationSeriesUpdateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.Is(err, errSeriesConflict):
+		return (*api.ReservationSeriesUpdateConflict)(seriesConflictProblem(result)), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, result.occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toReservationSeriesWithSkipped(result, zones)
+	return &updated, nil
+}
+
+// ReservationSeriesCancel cancels a confirmed series together with its occurrences that have not started yet.
+// Waitlist entries fitting the released periods are promoted in the same transaction, and their users are
+// notified once it commits. Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationSeriesCancel(
+	ctx context.Context,
+	params api.ReservationSeriesCancelParams,
+) (res api.ReservationSeriesCancelRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesCancel(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesCancelUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var (
+		series      db.ReservationSeries
+		occurrences []db.Reservation
+		promoted    []db.WaitlistEntry
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		_, err := lockConfirmedReservationSeries(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+
+		series, promoted, err = cancelSeries(ctx, tx, params.ID, time.Now())
+		if err != nil {
+			return err
+		}
+
+		occurrences, err = tx.ListReservationsBySeriesIDs(ctx, []uuid.UUID{series.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list series occurrences: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errReservationSeriesNotFound):
+		return (*api.ReservationSeriesCancelNotFound)(reservationSeriesNotFoundProblem()), nil
+	case errors.Is(err, errReservationSeriesCancelled):
+		return (*api.ReservationSeriesCancelConflict)(reservationSeriesCancelledProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := toReservationSeries(series, occurrences, zones)
+	return &cancelled, nil
+}
+
+// seriesResult is a series after its occurrences have been written.
+type seriesResult struct {
+	series      db.ReservationSeries
+	occurrences []db.Reservation
+	skipped     []occurrence
+	// total is the number of occurrences that were attempted to be written.
+	total int
+}
+
+// materializeSeries inserts the given occurrences of a series and loads its confirmed occurrences.
+// Occurrences violating the booking policy of the facility fail the whole series with a bookingPolicyError.
+// Occurrences overlapping blackouts or confirmed reservations, including the buffers of the facility,
+// or outside its opening hours are skipped; in reject mode any skip fails with errSeriesConflict
+// so that the caller's transaction is rolled back.
+// Occurrences exceeding a booking quota of the owner are skipped as well, but fail with a quotaExceededError
+// in reject mode. The occurrences written before count against the quotas of the later ones.
+func materializeSeries(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	occurrences []occurrence,
+	mode api.ConflictMode,
+) (seriesResult, error) {
+	result := seriesResult{
+		series:      series,
+		occurrences: nil,
+		skipped:     make([]occurrence, 0),
+		total:       len(occurrences),
+	}
+
+	var calendar seriesCalendar
+	if len(occurrences) > 0 {
+		var err error
+		calendar, err = loadSeriesCalendar(ctx, tx, series.FacilityID, occurrences)
+		if err != nil {
+			return result, err
+		}
+		if err := deleteExpiredHolds(ctx, tx, series.FacilityID); err != nil {
+			return result, err
+		}
+	}
+	if violations := calendar.policyViolations(occurrences, time.Now()); len(violations) > 0 {
+		return result, &bookingPolicyError{violations: violations}
+	}
+
+	for _, o := range occurrences {
+		_, blackedOut := overlappingBlackout(calendar.blackouts, o.StartsAt, o.EndsAt)
+		if blackedOut || !calendar.hours.covers(o.StartsAt, o.EndsAt) {
+			result.skipped = append(result.skipped, o)
+			continue
+		}
+		err := enforceBookingQuotas(ctx, tx, series.UserID, series.FacilityID, o.StartsAt, o.EndsAt, nil)
+		var quotaErr *quotaExceededError
+		if errors.As(err, &quotaErr) && mode == api.ConflictModeSkip {
+			result.skipped = append(result.skipped, o)
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		blockedStartsAt, blockedEndsAt := blockedPeriod(calendar.facility, o.StartsAt, o.EndsAt)
+		inserted, err := tx.CreateSeriesOccurrence(ctx, db.CreateSeriesOccurrenceParams{
+			ID:              uuid.Must(uuid.NewV7()),
+			FacilityID:      series.FacilityID,
+			UserID:          series.UserID,
+			Title:           series.Title,
+			Description:     series.Description,
+			StartsAt:        o.StartsAt,
+			EndsAt:          o.EndsAt,
+			BlockedStartsAt: blockedStartsAt,
+			BlockedEndsAt:   blockedEndsAt,
+			SeriesID:        series.ID,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to create series occurrence: %w", err)
+		}
+		if inserted == 0 {
+			result.skipped = append(result.skipped, o)
+		}
+	}
+	if len(result.skipped) > 0 && mode == api.ConflictModeReject {
+		return result, errSeriesConflict
+	}
+
+	var err error
+	result.occurrences, err = tx.ListReservationsBySeriesIDs(ctx, []uuid.UUID{series.ID})
+	if err != nil {
+		return result, fmt.Errorf("failed to list series occurrences: %w", err)
+	}
+	return result, nil
+}
+
+// seriesCalendar is what the occurrences of a series are checked against before they are written.
+type seriesCalendar struct {
+	facility  db.Facility
+	hours     openingHours
+	blackouts []blackoutPeriod
+	rules     policy.Policy
+	loc       *time.Location
+}
+
+// loadSeriesCalendar loads the facility, opening hours, blackouts and booking policy covering the occurrences,
+// which must be ordered by their start.
+func loadSeriesCalendar(
+	ctx context.Context,
+	tx *Transaction,
+	facilityID int32,
+	occurrences []occurrence,
+) (seriesCalendar, error) {
+	// The facility is locked for share before its blackouts are loaded, see GetFacilityByIDForShare.
+	facility, err := tx.GetFacilityByIDForShare(ctx, facilityID)
+	if err != nil {
+		return seriesCalendar{}, fmt.Errorf("failed to get facility: %w", err)
+	}
+
+	from, to := occurrences[0].StartsAt, occurrences[len(occurrences)-1].EndsAt
+	calendars, err := loadOpeningHours(ctx, tx, []int32{facilityID}, from, to)
+	if err != nil {
+		return seriesCalendar{}, err
+	}
+	blackouts, err := loadBlackouts(ctx, tx, []int32{facilityID}, from, to)
+	if err != nil {
+		return seriesCalendar{}, err
+	}
+	rules, loc, err := loadEffectiveBookingPolicy(ctx, tx, facilityID)
+	if err != nil {
+		return seriesCalendar{}, err
+	}
+
+	return seriesCalendar{
+		facility:  facility,
+		hours:     calendars[facilityID],
+		blackouts: blackouts[facilityID],
+		rules:     rules,
+		loc:       loc,
+	}, nil
+}
+
+// policyViolations evaluates the occurrences made at now against the booking policy and returns every violated
+// rule once, in the order they are first violated.
+func (c seriesCalendar) policyViolations(occurrences []occurrence, now time.Time) []policy.Violation {
+	seen := make(map[policy.Violation]bool)
+	violations := make([]policy.Violation, 0)
+	for _, o := range occurrences {
+		for _, v := range policy.Evaluate(c.rules, policy.Request{
+			StartsAt: o.StartsAt,
+			EndsAt:   o.EndsAt,
+			Now:      now,
+			Location: c.loc,
+		}) {
+			if !seen[v] {
+				seen[v] = true
+				violations = append(violations, v)
+			}
+		}
+	}
+	return violations
+}
+
+// replaceUpcomingOccurrences updates a series and regenerates its occurrences starting at or after now.
+func replaceUpcomingOccurrences(
+	ctx context.Context,
+	tx *Transaction,
+	arg db.UpdateReservationSeriesParams,
+	occurrences []occurrence,
+	mode api.ConflictMode,
+	now time.Time,
+) (seriesResult, error) {
+	series, err := tx.UpdateReservationSeries(ctx, arg)
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to update reservation series: %w", err)
+	}
+	return regenerateOccurrences(ctx, tx, series, occurrences, mode, now)
+}
+
+// regenerateOccurrences replaces the occurrences of a series starting at or after now.
+// Occurrences edited or skipped individually are kept as long as the rule still yields their original start;
+// upcoming ones the rule no longer yields are removed.
+func regenerateOccurrences(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	occurrences []occurrence,
+	mode api.ConflictMode,
+	now time.Time,
+) (seriesResult, error) {
+	_, err := tx.DeleteSeriesReservationsFrom(ctx, db.DeleteSeriesReservationsFromParams{
+		SeriesID: series.ID,
+		From:     now,
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to delete upcoming occurrences: %w", err)
+	}
+
+	generated := make(map[int64]bool, len(occurrences))
+	for _, o := range occurrences {
+		generated[o.StartsAt.UnixMicro()] = true
+	}
+
+	exceptions, err := tx.ListSeriesExceptions(ctx, series.ID)
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to list series exceptions: %w", err)
+	}
+	kept := make(map[int64]bool, len(exceptions))
+	for _, r := range exceptions {
+		switch {
+		case generated[r.OriginalStartsAt.UnixMicro()]:
+			kept[r.OriginalStartsAt.UnixMicro()] = true
+		case !r.OriginalStartsAt.Before(now):
+			if err := tx.DeleteReservation(ctx, r.ID); err != nil {
+				return seriesResult{}, fmt.Errorf("failed to delete stale exception: %w", err)
+			}
+		}
+	}
+
+	upcoming := make([]occurrence, 0, len(occurrences))
+	for _, o := range occurrencesFrom(occurrences, now) {
+		if !kept[o.StartsAt.UnixMicro()] {
+			upcoming = append(upcoming, o)
+		}
+	}
+	return materializeSeries(ctx, tx, series, upcoming, mode)
+}
+
+// cancelSeries cancels a series together with its occurrences starting at or after from
+// and promotes the waitlist entries fitting the released periods. It returns the promoted entries.
+func cancelSeries(
+	ctx context.Context,
+	tx *Transaction,
+	id uuid.UUID,
+	from time.Time,
+) (db.ReservationSeries, []db.WaitlistEntry, error) {
+	series, err := tx.CancelReservationSeries(ctx, id)
+	if err != nil {
+		return db.ReservationSeries{}, nil, fmt.Errorf("failed to cancel reservation series: %w", err)
+	}
+
+	cancelled, err := tx.CancelSeriesReservationsFrom(ctx, db.CancelSeriesReservationsFromParams{
+		SeriesID: series.ID,
+		From:     from,
+	})
+	if err != nil {
+		return db.ReservationSeries{}, nil, fmt.Errorf("failed to cancel upcoming occurrences: %w", err)
+	}
+	promoted, err := promoteWaitlists(ctx, tx, cancelled)
+	if err != nil {
+		return db.ReservationSeries{}, nil, err
+	}
+	return series, promoted, nil
+}
+
+// lockConfirmedReservationSeries locks a series visible to the caller for modification.
+// It returns errReservationSeriesNotFound or errReservationSeriesCancelled when the series cannot be modified.
+func lockConfirmedReservationSeries(
+	ctx context.Context,
+	tx *Transaction,
+	caller *AuthenticatedUser,
+	id uuid.UUID,
+) (db.ReservationSeries, error) {
+	series, err := tx.GetReservationSeriesByIDForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.ReservationSeries{}, errReservationSeriesNotFound
+	}
+	if err != nil {
+		return db.ReservationSeries{}, fmt.Errorf("failed to get reservation series: %w", err)
+	}
+	allowed, err := canActFor(ctx, tx, caller, series.UserID)
+	if err != nil {
+		return db.ReservationSeries{}, err
+	}
+	if !allowed {
+		return db.ReservationSeries{}, errReservationSeriesNotFound
+	}
+	if series.Status == db.ReservationStatusCancelled {
+		return db.ReservationSeries{}, errReservationSeriesCancelled
+	}
+	return series, nil
+}
+
+// seriesTimeZone returns the name of the time zone in which the rule of a series request is expanded,
+// defaulting to the time zone of its facility.
+func seriesTimeZone(ctx context.Context, q db.Querier, req *api.ReservationSeriesInput) (string, error) {
+	if name, ok := req.TimeZone.Get(); ok {
+		return name, nil
+	}
+
+	name := defaultTimeZone.String()
+	// Requests for facilities that do not exist are rejected once the facility is looked up.
+	if facilityID, ok := toFacilityID(req.FacilityID); ok {
+		loc, err := facilityTimeZone(ctx, q, facilityID)
+		if err != nil {
+			return "", err
+		}
+		name = loc.String()
+	}
+	return name, nil
+}
+
+// expandSeriesInput validates a series request and expands it into occurrences in the named time zone.
+// It returns a problem when the request cannot describe a series.
+func expandSeriesInput(req *api.ReservationSeriesInput, timeZone string) ([]occurrence, *api.ProblemDetails) {
+	if !req.StartsAt.Before(req.EndsAt) {
+		return nil, newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+	}
+
+	loc, err := loadSeriesLocation(timeZone)
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, err.Error()+".")
+	}
+	occurrences, err := expandRecurrence(req.Rrule, req.StartsAt, req.EndsAt, loc)
+	if err != nil {
+		return nil, newProblem(http.StatusBadRequest, err.Error()+".")
+	}
+	return occurrences, nil
+}
+
+// occurrencesFrom returns the occurrences starting at or after t.
+func occurrencesFrom(occurrences []occurrence, t time.Time) []occurrence {
+	upcoming := make([]occurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		if !o.StartsAt.Before(t) {
+			upcoming = append(upcoming, o)
+		}
+	}
+	return upcoming
+}
+
+// toReservationSeries converts a database series and its occurrences into the API representation,
+// given the time zones of the facilities of the occurrences.
+func toReservationSeries(
+	rs db.ReservationSeries,
+	occurrences []db.Reservation,
+	zones map[int32]*time.Location,
+) api.ReservationSeries {
+	list := make([]api.Reservation, 0, len(occurrences))
+	for _, r := range occurrences {
+		list = append(list, toReservation(r, zones[r.FacilityID]))
+	}
+
+	return api.ReservationSeries{
+		ID:          rs.ID,
+		UserID:      rs.UserID,
+		FacilityID:  int(rs.FacilityID),
+		Title:       rs.Title,
+		Description: optString(rs.Description),
+		StartsAt:    rs.StartsAt,
+		EndsAt:      rs.EndsAt,
+		Rrule:       rs.Rrule,
+		TimeZone:    api.NewOptString(rs.TimeZone),
+		Status:      api.ReservationStatus(rs.Status),
+		CancelledAt: optDateTime(rs.CancelledAt),
+		CreatedAt:   rs.CreatedAt,
+		UpdatedAt:   rs.UpdatedAt,
+		Occurrences: list,
+	}
+}
+
+// toReservationSeriesWithSkipped converts a written series into the API representation reporting skipped
+// occurrences.
+func toReservationSeriesWithSkipped(
+	result seriesResult,
+	zones map[int32]*time.Location,
+) api.ReservationSeriesWithSkipped {
+	series := toReservationSeries(result.series, result.occurrences, zones)
+
+	skipped := make([]api.OccurrencePeriod, 0, len(result.skipped))
+	for _, o := range result.skipped {
+		skipped = append(skipped, api.OccurrencePeriod{
+			StartsAt: o.StartsAt,
+			EndsAt:   o.EndsAt,
+		})
+	}
+
+	return api.ReservationSeriesWithSkipped{
+		ID:          series.ID,
+		UserID:      series.UserID,
+		FacilityID:  series.FacilityID,
+		Title:       series.Title,
+		Description: series.Description,
+		StartsAt:    series.StartsAt,
+		EndsAt:      series.EndsAt,
+		Rrule:       series.Rrule,
+		TimeZone:    series.TimeZone,
+		Status:      series.Status,
+		CancelledAt: series.CancelledAt,
+		CreatedAt:   series.CreatedAt,
+		UpdatedAt:   series.UpdatedAt,
+		Occurrences: series.Occurrences,
+		Skipped:     skipped,
+	}
+}
+
+func reservationSeriesNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Reservation series not found.")
+}
+
+func reservationSeriesCancelledProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation series has already been cancelled.")
+}
+
+func seriesApprovalProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest,
+		"Facilities requiring approval cannot be reserved by series. Request each reservation individually.")
+}
+
+func seriesConflictProblem(result seriesResult) *api.ProblemDetails {
+	return newProblem(http.StatusConflict, fmt.Sprintf(
+		"%d of %d occurrences overlap existing reservations or blackouts, or fall outside opening hours. "+
+			"Use conflict_mode=skip to create the others.",
+		len(result.skipped), result.total))
+}
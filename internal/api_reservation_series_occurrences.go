@@ -0,0 +1,492 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+var (
+	// errOccurrenceNotFound is returned inside transactions when the reservation is not an occurrence of the series.
+	errOccurrenceNotFound = errors.New("occurrence not found")
+	// errOccurrenceStarted is returned inside transactions when a started occurrence would be replaced.
+	errOccurrenceStarted = errors.New("occurrence has already started")
+)
+
+// occurrenceChange is a requested change of a series occurrence.
+type occurrenceChange struct {
+	req      *api.ReservationInput
+	facility db.Facility
+	mode     api.ConflictMode
+	now      time.Time
+}
+
+// ReservationSeriesOccurrenceUpdate edits an occurrence of a confirmed series within the requested scope.
+// Occurrences have no attendees, so requests listing any are rejected.
+// Only the owner of the series, their delegates and staff users are allowed.
+func (s *APIService) ReservationSeriesOccurrenceUpdate(
+	ctx context.Context,
+	req *api.ReservationInput,
+	params api.ReservationSeriesOccurrenceUpdateParams,
+) (res api.ReservationSeriesOccurrenceUpdateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesOccurrenceUpdate(ctx, req, %s, %s)", params.ID, params.OccurrenceID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesOccurrenceUpdateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	if problem := validateReservationPeriod(req); problem != nil {
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(problem), nil
+	}
+	if len(req.Attendees) > 0 {
+		problem := newProblem(http.StatusBadRequest, "Occurrences of a series cannot have attendees.")
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(problem), nil
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(facilityUnavailableProblem()), nil
+	}
+	if facility.RequiresApproval {
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(seriesApprovalProblem()), nil
+	}
+
+	change := occurrenceChange{
+		req:      req,
+		facility: facility,
+		mode:     params.ConflictMode.Or(api.ConflictModeReject),
+		now:      time.Now(),
+	}
+
+	var result seriesResult
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		series, err := lockConfirmedReservationSeries(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		r, err := lockSeriesOccurrence(ctx, tx, series.ID, params.OccurrenceID)
+		if err != nil {
+			return err
+		}
+
+		switch params.Scope.Or(api.EditScopeThis) {
+		case api.EditScopeThis:
+			result, err = editOccurrence(ctx, tx, series, r, change)
+		case api.EditScopeThisAndFollowing:
+			result, err = splitSeries(ctx, tx, series, r, change)
+		case api.EditScopeAll:
+			result, err = shiftSeries(ctx, tx, series, r, change)
+		}
+		return err
+	})
+	if err != nil {
+		return occurrenceUpdateFailure(err, result)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, result.occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toReservationSeriesWithSkipped(result, zones)
+	return &updated, nil
+}
+
+// ReservationSeriesOccurrenceSkip skips an occurrence of a confirmed series within the requested scope.
+// Waitlist entries fitting the released periods are promoted in the same transaction, and their users are
+// notified once it commits. Only the owner of the series, their delegates and staff users are allowed.
+func (s *APIService) ReservationSeriesOccurrenceSkip(
+	ctx context.Context,
+	params api.ReservationSeriesOccurrenceSkipParams,
+) (res api.ReservationSeriesOccurrenceSkipRes, err error) {
+	defer derrors.Wrap(&err, "ReservationSeriesOccurrenceSkip(ctx, %s, %s)", params.ID, params.OccurrenceID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationSeriesOccurrenceSkipUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	now := time.Now()
+	var (
+		series      db.ReservationSeries
+		occurrences []db.Reservation
+		promoted    []db.WaitlistEntry
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		var err error
+		series, err = lockConfirmedReservationSeries(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		r, err := lockSeriesOccurrence(ctx, tx, series.ID, params.OccurrenceID)
+		if err != nil {
+			return err
+		}
+
+		switch params.Scope.Or(api.EditScopeThis) {
+		case api.EditScopeThis:
+			promoted, err = skipOccurrence(ctx, tx, r)
+		case api.EditScopeThisAndFollowing:
+			series, promoted, err = truncateSeries(ctx, tx, series, r, now)
+		case api.EditScopeAll:
+			series, promoted, err = cancelSeries(ctx, tx, series.ID, now)
+		}
+		if err != nil {
+			return err
+		}
+
+		occurrences, err = tx.ListReservationsBySeriesIDs(ctx, []uuid.UUID{series.ID})
+		if err != nil {
+			return fmt.Errorf("failed to list series occurrences: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errReservationSeriesNotFound):
+		return (*api.ReservationSeriesOccurrenceSkipNotFound)(reservationSeriesNotFoundProblem()), nil
+	case errors.Is(err, errOccurrenceNotFound):
+		return (*api.ReservationSeriesOccurrenceSkipNotFound)(occurrenceNotFoundProblem()), nil
+	case errors.Is(err, errReservationSeriesCancelled):
+		return (*api.ReservationSeriesOccurrenceSkipConflict)(reservationSeriesCancelledProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationSeriesOccurrenceSkipConflict)(occurrenceCancelledProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, occurrences)
+	if err != nil {
+		return nil, err
+	}
+
+	skipped := toReservationSeries(series, occurrences, zones)
+	return &skipped, nil
+}
+
+// editOccurrence changes only the given occurrence, turning it into an exception of its series.
+func editOccurrence(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	r db.Reservation,
+	change occurrenceChange,
+) (seriesResult, error) {
+	// Changing only the details of an occurrence is not checked, like in ReservationsUpdate.
+	if reservationMoved(r, change.facility.ID, change.req.StartsAt, change.req.EndsAt) {
+		err := checkMovedReservationLocked(ctx, tx, r, change.facility.ID, change.req.StartsAt, change.req.EndsAt)
+		if err != nil {
+			return seriesResult{}, err
+		}
+	}
+
+	blockedStartsAt, blockedEndsAt := blockedPeriod(change.facility, change.req.StartsAt, change.req.EndsAt)
+	_, err := tx.UpdateReservation(ctx, db.UpdateReservationParams{
+		FacilityID:      change.facility.ID,
+		Title:           change.req.Title,
+		Description:     ptrOf(change.req.Description),
+		StartsAt:        change.req.StartsAt,
+		EndsAt:          change.req.EndsAt,
+		BlockedStartsAt: blockedStartsAt,
+		BlockedEndsAt:   blockedEndsAt,
+		Status:          r.Status,
+		AttendeeUserIds: nil,
+		AttendeeEmails:  nil,
+		ID:              r.ID,
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to update occurrence: %w", err)
+	}
+
+	occurrences, err := tx.ListReservationsBySeriesIDs(ctx, []uuid.UUID{series.ID})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to list series occurrences: %w", err)
+	}
+	return seriesResult{
+		series:      series,
+		occurrences: occurrences,
+		skipped:     make([]occurrence, 0),
+		total:       1,
+	}, nil
+}
+
+// splitSeries ends the series before the given occurrence and continues the remaining occurrences
+// as a new series starting with the changed occurrence.
+func splitSeries(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	r db.Reservation,
+	change occurrenceChange,
+) (seriesResult, error) {
+	if r.Period.Lower.Time.Before(change.now) {
+		return seriesResult{}, errOccurrenceStarted
+	}
+
+	loc, err := loadSeriesLocation(series.TimeZone)
+	if err != nil {
+		return seriesResult{}, err
+	}
+	at := *r.OriginalStartsAt
+	head, tail, before, err := splitRecurrence(series.Rrule, series.StartsAt, at, loc)
+	if err != nil {
+		return seriesResult{}, err
+	}
+	if before == 0 {
+		// Splitting at the first occurrence changes the whole series.
+		return shiftSeries(ctx, tx, series, r, change)
+	}
+
+	occurrences, err := expandRecurrence(tail, change.req.StartsAt, change.req.EndsAt, loc)
+	if err != nil {
+		return seriesResult{}, err
+	}
+
+	if err := tx.DeleteReservation(ctx, r.ID); err != nil {
+		return seriesResult{}, fmt.Errorf("failed to delete occurrence: %w", err)
+	}
+	series, err = tx.UpdateReservationSeries(ctx, db.UpdateReservationSeriesParams{
+		ID:          series.ID,
+		FacilityID:  series.FacilityID,
+		Title:       series.Title,
+		Description: series.Description,
+		Rrule:       head,
+		TimeZone:    series.TimeZone,
+		StartsAt:    series.StartsAt,
+		EndsAt:      series.EndsAt,
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to truncate reservation series: %w", err)
+	}
+	_, err = tx.DeleteSeriesReservationsFrom(ctx, db.DeleteSeriesReservationsFromParams{
+		SeriesID: series.ID,
+		From:     latest(at, change.now),
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to delete following occurrences: %w", err)
+	}
+
+	following, err := tx.CreateReservationSeries(ctx, db.CreateReservationSeriesParams{
+		ID:          uuid.Must(uuid.NewV7()),
+		FacilityID:  change.facility.ID,
+		UserID:      series.UserID,
+		Title:       change.req.Title,
+		Description: ptrOf(change.req.Description),
+		Rrule:       tail,
+		TimeZone:    series.TimeZone,
+		StartsAt:    change.req.StartsAt,
+		EndsAt:      change.req.EndsAt,
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to create following reservation series: %w", err)
+	}
+	_, err = tx.MoveSeriesExceptions(ctx, db.MoveSeriesExceptionsParams{
+		NewSeriesID: following.ID,
+		SeriesID:    series.ID,
+		From:        at,
+	})
+	if err != nil {
+		return seriesResult{}, fmt.Errorf("failed to move following exceptions: %w", err)
+	}
+
+	return regenerateOccurrences(ctx, tx, following, occurrences, change.mode, change.now)
+}
+
+// shiftSeries applies the change to every upcoming occurrence of the series.
+// The occurrences are moved by the wall-clock distance between the original and the requested start.
+func shiftSeries(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	r db.Reservation,
+	change occurrenceChange,
+) (seriesResult, error) {
+	if r.Period.Lower.Time.Before(change.now) {
+		return seriesResult{}, errOccurrenceStarted
+	}
+
+	loc, err := loadSeriesLocation(series.TimeZone)
+	if err != nil {
+		return seriesResult{}, err
+	}
+	startsAt := shiftWallClock(series.StartsAt, *r.OriginalStartsAt, change.req.StartsAt, loc)
+	endsAt := startsAt.Add(change.req.EndsAt.Sub(change.req.StartsAt))
+	occurrences, err := expandRecurrence(series.Rrule, startsAt, endsAt, loc)
+	if err != nil {
+		return seriesResult{}, err
+	}
+
+	// The selected occurrence is regenerated with the change even when it was an exception.
+	if err := tx.DeleteReservation(ctx, r.ID); err != nil {
+		return seriesResult{}, fmt.Errorf("failed to delete occurrence: %w", err)
+	}
+	return replaceUpcomingOccurrences(ctx, tx, db.UpdateReservationSeriesParams{
+		ID:          series.ID,
+		FacilityID:  change.facility.ID,
+		Title:       change.req.Title,
+		Description: ptrOf(change.req.Description),
+		Rrule:       series.Rrule,
+		TimeZone:    series.TimeZone,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+	}, occurrences, change.mode, change.now)
+}
+
+// skipOccurrence cancels only the given occurrence and promotes the waitlist entries fitting its period.
+// It returns the promoted entries.
+func skipOccurrence(ctx context.Context, tx *Transaction, r db.Reservation) ([]db.WaitlistEntry, error) {
+	cancelled, err := tx.CancelReservation(ctx, r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel occurrence: %w", err)
+	}
+	return promoteWaitlist(ctx, tx, cancelled)
+}
+
+// truncateSeries ends the series before the given occurrence and cancels the occurrences from it,
+// promoting the waitlist entries fitting the released periods. It returns the promoted entries.
+// Truncating at the first occurrence cancels the whole series.
+func truncateSeries(
+	ctx context.Context,
+	tx *Transaction,
+	series db.ReservationSeries,
+	r db.Reservation,
+	now time.Time,
+) (db.ReservationSeries, []db.WaitlistEntry, error) {
+	loc, err := loadSeriesLocation(series.TimeZone)
+	if err != nil {
+		return db.ReservationSeries{}, nil, err
+	}
+	at := *r.OriginalStartsAt
+	head, _, before, err := splitRecurrence(series.Rrule, series.StartsAt, at, loc)
+	if err != nil {
+		return db.ReservationSeries{}, nil, err
+	}
+	if before == 0 {
+		return cancelSeries(ctx, tx, series.ID, now)
+	}
+
+	series, err = tx.UpdateReservationSeries(ctx, db.UpdateReservationSeriesParams{
+		ID:          series.ID,
+		FacilityID:  series.FacilityID,
+		Title:       series.Title,
+		Description: series.Description,
+		Rrule:       head,
+		TimeZone:    series.TimeZone,
+		StartsAt:    series.StartsAt,
+		EndsAt:      series.EndsAt,
+	})
+	if err != nil {
+		return db.ReservationSeries{}, nil, fmt.Errorf("failed to truncate reservation series: %w", err)
+	}
+	cancelled, err := tx.CancelSeriesReservationsFrom(ctx, db.CancelSeriesReservationsFromParams{
+		SeriesID: series.ID,
+		From:     latest(at, now),
+	})
+	if err != nil {
+		return db.ReservationSeries{}, nil, fmt.Errorf("failed to cancel following occurrences: %w", err)
+	}
+	promoted, err := promoteWaitlists(ctx, tx, cancelled)
+	if err != nil {
+		return db.ReservationSeries{}, nil, err
+	}
+	return series, promoted, nil
+}
+
+// occurrenceUpdateFailure converts an error returned by the transaction of an occurrence update
+// into its response.
+func occurrenceUpdateFailure(err error, result seriesResult) (api.ReservationSeriesOccurrenceUpdateRes, error) {
+	var (
+		blackoutErr *blackoutConflictError
+		policyErr   *bookingPolicyError
+		quotaErr    *quotaExceededError
+	)
+	switch {
+	case errors.Is(err, errReservationSeriesNotFound):
+		return (*api.ReservationSeriesOccurrenceUpdateNotFound)(reservationSeriesNotFoundProblem()), nil
+	case errors.Is(err, errOccurrenceNotFound):
+		return (*api.ReservationSeriesOccurrenceUpdateNotFound)(occurrenceNotFoundProblem()), nil
+	case errors.Is(err, errReservationSeriesCancelled):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(reservationSeriesCancelledProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(occurrenceCancelledProblem()), nil
+	case errors.Is(err, errOccurrenceStarted):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(occurrenceStartedProblem()), nil
+	case errors.Is(err, errSeriesConflict):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(seriesConflictProblem(result)), nil
+	case isExclusionViolation(err):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(reservationConflictProblem()), nil
+	case errors.Is(err, errInvalidRecurrence):
+		problem := newProblem(http.StatusBadRequest, "The change leaves the series without valid occurrences.")
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(problem), nil
+	case errors.As(err, &policyErr):
+		problem := bookingPolicyViolationProblem(policyErr.violations)
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(problem), nil
+	case errors.As(err, &quotaErr):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.Is(err, errOutsideOpeningHours):
+		return (*api.ReservationSeriesOccurrenceUpdateBadRequest)(outsideOpeningHoursProblem()), nil
+	case errors.As(err, &blackoutErr):
+		return (*api.ReservationSeriesOccurrenceUpdateConflict)(blackoutConflictProblem(blackoutErr.period)), nil
+	default:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+}
+
+// lockSeriesOccurrence locks a confirmed occurrence of the series for modification.
+// It returns errOccurrenceNotFound or errReservationCancelled when the occurrence cannot be modified.
+func lockSeriesOccurrence(
+	ctx context.Context,
+	tx *Transaction,
+	seriesID uuid.UUID,
+	id uuid.UUID,
+) (db.Reservation, error) {
+	r, err := tx.GetReservationByIDForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Reservation{}, errOccurrenceNotFound
+	}
+	if err != nil {
+		return db.Reservation{}, fmt.Errorf("failed to get occurrence: %w", err)
+	}
+	if r.SeriesID == nil || *r.SeriesID != seriesID || r.OriginalStartsAt == nil {
+		return db.Reservation{}, errOccurrenceNotFound
+	}
+	if r.Status == db.ReservationStatusCancelled {
+		return db.Reservation{}, errReservationCancelled
+	}
+	return r, nil
+}
+
+// latest returns the later of a and b.
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func occurrenceNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Occurrence not found.")
+}
+
+func occurrenceCancelledProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The occurrence has already been skipped or cancelled.")
+}
+
+func occurrenceStartedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "Only upcoming occurrences can be changed with this scope.")
+}
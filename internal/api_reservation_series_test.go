@@ -0,0 +1,406 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestReservationSeriesValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	startsAt := time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)
+	newInput := func(rule, timeZone string) *api.ReservationSeriesInput {
+		return &api.ReservationSeriesInput{
+			FacilityID: 1,
+			Title:      "Weekly sync",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+			Rrule:      rule,
+			TimeZone:   api.NewOptString(timeZone),
+		}
+	}
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationSeriesList(t.Context())
+		require.NoError(t, err)
+		assert.IsType(t, &api.ProblemDetails{}, res)
+	})
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCreate(t.Context(), &api.ReservationSeriesInput{},
+			api.ReservationSeriesCreateParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCreateUnauthorized{}, res)
+	})
+
+	tests := []struct {
+		name  string
+		input *api.ReservationSeriesInput
+	}{
+		{"empty period", &api.ReservationSeriesInput{
+			FacilityID: 1,
+			Title:      "Weekly sync",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt,
+			Rrule:      "FREQ=WEEKLY;COUNT=4",
+			TimeZone:   api.NewOptString("UTC"),
+		}},
+		{"malformed rule", newInput("FREQ=SOMETIMES", "UTC")},
+		{"unbounded rule", newInput("FREQ=WEEKLY;BYDAY=MO", "UTC")},
+		{"sub-daily rule", newInput("FREQ=HOURLY;COUNT=3", "UTC")},
+		{"rule with DTSTART", newInput("DTSTART:20300107T100000Z\nRRULE:FREQ=DAILY;COUNT=2", "UTC")},
+		{"too many occurrences", newInput("FREQ=DAILY;COUNT=1000", "UTC")},
+		{"unknown time zone", newInput("FREQ=WEEKLY;COUNT=4", "Mars/Olympus_Mons")},
+		{"local time zone", newInput("FREQ=WEEKLY;COUNT=4", "Local")},
+	}
+	for _, tt := range tests {
+		t.Run("create rejects "+tt.name, func(t *testing.T) {
+			res, err := svc.ReservationSeriesCreate(userCtx, tt.input, api.ReservationSeriesCreateParams{})
+			require.NoError(t, err)
+			assert.IsType(t, &api.ReservationSeriesCreateBadRequest{}, res)
+		})
+	}
+
+	t.Run("update rejects malformed rule", func(t *testing.T) {
+		res, err := svc.ReservationSeriesUpdate(userCtx, newInput("FREQ=WEEKLY;COUNT=x", "UTC"),
+			api.ReservationSeriesUpdateParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesUpdateBadRequest{}, res)
+	})
+
+	t.Run("cancel rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCancel(t.Context(),
+			api.ReservationSeriesCancelParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCancelUnauthorized{}, res)
+	})
+
+	t.Run("occurrence update rejects reversed period", func(t *testing.T) {
+		res, err := svc.ReservationSeriesOccurrenceUpdate(userCtx, &api.ReservationInput{
+			FacilityID: 1,
+			Title:      "Weekly sync",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(-time.Hour),
+		}, api.ReservationSeriesOccurrenceUpdateParams{
+			ID:           uuid.Must(uuid.NewV7()),
+			OccurrenceID: uuid.Must(uuid.NewV7()),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesOccurrenceUpdateBadRequest{}, res)
+	})
+
+	t.Run("occurrence update rejects attendees", func(t *testing.T) {
+		input := newInput("FREQ=WEEKLY;COUNT=4", "UTC")
+		res, err := svc.ReservationSeriesOccurrenceUpdate(userCtx, &api.ReservationInput{
+			FacilityID: input.FacilityID,
+			Title:      input.Title,
+			StartsAt:   input.StartsAt,
+			EndsAt:     input.EndsAt,
+			Attendees: []api.ReservationAttendee{
+				{UserID: api.OptUUID{}, Email: api.NewOptEmailString("guest@example.com")},
+			},
+		}, api.ReservationSeriesOccurrenceUpdateParams{
+			ID:           uuid.Must(uuid.NewV7()),
+			OccurrenceID: uuid.Must(uuid.NewV7()),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesOccurrenceUpdateBadRequest{}, res)
+	})
+
+	t.Run("occurrence skip rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationSeriesOccurrenceSkip(t.Context(), api.ReservationSeriesOccurrenceSkipParams{
+			ID:           uuid.Must(uuid.NewV7()),
+			OccurrenceID: uuid.Must(uuid.NewV7()),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesOccurrenceSkipUnauthorized{}, res)
+	})
+}
+
+func TestReservationSeriesLifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUser := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	owner := newUser(t)
+	other := newUser(t)
+	ownerCtx := internal.WithAuthenticatedUser(ctx, owner)
+	otherCtx := internal.WithAuthenticatedUser(ctx, other)
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	startsAt := time.Now().In(tokyo).AddDate(0, 0, 7).Truncate(time.Hour)
+
+	// Occupy the third weekly occurrence so that the series conflicts with it.
+	blockingRes, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Blocking",
+		StartsAt:   startsAt.AddDate(0, 0, 14),
+		EndsAt:     startsAt.AddDate(0, 0, 14).Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.IsType(t, &api.Reservation{}, blockingRes)
+
+	input := &api.ReservationSeriesInput{
+		FacilityID: facility.ID,
+		Title:      "Weekly sync",
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(time.Hour),
+		Rrule:      "FREQ=WEEKLY;COUNT=4",
+		TimeZone:   api.NewOptString("Asia/Tokyo"),
+	}
+
+	t.Run("create rejects conflicting occurrences by default", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCreate(ownerCtx, input, api.ReservationSeriesCreateParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCreateConflict{}, res)
+
+		listRes, err := svc.ReservationSeriesList(ownerCtx)
+		require.NoError(t, err)
+		list, ok := listRes.(*api.ReservationSeriesListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		assert.Empty(t, *list)
+	})
+
+	res, err := svc.ReservationSeriesCreate(ownerCtx, input, api.ReservationSeriesCreateParams{
+		ConflictMode: api.NewOptConflictMode(api.ConflictModeSkip),
+	})
+	require.NoError(t, err)
+	created, ok := res.(*api.ReservationSeriesWithSkipped)
+	require.True(t, ok, "unexpected response %T", res)
+	require.Len(t, created.Occurrences, 3)
+	require.Len(t, created.Skipped, 1)
+	assert.True(t, startsAt.AddDate(0, 0, 14).Equal(created.Skipped[0].StartsAt))
+	for _, o := range created.Occurrences {
+		assert.Equal(t, api.NewOptUUID(created.ID), o.SeriesID)
+		assert.Equal(t, startsAt.Hour(), o.StartsAt.In(tokyo).Hour())
+	}
+
+	t.Run("retrieve hides series of other users", func(t *testing.T) {
+		res, err := svc.ReservationSeriesRetrieve(otherCtx, api.ReservationSeriesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesRetrieveNotFound{}, res)
+
+		res, err = svc.ReservationSeriesRetrieve(staffCtx, api.ReservationSeriesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		found, ok := res.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, found.Occurrences, 3)
+	})
+
+	t.Run("update regenerates upcoming occurrences", func(t *testing.T) {
+		moved := *input
+		moved.StartsAt = startsAt.Add(2 * time.Hour)
+		moved.EndsAt = startsAt.Add(3 * time.Hour)
+		moved.Rrule = "FREQ=WEEKLY;COUNT=2"
+
+		res, err := svc.ReservationSeriesUpdate(ownerCtx, &moved, api.ReservationSeriesUpdateParams{
+			ID:           created.ID,
+			ConflictMode: api.NewOptConflictMode(api.ConflictModeReject),
+		})
+		require.NoError(t, err)
+		updated, ok := res.(*api.ReservationSeriesWithSkipped)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, updated.Occurrences, 2)
+		assert.Empty(t, updated.Skipped)
+		assert.True(t, moved.StartsAt.Equal(updated.Occurrences[0].StartsAt))
+	})
+
+	t.Run("cancel releases upcoming occurrences", func(t *testing.T) {
+		res, err := svc.ReservationSeriesCancel(ownerCtx, api.ReservationSeriesCancelParams{ID: created.ID})
+		require.NoError(t, err)
+		cancelled, ok := res.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusCancelled, cancelled.Status)
+		assert.Empty(t, cancelled.Occurrences)
+
+		res, err = svc.ReservationSeriesCancel(ownerCtx, api.ReservationSeriesCancelParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesCancelConflict{}, res)
+	})
+}
+
+func TestReservationSeriesOccurrences(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().AddDate(0, 0, 7).Truncate(time.Hour)
+	createRes, err := svc.ReservationSeriesCreate(staffCtx, &api.ReservationSeriesInput{
+		FacilityID: facility.ID,
+		Title:      "Weekly sync",
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(time.Hour),
+		Rrule:      "FREQ=WEEKLY;COUNT=6",
+		TimeZone:   api.NewOptString("UTC"),
+	}, api.ReservationSeriesCreateParams{})
+	require.NoError(t, err)
+	created, ok := createRes.(*api.ReservationSeriesWithSkipped)
+	require.True(t, ok, "unexpected response %T", createRes)
+	require.Len(t, created.Occurrences, 6)
+	occurrences := created.Occurrences
+
+	input := func(startsAt time.Time, title string) *api.ReservationInput {
+		return &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      title,
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		}
+	}
+	update := func(t *testing.T, seriesID uuid.UUID, o api.Reservation, in *api.ReservationInput,
+		scope api.EditScope,
+	) *api.ReservationSeriesWithSkipped {
+		t.Helper()
+		res, err := svc.ReservationSeriesOccurrenceUpdate(staffCtx, in, api.ReservationSeriesOccurrenceUpdateParams{
+			ID:           seriesID,
+			OccurrenceID: o.ID,
+			Scope:        api.NewOptEditScope(scope),
+		})
+		require.NoError(t, err)
+		series, ok := res.(*api.ReservationSeriesWithSkipped)
+		require.True(t, ok, "unexpected response %T", res)
+		return series
+	}
+
+	t.Run("skip this occurrence", func(t *testing.T) {
+		res, err := svc.ReservationSeriesOccurrenceSkip(staffCtx, api.ReservationSeriesOccurrenceSkipParams{
+			ID:           created.ID,
+			OccurrenceID: occurrences[1].ID,
+		})
+		require.NoError(t, err)
+		series, ok := res.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, series.Occurrences, 5)
+
+		res, err = svc.ReservationSeriesOccurrenceSkip(staffCtx, api.ReservationSeriesOccurrenceSkipParams{
+			ID:           created.ID,
+			OccurrenceID: occurrences[1].ID,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesOccurrenceSkipConflict{}, res)
+	})
+
+	t.Run("move this occurrence", func(t *testing.T) {
+		moved := occurrences[2].StartsAt.Add(3 * time.Hour)
+		series := update(t, created.ID, occurrences[2], input(moved, "Moved sync"), api.EditScopeThis)
+		require.Len(t, series.Occurrences, 5)
+		// The skipped second occurrence is gone, so the moved third one comes second.
+		assert.True(t, moved.Equal(series.Occurrences[1].StartsAt))
+		assert.True(t, series.Occurrences[1].IsException)
+		assert.Equal(t, api.NewOptDateTime(occurrences[2].StartsAt), series.Occurrences[1].OriginalStartsAt)
+	})
+
+	t.Run("edit all keeps exceptions", func(t *testing.T) {
+		series := update(t, created.ID, occurrences[3], input(occurrences[3].StartsAt, "Renamed sync"),
+			api.EditScopeAll)
+		require.Len(t, series.Occurrences, 5)
+		assert.Equal(t, "Renamed sync", series.Occurrences[0].Title)
+		assert.Equal(t, "Moved sync", series.Occurrences[1].Title)
+	})
+
+	var following *api.ReservationSeriesWithSkipped
+	t.Run("edit this and following splits the series", func(t *testing.T) {
+		current, err := svc.ReservationSeriesRetrieve(staffCtx, api.ReservationSeriesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		series, ok := current.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", current)
+
+		// Split at the fourth occurrence, the third one in the list.
+		later := series.Occurrences[2].StartsAt.Add(2 * time.Hour)
+		following = update(t, created.ID, series.Occurrences[2], input(later, "Later sync"),
+			api.EditScopeThisAndFollowing)
+		assert.NotEqual(t, created.ID, following.ID)
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=3", following.Rrule)
+		require.Len(t, following.Occurrences, 3)
+		assert.True(t, later.Equal(following.Occurrences[0].StartsAt))
+
+		res, err := svc.ReservationSeriesRetrieve(staffCtx, api.ReservationSeriesRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		truncated, ok := res.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Len(t, truncated.Occurrences, 2)
+	})
+
+	t.Run("skip this and following truncates the series", func(t *testing.T) {
+		require.NotNil(t, following)
+		res, err := svc.ReservationSeriesOccurrenceSkip(staffCtx, api.ReservationSeriesOccurrenceSkipParams{
+			ID:           following.ID,
+			OccurrenceID: following.Occurrences[1].ID,
+			Scope:        api.NewOptEditScope(api.EditScopeThisAndFollowing),
+		})
+		require.NoError(t, err)
+		series, ok := res.(*api.ReservationSeries)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusConfirmed, series.Status)
+		assert.Len(t, series.Occurrences, 1)
+	})
+
+	t.Run("rejects occurrences of other series", func(t *testing.T) {
+		require.NotNil(t, following)
+		res, err := svc.ReservationSeriesOccurrenceSkip(staffCtx, api.ReservationSeriesOccurrenceSkipParams{
+			ID:           created.ID,
+			OccurrenceID: following.Occurrences[0].ID,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationSeriesOccurrenceSkipNotFound{}, res)
+	})
+}
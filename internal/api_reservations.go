@@ -0,0 +1,640 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+var (
+	// errReservationNotFound is returned inside transactions when the reservation is missing
+	// or not visible to the caller.
+	errReservationNotFound = errors.New("reservation not found")
+	// errReservationCancelled is returned inside transactions when the reservation was already cancelled.
+	errReservationCancelled = errors.New("reservation is cancelled")
+	// errReservationClosed is returned inside transactions when the reservation request was rejected or expired,
+	// or the reservation was released as a no-show.
+	errReservationClosed = errors.New("reservation request is closed")
+)
+
+// ReservationsList returns reservations ordered by their start time.
+// Staff users see all reservations, other users their own, those of the users who granted them delegation
+// and those they attend. Cancelled, rejected and expired reservations are only included on request.
+func (s *APIService) ReservationsList(
+	ctx context.Context,
+	params api.ReservationsListParams,
+) (res api.ReservationsListRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsList(ctx, params)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsListUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	arg := db.ListReservationsParams{
+		UserID:           nil,
+		FacilityID:       nil,
+		From:             ptrOf(params.From),
+		To:               ptrOf(params.To),
+		IncludeCancelled: params.IncludeCancelled.Or(false),
+	}
+	if arg.From != nil && arg.To != nil && !arg.From.Before(*arg.To) {
+		problem := newProblem(http.StatusBadRequest, "from must be before to.")
+		return (*api.ReservationsListBadRequest)(problem), nil
+	}
+	if v, ok := params.FacilityID.Get(); ok {
+		facilityID, ok := toFacilityID(v)
+		if !ok {
+			return (*api.ReservationsListBadRequest)(facilityUnavailableProblem()), nil
+		}
+		arg.FacilityID = &facilityID
+	}
+	if !caller.IsStaff {
+		userID, err := uuid.Parse(caller.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+		}
+		arg.UserID = &userID
+	}
+
+	reservations, err := s.ds.ListReservations(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+
+	zones, err := reservationTimeZones(ctx, s.ds, reservations)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make(api.ReservationsListOKApplicationJSON, 0, len(reservations))
+	for _, r := range reservations {
+		list = append(list, toReservation(r, zones[r.FacilityID]))
+	}
+	return &list, nil
+}
+
+// ReservationsCreate reserves a facility within its opening hours for the authenticated user, or on behalf of
+// the user given in the request when the caller is a staff user or a delegate of that user.
+// Every rule of the booking policy of the facility the period violates is reported with 400 Bad Request,
+// as are attendees exceeding the capacity of the facility together with the owner.
+// Periods overlapping a blackout or confirmed reservations, the latter detected by the database
+// including the setup and teardown buffers of the facility, and reservations exceeding a booking quota
+// of the owner are rejected with 409 Conflict.
+// Reservations of facilities requiring approval are created as pending requests unless made by staff users.
+func (s *APIService) ReservationsCreate(
+	ctx context.Context,
+	req *api.ReservationInput,
+) (res api.ReservationsCreateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsCreate(ctx, req)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	if problem := validateReservationPeriod(req); problem != nil {
+		return (*api.ReservationsCreateBadRequest)(problem), nil
+	}
+
+	userID, bookedBy, err := reservationOwner(ctx, s.ds, caller, req.UserID)
+	switch {
+	case errors.Is(err, errDelegationRequired):
+		return (*api.ReservationsCreateForbidden)(delegationRequiredProblem()), nil
+	case errors.Is(err, errOwnerNotFound):
+		return (*api.ReservationsCreateBadRequest)(ownerNotFoundProblem()), nil
+	case err != nil:
+		return nil, err
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.ReservationsCreateBadRequest)(facilityUnavailableProblem()), nil
+	}
+
+	problem, found, err := reservationPeriodProblem(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if found && isConflictProblem(problem) {
+		return (*api.ReservationsCreateConflict)(problem), nil
+	}
+	if found {
+		return (*api.ReservationsCreateBadRequest)(problem), nil
+	}
+
+	var reservation db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		attendees, err := resolveAttendees(ctx, tx, facility, userID, req.Attendees)
+		if err != nil {
+			return err
+		}
+		err = checkReservationLocked(ctx, tx, userID, facility.ID, req.StartsAt, req.EndsAt, nil)
+		if err != nil {
+			return err
+		}
+
+		blockedStartsAt, blockedEndsAt := blockedPeriod(facility, req.StartsAt, req.EndsAt)
+		reservation, err = tx.CreateReservation(ctx, db.CreateReservationParams{
+			ID:              uuid.Must(uuid.NewV7()),
+			FacilityID:      facility.ID,
+			UserID:          userID,
+			Title:           req.Title,
+			Description:     ptrOf(req.Description),
+			StartsAt:        req.StartsAt,
+			EndsAt:          req.EndsAt,
+			BlockedStartsAt: blockedStartsAt,
+			BlockedEndsAt:   blockedEndsAt,
+			Status:          reservationStatus(caller, facility, nil, req.StartsAt, req.EndsAt),
+			BookedBy:        bookedBy,
+			AttendeeUserIds: attendees.userIDs,
+			AttendeeEmails:  attendees.emails,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create reservation: %w", err)
+		}
+		return nil
+	})
+	var (
+		attendeesErr *invalidAttendeesError
+		quotaErr     *quotaExceededError
+		blackoutErr  *blackoutConflictError
+	)
+	switch {
+	case errors.As(err, &attendeesErr):
+		return (*api.ReservationsCreateBadRequest)(invalidAttendeesProblem(attendeesErr)), nil
+	case errors.As(err, &quotaErr):
+		return (*api.ReservationsCreateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.As(err, &blackoutErr):
+		return (*api.ReservationsCreateConflict)(blackoutConflictProblem(blackoutErr.period)), nil
+	case isExclusionViolation(err):
+		return (*api.ReservationsCreateConflict)(reservationConflictProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	created := toReservation(reservation, loc)
+	return &created, nil
+}
+
+// ReservationsRetrieve returns a single reservation. Holds are not reservations until they are confirmed.
+// Only its owner, their delegates, its attendees and staff users are allowed.
+func (s *APIService) ReservationsRetrieve(
+	ctx context.Context,
+	params api.ReservationsRetrieveParams,
+) (res api.ReservationsRetrieveRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsRetrieve(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsRetrieveUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	reservation, err := s.ds.GetReservationByID(ctx, params.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return (*api.ReservationsRetrieveNotFound)(reservationNotFoundProblem()), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	allowed, err := canActFor(ctx, s.ds, caller, reservation.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if (!allowed && !isAttendee(caller, reservation)) || reservation.Status == db.ReservationStatusHeld {
+		return (*api.ReservationsRetrieveNotFound)(reservationNotFoundProblem()), nil
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	found := toReservation(reservation, loc)
+	return &found, nil
+}
+
+// ReservationsUpdate replaces the facility, period, details and attendees of a confirmed or pending reservation.
+// A moved reservation must satisfy the booking policy of the facility, lie within its opening hours
+// and outside its blackouts, and keep the owner within their booking quotas. Changing only its details
+// or attendees is not checked against them, so reservations made before a rule changed remain editable.
+// Moving a reservation of a facility requiring approval turns it into a pending request again
+// unless done by a staff user. Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationsUpdate(
+	ctx context.Context,
+	req *api.ReservationInput,
+	params api.ReservationsUpdateParams,
+) (res api.ReservationsUpdateRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsUpdate(ctx, req, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsUpdateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	if problem := validateReservationPeriod(req); problem != nil {
+		return (*api.ReservationsUpdateBadRequest)(problem), nil
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.ReservationsUpdateBadRequest)(facilityUnavailableProblem()), nil
+	}
+
+	var reservation db.Reservation
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		current, err := lockActiveReservation(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+		attendees, err := resolveAttendees(ctx, tx, facility, current.UserID, req.Attendees)
+		if err != nil {
+			return err
+		}
+		if reservationMoved(current, facility.ID, req.StartsAt, req.EndsAt) {
+			err = checkMovedReservationLocked(ctx, tx, current, facility.ID, req.StartsAt, req.EndsAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		blockedStartsAt, blockedEndsAt := blockedPeriod(facility, req.StartsAt, req.EndsAt)
+		reservation, err = tx.UpdateReservation(ctx, db.UpdateReservationParams{
+			FacilityID:      facility.ID,
+			Title:           req.Title,
+			Description:     ptrOf(req.Description),
+			StartsAt:        req.StartsAt,
+			EndsAt:          req.EndsAt,
+			BlockedStartsAt: blockedStartsAt,
+			BlockedEndsAt:   blockedEndsAt,
+			Status:          reservationStatus(caller, facility, &current, req.StartsAt, req.EndsAt),
+			AttendeeUserIds: attendees.userIDs,
+			AttendeeEmails:  attendees.emails,
+			ID:              params.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update reservation: %w", err)
+		}
+		return nil
+	})
+	var (
+		attendeesErr *invalidAttendeesError
+		policyErr    *bookingPolicyError
+		quotaErr     *quotaExceededError
+		blackoutErr  *blackoutConflictError
+	)
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.ReservationsUpdateNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationsUpdateConflict)(reservationCancelledProblem()), nil
+	case errors.Is(err, errReservationClosed):
+		return (*api.ReservationsUpdateConflict)(reservationClosedProblem()), nil
+	case errors.As(err, &attendeesErr):
+		return (*api.ReservationsUpdateBadRequest)(invalidAttendeesProblem(attendeesErr)), nil
+	case errors.As(err, &policyErr):
+		return (*api.ReservationsUpdateBadRequest)(bookingPolicyViolationProblem(policyErr.violations)), nil
+	case errors.Is(err, errOutsideOpeningHours):
+		return (*api.ReservationsUpdateBadRequest)(outsideOpeningHoursProblem()), nil
+	case errors.As(err, &quotaErr):
+		return (*api.ReservationsUpdateConflict)(quotaExceededProblem(quotaErr)), nil
+	case errors.As(err, &blackoutErr):
+		return (*api.ReservationsUpdateConflict)(blackoutConflictProblem(blackoutErr.period)), nil
+	case isExclusionViolation(err):
+		return (*api.ReservationsUpdateConflict)(reservationConflictProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := toReservation(reservation, loc)
+	return &updated, nil
+}
+
+// ReservationsCancel cancels a confirmed or pending reservation, releasing its period for other reservations.
+// Waitlist entries fitting the released period are promoted to reservations in the same transaction,
+// and their users are notified once it commits. Only its owner, their delegates and staff users are allowed.
+func (s *APIService) ReservationsCancel(
+	ctx context.Context,
+	params api.ReservationsCancelParams,
+) (res api.ReservationsCancelRes, err error) {
+	defer derrors.Wrap(&err, "ReservationsCancel(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.ReservationsCancelUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	var (
+		reservation db.Reservation
+		promoted    []db.WaitlistEntry
+	)
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		_, err := lockActiveReservation(ctx, tx, caller, params.ID)
+		if err != nil {
+			return err
+		}
+
+		reservation, err = tx.CancelReservation(ctx, params.ID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel reservation: %w", err)
+		}
+		promoted, err = promoteWaitlist(ctx, tx, reservation)
+		return err
+	})
+	switch {
+	case errors.Is(err, errReservationNotFound):
+		return (*api.ReservationsCancelNotFound)(reservationNotFoundProblem()), nil
+	case errors.Is(err, errReservationCancelled):
+		return (*api.ReservationsCancelConflict)(reservationCancelledProblem()), nil
+	case errors.Is(err, errReservationClosed):
+		return (*api.ReservationsCancelConflict)(reservationClosedProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	loc, err := facilityTimeZone(ctx, s.ds, reservation.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelled := toReservation(reservation, loc)
+	return &cancelled, nil
+}
+
+// reservableFacility returns the facility identified by an API facility ID.
+// It reports false when the facility does not exist or is inactive.
+func (s *APIService) reservableFacility(ctx context.Context, id int) (db.Facility, bool, error) {
+	facilityID, ok := toFacilityID(id)
+	if !ok {
+		return db.Facility{}, false, nil
+	}
+
+	facility, err := s.ds.GetFacilityByID(ctx, facilityID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Facility{}, false, nil
+	}
+	if err != nil {
+		return db.Facility{}, false, fmt.Errorf("failed to get facility: %w", err)
+	}
+	return facility, facility.IsActive, nil
+}
+
+// blockedPeriod returns the period for which a reservation of [startsAt, endsAt) blocks the facility,
+// widened by the setup and teardown buffers of the facility.
+func blockedPeriod(f db.Facility, startsAt, endsAt time.Time) (blockedStartsAt, blockedEndsAt time.Time) {
+	return startsAt.Add(-time.Duration(f.SetupBufferMinutes) * time.Minute),
+		endsAt.Add(time.Duration(f.TeardownBufferMinutes) * time.Minute)
+}
+
+// lockActiveReservation locks a confirmed or pending reservation visible to the caller for modification
+// and returns it. It returns errReservationNotFound, errReservationCancelled or errReservationClosed
+// when the reservation cannot be modified.
+func lockActiveReservation(
+	ctx context.Context,
+	tx *Transaction,
+	caller *AuthenticatedUser,
+	id uuid.UUID,
+) (db.Reservation, error) {
+	reservation, err := tx.GetReservationByIDForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Reservation{}, errReservationNotFound
+	}
+	if err != nil {
+		return db.Reservation{}, fmt.Errorf("failed to get reservation: %w", err)
+	}
+	allowed, err := canActFor(ctx, tx, caller, reservation.UserID)
+	if err != nil {
+		return db.Reservation{}, err
+	}
+	if !allowed {
+		return db.Reservation{}, errReservationNotFound
+	}
+	switch reservation.Status {
+	case db.ReservationStatusHeld:
+		return db.Reservation{}, errReservationNotFound
+	case db.ReservationStatusCancelled:
+		return db.Reservation{}, errReservationCancelled
+	case db.ReservationStatusRejected, db.ReservationStatusExpired, db.ReservationStatusNoShow:
+		return db.Reservation{}, errReservationClosed
+	case db.ReservationStatusConfirmed, db.ReservationStatusPending:
+	}
+	return reservation, nil
+}
+
+// reservationStatus returns the status of a reservation of [startsAt, endsAt) of the facility made by the caller,
+// replacing current unless it is nil. Reservations of facilities requiring approval are pending requests
+// unless made by staff users, and an existing reservation keeps its status as long as it is not moved.
+func reservationStatus(
+	caller *AuthenticatedUser,
+	facility db.Facility,
+	current *db.Reservation,
+	startsAt, endsAt time.Time,
+) db.ReservationStatus {
+	if !facility.RequiresApproval {
+		return db.ReservationStatusConfirmed
+	}
+	if current != nil && (caller.IsStaff || !reservationMoved(*current, facility.ID, startsAt, endsAt)) {
+		return current.Status
+	}
+	if caller.IsStaff {
+		return db.ReservationStatusConfirmed
+	}
+	return db.ReservationStatusPending
+}
+
+// canAccessReservation reports whether the caller owns the reservation or is a staff user.
+// Holds are personal, so delegation grants are not honoured here.
+func canAccessReservation(caller *AuthenticatedUser, r db.Reservation) bool {
+	return caller.IsStaff || caller.ID == r.UserID.String()
+}
+
+// validateReservationPeriod returns a problem when the requested period is empty or reversed.
+func validateReservationPeriod(req *api.ReservationInput) *api.ProblemDetails {
+	if !req.StartsAt.Before(req.EndsAt) {
+		return newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+	}
+	return nil
+}
+
+// reservationPeriodProblem returns the problem with reserving [startsAt, endsAt) of the facility and reports
+// whether there is one: the rules of its booking policy the period violates or the period lying outside
+// its opening hours with 400 Bad Request, and the first of its blackouts the period overlaps with 409 Conflict.
+func reservationPeriodProblem(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+) (*api.ProblemDetails, bool, error) {
+	violations, err := evaluateBookingPolicy(ctx, q, facilityID, startsAt, endsAt)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(violations) > 0 {
+		return bookingPolicyViolationProblem(violations), true, nil
+	}
+
+	isOpen, err := withinOpeningHours(ctx, q, facilityID, startsAt, endsAt)
+	if err != nil {
+		return nil, false, err
+	}
+	if !isOpen {
+		return outsideOpeningHoursProblem(), true, nil
+	}
+	blackout, found, err := findBlackout(ctx, q, facilityID, startsAt, endsAt)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		return blackoutConflictProblem(blackout), true, nil
+	}
+	return nil, false, nil
+}
+
+// checkReservationLocked checks a reservation of [startsAt, endsAt) of the facility inside the transaction
+// writing it: the user must stay within their booking quotas, returning a *quotaExceededError otherwise,
+// and the period must not overlap a blackout, returning a *blackoutConflictError otherwise.
+// excludeID identifies the reservation being replaced, if any. Expired holds of the facility are deleted
+// so that only live reservations can conflict with it.
+func checkReservationLocked(
+	ctx context.Context,
+	tx *Transaction,
+	userID uuid.UUID,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+	excludeID *uuid.UUID,
+) error {
+	if err := enforceBookingQuotas(ctx, tx, userID, facilityID, startsAt, endsAt, excludeID); err != nil {
+		return err
+	}
+	if err := checkBlackoutsLocked(ctx, tx, facilityID, startsAt, endsAt); err != nil {
+		return err
+	}
+	return deleteExpiredHolds(ctx, tx, facilityID)
+}
+
+// checkMovedReservationLocked checks moving the reservation to [startsAt, endsAt) of the facility inside
+// the transaction writing it: the period must satisfy the booking policy of the facility, returning
+// a *bookingPolicyError otherwise, and lie within its opening hours, returning errOutsideOpeningHours
+// otherwise, before it is checked like by checkReservationLocked.
+func checkMovedReservationLocked(
+	ctx context.Context,
+	tx *Transaction,
+	r db.Reservation,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+) error {
+	violations, err := evaluateBookingPolicy(ctx, tx, facilityID, startsAt, endsAt)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return &bookingPolicyError{violations: violations}
+	}
+	isOpen, err := withinOpeningHours(ctx, tx, facilityID, startsAt, endsAt)
+	if err != nil {
+		return err
+	}
+	if !isOpen {
+		return errOutsideOpeningHours
+	}
+	return checkReservationLocked(ctx, tx, r.UserID, facilityID, startsAt, endsAt, &r.ID)
+}
+
+// reservationMoved reports whether replacing the reservation with one of [startsAt, endsAt) of the facility
+// changes the period it occupies.
+func reservationMoved(r db.Reservation, facilityID int32, startsAt, endsAt time.Time) bool {
+	return r.FacilityID != facilityID || !r.Period.Lower.Time.Equal(startsAt) || !r.Period.Upper.Time.Equal(endsAt)
+}
+
+// reservationTimeZones returns the time zones of the facilities of the reservations.
+func reservationTimeZones(
+	ctx context.Context,
+	q db.Querier,
+	reservations []db.Reservation,
+) (map[int32]*time.Location, error) {
+	facilityIDs := make([]int32, 0, len(reservations))
+	for _, r := range reservations {
+		facilityIDs = append(facilityIDs, r.FacilityID)
+	}
+	return facilityTimeZones(ctx, q, facilityIDs)
+}
+
+// toReservation converts a database reservation into its API representation with its period both in UTC and in
+// loc, the time zone of its facility.
+func toReservation(r db.Reservation, loc *time.Location) api.Reservation {
+	return api.Reservation{
+		ID:               r.ID,
+		UserID:           r.UserID,
+		FacilityID:       int(r.FacilityID),
+		Title:            r.Title,
+		Description:      optString(r.Description),
+		StartsAt:         r.Period.Lower.Time.UTC(),
+		EndsAt:           r.Period.Upper.Time.UTC(),
+		TimeZone:         loc.String(),
+		LocalStartsAt:    r.Period.Lower.Time.In(loc),
+		LocalEndsAt:      r.Period.Upper.Time.In(loc),
+		Status:           api.ReservationStatus(r.Status),
+		CancelledAt:      optDateTime(r.CancelledAt),
+		ReviewedAt:       optDateTime(r.ReviewedAt),
+		BookedBy:         optUUID(r.BookedBy),
+		SeriesID:         optUUID(r.SeriesID),
+		OriginalStartsAt: optDateTime(r.OriginalStartsAt),
+		IsException:      r.IsException,
+		BundleID:         optUUID(r.BundleID),
+		Attendees:        toReservationAttendees(r),
+		CheckedInAt:      optDateTime(r.CheckedInAt),
+		CheckedOutAt:     optDateTime(r.CheckedOutAt),
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
+
+func reservationNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Reservation not found.")
+}
+
+func reservationConflictProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"The facility is already reserved for an overlapping period, including setup and teardown buffers.")
+}
+
+func reservationCancelledProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict, "The reservation has already been cancelled.")
+}
+
+func reservationClosedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"The reservation request has been rejected or has expired, or the reservation was released as a no-show.")
+}
+
+func facilityUnavailableProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "facility_id must identify an active facility.")
+}
@@ -0,0 +1,432 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestReservationsValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	startsAt := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationsList(t.Context(), api.ReservationsListParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsListUnauthorized{}, res)
+	})
+
+	t.Run("list rejects reversed range", func(t *testing.T) {
+		res, err := svc.ReservationsList(userCtx, api.ReservationsListParams{
+			From: api.NewOptDateTime(startsAt),
+			To:   api.NewOptDateTime(startsAt.Add(-time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsListBadRequest{}, res)
+	})
+
+	t.Run("create rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(t.Context(), &api.ReservationInput{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateUnauthorized{}, res)
+	})
+
+	t.Run("create rejects empty period", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: 1,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	t.Run("update rejects reversed period", func(t *testing.T) {
+		res, err := svc.ReservationsUpdate(userCtx, &api.ReservationInput{
+			FacilityID: 1,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(-time.Hour),
+		}, api.ReservationsUpdateParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsUpdateBadRequest{}, res)
+	})
+
+	t.Run("cancel rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.ReservationsCancel(t.Context(), api.ReservationsCancelParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCancelUnauthorized{}, res)
+	})
+}
+
+func TestReservationsLifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUserCtx := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	owner := newUserCtx(t)
+	other := newUserCtx(t)
+	ownerCtx := internal.WithAuthenticatedUser(ctx, owner)
+	otherCtx := internal.WithAuthenticatedUser(ctx, other)
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	input := &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Team meeting",
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(time.Hour),
+	}
+
+	createRes, err := svc.ReservationsCreate(ownerCtx, input)
+	require.NoError(t, err)
+	created, ok := createRes.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", createRes)
+	assert.Equal(t, owner.ID, created.UserID.String())
+	assert.Equal(t, api.ReservationStatusConfirmed, created.Status)
+	assert.True(t, startsAt.Equal(created.StartsAt))
+
+	t.Run("create rejects overlapping period", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Overlap",
+			StartsAt:   startsAt.Add(30 * time.Minute),
+			EndsAt:     startsAt.Add(90 * time.Minute),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("create accepts adjacent period", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Adjacent",
+			StartsAt:   startsAt.Add(time.Hour),
+			EndsAt:     startsAt.Add(2 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, res)
+	})
+
+	t.Run("create rejects unknown facility", func(t *testing.T) {
+		res, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+			FacilityID: 0,
+			Title:      "Nowhere",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	t.Run("retrieve hides reservations of other users", func(t *testing.T) {
+		res, err := svc.ReservationsRetrieve(otherCtx, api.ReservationsRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsRetrieveNotFound{}, res)
+
+		res, err = svc.ReservationsRetrieve(staffCtx, api.ReservationsRetrieveParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, res)
+	})
+
+	t.Run("list returns only own reservations", func(t *testing.T) {
+		res, err := svc.ReservationsList(ownerCtx, api.ReservationsListParams{
+			FacilityID: api.NewOptInt(facility.ID),
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.ReservationsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, created.ID, (*list)[0].ID)
+	})
+
+	t.Run("update moves the reservation", func(t *testing.T) {
+		res, err := svc.ReservationsUpdate(ownerCtx, &api.ReservationInput{
+			FacilityID:  facility.ID,
+			Title:       "Moved meeting",
+			Description: api.NewOptString("Moved one hour earlier"),
+			StartsAt:    startsAt.Add(-time.Hour),
+			EndsAt:      startsAt,
+		}, api.ReservationsUpdateParams{ID: created.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, "Moved meeting", updated.Title)
+		assert.True(t, startsAt.Equal(updated.EndsAt))
+	})
+
+	t.Run("cancel releases the period", func(t *testing.T) {
+		res, err := svc.ReservationsCancel(ownerCtx, api.ReservationsCancelParams{ID: created.ID})
+		require.NoError(t, err)
+		cancelled, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.ReservationStatusCancelled, cancelled.Status)
+		assert.True(t, cancelled.CancelledAt.IsSet())
+
+		res, err = svc.ReservationsCancel(ownerCtx, api.ReservationsCancelParams{ID: created.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCancelConflict{}, res)
+
+		createRes, err := svc.ReservationsCreate(otherCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Reuse",
+			StartsAt:   startsAt.Add(-time.Hour),
+			EndsAt:     startsAt,
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, createRes)
+	})
+}
+
+func TestReservationBuffers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+		Name:                  gofakeit.Company(),
+		SetupBufferMinutes:    api.NewOptInt32(15),
+		TeardownBufferMinutes: api.NewOptInt32(30),
+	})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+	assert.Equal(t, api.NewOptInt32(15), facility.SetupBufferMinutes)
+	assert.Equal(t, api.NewOptInt32(30), facility.TeardownBufferMinutes)
+
+	day := time.Now().UTC().AddDate(0, 0, 2).Truncate(24 * time.Hour)
+	reserve := func(t *testing.T, startsAt, endsAt time.Time) api.ReservationsCreateRes {
+		t.Helper()
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Workshop",
+			StartsAt:   startsAt,
+			EndsAt:     endsAt,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	// Blocks the facility from 09:45 to 11:30.
+	res := reserve(t, day.Add(10*time.Hour), day.Add(11*time.Hour))
+	reservation, ok := res.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", res)
+	assert.True(t, day.Add(10*time.Hour).Equal(reservation.StartsAt), "buffers are not part of the period")
+	assert.True(t, day.Add(11*time.Hour).Equal(reservation.EndsAt), "buffers are not part of the period")
+
+	t.Run("availability leaves room for the buffers", func(t *testing.T) {
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:       day.Add(9 * time.Hour),
+			To:         day.Add(13 * time.Hour),
+			FacilityID: []int{facility.ID},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+
+		slots := (*list)[0].Slots
+		require.Len(t, slots, 2)
+		assert.True(t, day.Add(9*time.Hour+15*time.Minute).Equal(slots[0].EndsAt))
+		assert.True(t, day.Add(11*time.Hour+45*time.Minute).Equal(slots[1].StartsAt))
+	})
+
+	t.Run("create rejects periods whose buffers overlap", func(t *testing.T) {
+		res := reserve(t, day.Add(11*time.Hour+15*time.Minute), day.Add(12*time.Hour))
+		assert.IsType(t, &api.ReservationsCreateConflict{}, res)
+	})
+
+	t.Run("create accepts periods after the buffers", func(t *testing.T) {
+		res := reserve(t, day.Add(11*time.Hour+45*time.Minute), day.Add(12*time.Hour+30*time.Minute))
+		assert.IsType(t, &api.Reservation{}, res)
+	})
+}
+
+func TestReservationAttendees(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUser := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	owner, attendee := newUser(t), newUser(t)
+	ownerCtx := internal.WithAuthenticatedUser(ctx, owner)
+	attendeeCtx := internal.WithAuthenticatedUser(ctx, attendee)
+	attendeeID := uuid.MustParse(attendee.ID)
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+		Name:     gofakeit.Company(),
+		Capacity: api.NewOptInt32(3),
+	})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+	assert.Equal(t, api.NewOptInt32(3), facility.Capacity)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	byUser := func(id uuid.UUID) api.ReservationAttendee {
+		return api.ReservationAttendee{UserID: api.NewOptUUID(id), Email: api.OptEmailString{}}
+	}
+	byEmail := func(email string) api.ReservationAttendee {
+		return api.ReservationAttendee{UserID: api.OptUUID{}, Email: api.NewOptEmailString(api.EmailString(email))}
+	}
+	reserve := func(t *testing.T, attendees ...api.ReservationAttendee) api.ReservationsCreateRes {
+		t.Helper()
+		res, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Planning",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+			Attendees:  attendees,
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("create rejects headcounts exceeding the capacity", func(t *testing.T) {
+		res := reserve(t, byUser(attendeeID), byEmail("guest@example.com"), byEmail("other@example.com"))
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	t.Run("create rejects attendees listed twice", func(t *testing.T) {
+		res := reserve(t, byEmail("guest@example.com"), byEmail("Guest@example.com"))
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	t.Run("create rejects unknown users", func(t *testing.T) {
+		res := reserve(t, byUser(uuid.Must(uuid.NewV7())))
+		assert.IsType(t, &api.ReservationsCreateBadRequest{}, res)
+	})
+
+	res := reserve(t, byUser(attendeeID), byEmail("guest@example.com"))
+	reservation, ok := res.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", res)
+	assert.Equal(t, []api.ReservationAttendee{byUser(attendeeID), byEmail("guest@example.com")},
+		reservation.Attendees)
+
+	t.Run("attendees see the reservation but cannot change it", func(t *testing.T) {
+		listRes, err := svc.ReservationsList(attendeeCtx, api.ReservationsListParams{})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.ReservationsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		require.Len(t, *list, 1)
+		assert.Equal(t, reservation.ID, (*list)[0].ID)
+
+		retrieveRes, err := svc.ReservationsRetrieve(attendeeCtx, api.ReservationsRetrieveParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.Reservation{}, retrieveRes)
+
+		cancelRes, err := svc.ReservationsCancel(attendeeCtx, api.ReservationsCancelParams{ID: reservation.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.ReservationsCancelNotFound{}, cancelRes)
+	})
+
+	t.Run("update replaces the attendees", func(t *testing.T) {
+		res, err := svc.ReservationsUpdate(ownerCtx, &api.ReservationInput{
+			FacilityID: facility.ID,
+			Title:      "Planning",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+			Attendees:  []api.ReservationAttendee{byEmail("guest@example.com")},
+		}, api.ReservationsUpdateParams{ID: reservation.ID})
+		require.NoError(t, err)
+		updated, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, []api.ReservationAttendee{byEmail("guest@example.com")}, updated.Attendees)
+
+		listRes, err := svc.ReservationsList(attendeeCtx, api.ReservationsListParams{})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.ReservationsListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		assert.Empty(t, *list)
+	})
+}
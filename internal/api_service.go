@@ -1,19 +1,164 @@
 package internal
 
 import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/thara/facility_reservation_go/internal/api"
 )
 
 // APIService implements the facility reservation API handlers by embedding the generated handler interface.
 type APIService struct {
 	api.UnimplementedHandler
-	dbService DBService
+	ds       *DataStore
+	notifier Notifier
+}
+
+// APIServiceOption configures optional dependencies of an APIService.
+type APIServiceOption func(*APIService)
+
+// WithNotifier makes the service deliver notifications through n. By default they are only logged.
+func WithNotifier(n Notifier) APIServiceOption {
+	return func(s *APIService) {
+		s.notifier = n
+	}
 }
 
 // NewAPIService creates a new service with database dependency.
-func NewAPIService(dbService DBService) *APIService {
-	return &APIService{
+func NewAPIService(ds *DataStore, opts ...APIServiceOption) *APIService {
+	s := &APIService{
 		UnimplementedHandler: api.UnimplementedHandler{},
-		dbService:            dbService,
+		ds:                   ds,
+		notifier:             newLogNotifier(slog.Default()),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// NewError converts an unexpected handler error into the default error response.
+// Failed security requirements are reported as 401 Unauthorized.
+func (s *APIService) NewError(ctx context.Context, err error) *api.UnexpectedErrorStatusCode {
+	var secErr *ogenerrors.SecurityError
+	if errors.As(err, &secErr) {
+		slog.WarnContext(ctx, "authentication failed", "operation", secErr.Name, "error", err)
+		return &api.UnexpectedErrorStatusCode{
+			StatusCode: http.StatusUnauthorized,
+			Response: api.UnexpectedError{
+				Code:    api.UnexpectedErrorCodeUNAUTHORIZED,
+				Message: http.StatusText(http.StatusUnauthorized),
+			},
+		}
+	}
+
+	slog.ErrorContext(ctx, "unexpected error while handling request", "error", err)
+	return &api.UnexpectedErrorStatusCode{
+		StatusCode: http.StatusInternalServerError,
+		Response: api.UnexpectedError{
+			Code:    api.UnexpectedErrorCodeINTERNALSERVERERROR,
+			Message: http.StatusText(http.StatusInternalServerError),
+		},
+	}
+}
+
+// staffAccess classifies the caller of a staff-only operation.
+type staffAccess int
+
+const (
+	staffAccessGranted staffAccess = iota
+	staffAccessUnauthenticated
+	staffAccessForbidden
+)
+
+// checkStaffAccess reports whether the request was made by an authenticated staff user.
+func checkStaffAccess(ctx context.Context) staffAccess {
+	user, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return staffAccessUnauthenticated
+	}
+	if !user.IsStaff {
+		return staffAccessForbidden
+	}
+	return staffAccessGranted
+}
+
+// newProblem builds an RFC 9457 problem body for the given status code.
+func newProblem(status int, detail string) *api.ProblemDetails {
+	return &api.ProblemDetails{
+		Type:       api.OptString{},
+		Title:      api.NewOptString(http.StatusText(status)),
+		Status:     api.NewOptInt(status),
+		Detail:     api.NewOptString(detail),
+		Instance:   api.OptString{},
+		Violations: nil,
+		Component:  api.OptInt{},
+	}
+}
+
+// isConflictProblem reports whether the problem is reported with 409 Conflict.
+func isConflictProblem(p *api.ProblemDetails) bool {
+	return p.Status.Or(http.StatusInternalServerError) == http.StatusConflict
+}
+
+func unauthenticatedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusUnauthorized, "Authentication credentials were not provided.")
+}
+
+func forbiddenProblem() *api.ProblemDetails {
+	return newProblem(http.StatusForbidden, "Staff privileges are required to perform this action.")
+}
+
+// optional is satisfied by the generated api.Opt* types.
+type optional[T any] interface {
+	Get() (T, bool)
+}
+
+// ptrOf returns a pointer to the value held by o, or nil when o is not set.
+func ptrOf[T any](o optional[T]) *T {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+func optString(v *string) api.OptString {
+	if v == nil {
+		return api.OptString{}
+	}
+	return api.NewOptString(*v)
+}
+
+func optInt32(v *int32) api.OptInt32 {
+	if v == nil {
+		return api.OptInt32{}
+	}
+	return api.NewOptInt32(*v)
+}
+
+func optInt64(v *int64) api.OptInt64 {
+	if v == nil {
+		return api.OptInt64{}
+	}
+	return api.NewOptInt64(*v)
+}
+
+func optDateTime(v *time.Time) api.OptDateTime {
+	if v == nil {
+		return api.OptDateTime{}
+	}
+	return api.NewOptDateTime(*v)
+}
+
+func optUUID(v *uuid.UUID) api.OptUUID {
+	if v == nil {
+		return api.OptUUID{}
 	}
+	return api.NewOptUUID(*v)
 }
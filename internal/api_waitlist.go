@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+var (
+	// errWaitlistEntryNotFound is returned inside transactions when the entry is missing
+	// or not visible to the caller.
+	errWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	// errWaitlistEntryPromoted is returned inside transactions when the entry was already promoted to a reservation.
+	errWaitlistEntryPromoted = errors.New("waitlist entry is promoted")
+)
+
+// WaitlistList returns waitlist entries in the order they were made.
+// Staff users see all entries, other users only their own. Promoted entries are only included on request.
+func (s *APIService) WaitlistList(
+	ctx context.Context,
+	params api.WaitlistListParams,
+) (res api.WaitlistListRes, err error) {
+	defer derrors.Wrap(&err, "WaitlistList(ctx, params)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.WaitlistListUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	arg := db.ListWaitlistEntriesParams{
+		UserID:          nil,
+		FacilityID:      nil,
+		IncludePromoted: params.IncludePromoted.Or(false),
+	}
+	if v, ok := params.FacilityID.Get(); ok {
+		facilityID, ok := toFacilityID(v)
+		if !ok {
+			return (*api.WaitlistListBadRequest)(facilityUnavailableProblem()), nil
+		}
+		arg.FacilityID = &facilityID
+	}
+	if !caller.IsStaff {
+		userID, err := uuid.Parse(caller.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+		}
+		arg.UserID = &userID
+	}
+
+	entries, err := s.ds.ListWaitlistEntries(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+
+	list := make(api.WaitlistListOKApplicationJSON, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, toWaitlistEntry(e))
+	}
+	return &list, nil
+}
+
+// WaitlistCreate puts the authenticated user on the waitlist for a period of a facility.
+// The period is checked like a new reservation, except that it may be taken by other reservations.
+func (s *APIService) WaitlistCreate(
+	ctx context.Context,
+	req *api.WaitlistEntryInput,
+) (res api.WaitlistCreateRes, err error) {
+	defer derrors.Wrap(&err, "WaitlistCreate(ctx, req)")
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.WaitlistCreateUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	if !req.StartsAt.Before(req.EndsAt) {
+		problem := newProblem(http.StatusBadRequest, "ends_at must be after starts_at.")
+		return (*api.WaitlistCreateBadRequest)(problem), nil
+	}
+
+	userID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+
+	facility, ok, err := s.reservableFacility(ctx, req.FacilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return (*api.WaitlistCreateBadRequest)(facilityUnavailableProblem()), nil
+	}
+
+	violations, err := evaluateBookingPolicy(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return (*api.WaitlistCreateBadRequest)(bookingPolicyViolationProblem(violations)), nil
+	}
+
+	isOpen, err := withinOpeningHours(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if !isOpen {
+		return (*api.WaitlistCreateBadRequest)(outsideOpeningHoursProblem()), nil
+	}
+	blackout, found, err := findBlackout(ctx, s.ds, facility.ID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return (*api.WaitlistCreateConflict)(blackoutConflictProblem(blackout)), nil
+	}
+
+	entry, err := s.ds.CreateWaitlistEntry(ctx, db.CreateWaitlistEntryParams{
+		ID:          uuid.Must(uuid.NewV7()),
+		FacilityID:  facility.ID,
+		UserID:      userID,
+		Title:       req.Title,
+		Description: ptrOf(req.Description),
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+
+	created := toWaitlistEntry(entry)
+	return &created, nil
+}
+
+// WaitlistDestroy withdraws a waiting entry. Only its owner and staff users are allowed.
+func (s *APIService) WaitlistDestroy(
+	ctx context.Context,
+	params api.WaitlistDestroyParams,
+) (res api.WaitlistDestroyRes, err error) {
+	defer derrors.Wrap(&err, "WaitlistDestroy(ctx, %s)", params.ID)
+
+	caller, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		return (*api.WaitlistDestroyUnauthorized)(unauthenticatedProblem()), nil
+	}
+
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		entry, err := tx.GetWaitlistEntryByIDForUpdate(ctx, params.ID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errWaitlistEntryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get waitlist entry: %w", err)
+		}
+		if !caller.IsStaff && caller.ID != entry.UserID.String() {
+			return errWaitlistEntryNotFound
+		}
+		if entry.PromotedAt != nil {
+			return errWaitlistEntryPromoted
+		}
+
+		if err := tx.DeleteWaitlistEntry(ctx, entry.ID); err != nil {
+			return fmt.Errorf("failed to delete waitlist entry: %w", err)
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errWaitlistEntryNotFound):
+		return (*api.WaitlistDestroyNotFound)(waitlistEntryNotFoundProblem()), nil
+	case errors.Is(err, errWaitlistEntryPromoted):
+		return (*api.WaitlistDestroyConflict)(waitlistEntryPromotedProblem()), nil
+	case err != nil:
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return &api.WaitlistDestroyNoContent{}, nil
+}
+
+// toWaitlistEntry converts a database waitlist entry into its API representation.
+func toWaitlistEntry(e db.WaitlistEntry) api.WaitlistEntry {
+	return api.WaitlistEntry{
+		ID:            e.ID,
+		UserID:        e.UserID,
+		FacilityID:    int(e.FacilityID),
+		Title:         e.Title,
+		Description:   optString(e.Description),
+		StartsAt:      e.Period.Lower.Time,
+		EndsAt:        e.Period.Upper.Time,
+		ReservationID: optUUID(e.ReservationID),
+		PromotedAt:    optDateTime(e.PromotedAt),
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func waitlistEntryNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusNotFound, "Waitlist entry not found.")
+}
+
+func waitlistEntryPromotedProblem() *api.ProblemDetails {
+	return newProblem(http.StatusConflict,
+		"The waitlist entry has already been promoted to a reservation. Cancel the reservation instead.")
+}
@@ -0,0 +1,278 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// recordingNotifier records the waitlist entries it is notified about.
+type recordingNotifier struct {
+	promoted []uuid.UUID
+}
+
+func (n *recordingNotifier) WaitlistPromoted(_ context.Context, entry db.WaitlistEntry) {
+	n.promoted = append(n.promoted, entry.ID)
+}
+
+func TestWaitlistValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	userCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       uuid.Must(uuid.NewV7()).String(),
+		Username: "regular-user",
+		IsStaff:  false,
+	})
+	startsAt := time.Now().Add(time.Hour)
+
+	t.Run("list rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.WaitlistList(t.Context(), api.WaitlistListParams{})
+		require.NoError(t, err)
+		assert.IsType(t, &api.WaitlistListUnauthorized{}, res)
+	})
+
+	t.Run("create rejects reversed periods", func(t *testing.T) {
+		res, err := svc.WaitlistCreate(userCtx, &api.WaitlistEntryInput{
+			FacilityID: 1,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.WaitlistCreateBadRequest{}, res)
+	})
+
+	t.Run("destroy rejects anonymous requests", func(t *testing.T) {
+		res, err := svc.WaitlistDestroy(t.Context(), api.WaitlistDestroyParams{ID: uuid.Must(uuid.NewV7())})
+		require.NoError(t, err)
+		assert.IsType(t, &api.WaitlistDestroyUnauthorized{}, res)
+	})
+}
+
+func TestWaitlist(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	notifier := &recordingNotifier{promoted: nil}
+	svc := internal.NewAPIService(ds, internal.WithNotifier(notifier))
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	newUserCtx := func(t *testing.T) *internal.AuthenticatedUser {
+		t.Helper()
+		created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+			Username: gofakeit.Username(),
+			IsStaff:  false,
+			Email:    nil,
+		})
+		require.NoError(t, err)
+		return &internal.AuthenticatedUser{
+			ID:       created.User.ID.String(),
+			Username: created.User.Username,
+			IsStaff:  created.User.IsStaff,
+		}
+	}
+	ownerCtx := internal.WithAuthenticatedUser(ctx, newUserCtx(t))
+	firstUser, secondUser := newUserCtx(t), newUserCtx(t)
+	firstCtx := internal.WithAuthenticatedUser(ctx, firstUser)
+	secondCtx := internal.WithAuthenticatedUser(ctx, secondUser)
+
+	facilityRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+	require.NoError(t, err)
+	facility, ok := facilityRes.(*api.PublicFacility)
+	require.True(t, ok, "unexpected response %T", facilityRes)
+
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	createRes, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+		FacilityID: facility.ID,
+		Title:      "Board meeting",
+		StartsAt:   startsAt,
+		EndsAt:     startsAt.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+	reservation, ok := createRes.(*api.Reservation)
+	require.True(t, ok, "unexpected response %T", createRes)
+
+	join := func(t *testing.T, user *internal.AuthenticatedUser, offset time.Duration) *api.WaitlistEntry {
+		t.Helper()
+		res, err := svc.WaitlistCreate(internal.WithAuthenticatedUser(ctx, user), &api.WaitlistEntryInput{
+			FacilityID: facility.ID,
+			Title:      "Team meeting",
+			StartsAt:   startsAt.Add(offset),
+			EndsAt:     startsAt.Add(offset + time.Hour),
+		})
+		require.NoError(t, err)
+		entry, ok := res.(*api.WaitlistEntry)
+		require.True(t, ok, "unexpected response %T", res)
+		return entry
+	}
+	isPromoted := func(t *testing.T, user *internal.AuthenticatedUser, facilityID int, entryID uuid.UUID) bool {
+		t.Helper()
+		res, err := svc.WaitlistList(internal.WithAuthenticatedUser(ctx, user), api.WaitlistListParams{
+			FacilityID:      api.NewOptInt(facilityID),
+			IncludePromoted: api.NewOptBool(true),
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.WaitlistListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		for _, e := range *list {
+			if e.ID == entryID {
+				return e.ReservationID.IsSet()
+			}
+		}
+		return false
+	}
+	first := join(t, firstUser, 0)
+	second := join(t, secondUser, 0)
+	withdrawn := join(t, secondUser, time.Hour)
+
+	t.Run("users only see their own entries", func(t *testing.T) {
+		res, err := svc.WaitlistList(secondCtx, api.WaitlistListParams{FacilityID: api.NewOptInt(facility.ID)})
+		require.NoError(t, err)
+		list, ok := res.(*api.WaitlistListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 2)
+		assert.Equal(t, second.ID, (*list)[0].ID)
+		assert.Equal(t, withdrawn.ID, (*list)[1].ID)
+	})
+
+	t.Run("withdrawing removes a waiting entry", func(t *testing.T) {
+		res, err := svc.WaitlistDestroy(secondCtx, api.WaitlistDestroyParams{ID: withdrawn.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.WaitlistDestroyNoContent{}, res)
+	})
+
+	t.Run("cancellation promotes the earliest entry that fits", func(t *testing.T) {
+		res, err := svc.ReservationsCancel(ownerCtx, api.ReservationsCancelParams{ID: reservation.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.Reservation{}, res)
+
+		listRes, err := svc.WaitlistList(firstCtx, api.WaitlistListParams{
+			FacilityID:      api.NewOptInt(facility.ID),
+			IncludePromoted: api.NewOptBool(true),
+		})
+		require.NoError(t, err)
+		list, ok := listRes.(*api.WaitlistListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		require.Len(t, *list, 1)
+		assert.Equal(t, first.ID, (*list)[0].ID)
+		reservationID, ok := (*list)[0].ReservationID.Get()
+		require.True(t, ok, "entry was not promoted")
+		assert.Equal(t, []uuid.UUID{first.ID}, notifier.promoted)
+
+		retrieveRes, err := svc.ReservationsRetrieve(firstCtx, api.ReservationsRetrieveParams{ID: reservationID})
+		require.NoError(t, err)
+		promoted, ok := retrieveRes.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", retrieveRes)
+		assert.Equal(t, api.ReservationStatusConfirmed, promoted.Status)
+		assert.Equal(t, first.StartsAt, promoted.StartsAt)
+
+		listRes, err = svc.WaitlistList(secondCtx, api.WaitlistListParams{FacilityID: api.NewOptInt(facility.ID)})
+		require.NoError(t, err)
+		list, ok = listRes.(*api.WaitlistListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", listRes)
+		require.Len(t, *list, 1)
+		assert.Equal(t, second.ID, (*list)[0].ID)
+	})
+
+	t.Run("promoted entries cannot be withdrawn", func(t *testing.T) {
+		res, err := svc.WaitlistDestroy(firstCtx, api.WaitlistDestroyParams{ID: first.ID})
+		require.NoError(t, err)
+		assert.IsType(t, &api.WaitlistDestroyConflict{}, res)
+	})
+
+	t.Run("series cancellation promotes entries for its occurrences", func(t *testing.T) {
+		seriesRes, err := svc.ReservationSeriesCreate(ownerCtx, &api.ReservationSeriesInput{
+			FacilityID: facility.ID,
+			Title:      "Daily sync",
+			StartsAt:   startsAt.Add(6 * time.Hour),
+			EndsAt:     startsAt.Add(7 * time.Hour),
+			Rrule:      "FREQ=DAILY;COUNT=2",
+			TimeZone:   api.NewOptString("UTC"),
+		}, api.ReservationSeriesCreateParams{})
+		require.NoError(t, err)
+		series, ok := seriesRes.(*api.ReservationSeriesWithSkipped)
+		require.True(t, ok, "unexpected response %T", seriesRes)
+		entry := join(t, secondUser, 30*time.Hour)
+
+		res, err := svc.ReservationSeriesCancel(ownerCtx, api.ReservationSeriesCancelParams{ID: series.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.ReservationSeries{}, res)
+
+		assert.True(t, isPromoted(t, secondUser, facility.ID, entry.ID), "entry was not promoted")
+		assert.Contains(t, notifier.promoted, entry.ID)
+	})
+
+	t.Run("the sweeper promotes entries for the period of an expired hold", func(t *testing.T) {
+		holdRes, err := svc.HoldsCreate(ownerCtx, &api.HoldInput{
+			FacilityID: facility.ID,
+			StartsAt:   startsAt.Add(10 * time.Hour),
+			EndsAt:     startsAt.Add(11 * time.Hour),
+		})
+		require.NoError(t, err)
+		held, ok := holdRes.(*api.Hold)
+		require.True(t, ok, "unexpected response %T", holdRes)
+		entry := join(t, secondUser, 10*time.Hour)
+
+		sweeper := internal.NewSweeper(ds, time.Minute, internal.WithSweeperNotifier(notifier))
+		require.NoError(t, sweeper.Sweep(ctx, held.ExpiresAt.Add(time.Second)))
+
+		assert.True(t, isPromoted(t, secondUser, facility.ID, entry.ID), "entry was not promoted")
+		assert.Contains(t, notifier.promoted, entry.ID)
+	})
+
+	t.Run("rejection promotes entries for the released period", func(t *testing.T) {
+		approvalRes, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:             gofakeit.Company(),
+			RequiresApproval: api.NewOptBool(true),
+		})
+		require.NoError(t, err)
+		approval, ok := approvalRes.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", approvalRes)
+
+		requestRes, err := svc.ReservationsCreate(ownerCtx, &api.ReservationInput{
+			FacilityID: approval.ID,
+			Title:      "Board meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		request, ok := requestRes.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", requestRes)
+		require.Equal(t, api.ReservationStatusPending, request.Status)
+
+		entryRes, err := svc.WaitlistCreate(secondCtx, &api.WaitlistEntryInput{
+			FacilityID: approval.ID,
+			Title:      "Team meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		entry, ok := entryRes.(*api.WaitlistEntry)
+		require.True(t, ok, "unexpected response %T", entryRes)
+
+		res, err := svc.AdminReservationsReject(staffCtx, api.AdminReservationsRejectParams{ID: request.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.Reservation{}, res)
+
+		assert.True(t, isPromoted(t, secondUser, approval.ID, entry.ID), "entry was not promoted")
+		assert.Contains(t, notifier.promoted, entry.ID)
+	})
+}
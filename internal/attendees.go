@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// attendeeList holds the people attending a reservation besides its owner.
+type attendeeList struct {
+	userIDs []uuid.UUID
+	emails  []string
+}
+
+// headcount returns the number of people attending a reservation, including its owner.
+func (a attendeeList) headcount() int {
+	return 1 + len(a.userIDs) + len(a.emails)
+}
+
+// invalidAttendeesError is returned inside transactions when the attendees of a reservation are invalid
+// or exceed the capacity of its facility.
+type invalidAttendeesError struct {
+	detail string
+}
+
+func (e *invalidAttendeesError) Error() string {
+	return "invalid attendees: " + e.detail
+}
+
+// resolveAttendees checks the requested attendees of a reservation of the facility owned by ownerID.
+// Every attendee is either an existing user other than the owner or an email address, listed once,
+// and together with the owner they must not exceed the capacity of the facility.
+// Email addresses are compared case-insensitively.
+func resolveAttendees(
+	ctx context.Context,
+	q db.Querier,
+	facility db.Facility,
+	ownerID uuid.UUID,
+	attendees []api.ReservationAttendee,
+) (attendeeList, error) {
+	list := attendeeList{
+		userIDs: make([]uuid.UUID, 0, len(attendees)),
+		emails:  make([]string, 0, len(attendees)),
+	}
+	seen := make(map[string]bool, len(attendees))
+	for _, a := range attendees {
+		userID, hasUserID := a.UserID.Get()
+		email, hasEmail := a.Email.Get()
+		key := userID.String()
+		if hasEmail {
+			key = strings.ToLower(string(email))
+		}
+
+		switch {
+		case hasUserID == hasEmail:
+			return attendeeList{}, &invalidAttendeesError{detail: "Every attendee must have either user_id or email."}
+		case hasUserID && userID == ownerID:
+			return attendeeList{}, &invalidAttendeesError{detail: "The owner of the reservation cannot attend it."}
+		case seen[key]:
+			return attendeeList{}, &invalidAttendeesError{detail: "Every attendee must be listed once."}
+		case hasUserID:
+			list.userIDs = append(list.userIDs, userID)
+		default:
+			list.emails = append(list.emails, string(email))
+		}
+		seen[key] = true
+	}
+
+	if facility.Capacity != nil && list.headcount() > int(*facility.Capacity) {
+		return attendeeList{}, &invalidAttendeesError{detail: fmt.Sprintf(
+			"The facility holds at most %d people, but the reservation has %d including its owner.",
+			*facility.Capacity, list.headcount())}
+	}
+
+	if len(list.userIDs) > 0 {
+		count, err := q.CountUsersByIDs(ctx, list.userIDs)
+		if err != nil {
+			return attendeeList{}, fmt.Errorf("failed to count attending users: %w", err)
+		}
+		if int(count) != len(list.userIDs) {
+			return attendeeList{}, &invalidAttendeesError{detail: "user_id of an attendee does not identify a user."}
+		}
+	}
+	return list, nil
+}
+
+// toReservationAttendees converts the attendees of a database reservation into their API representation,
+// users first.
+func toReservationAttendees(r db.Reservation) []api.ReservationAttendee {
+	attendees := make([]api.ReservationAttendee, 0, len(r.AttendeeUserIds)+len(r.AttendeeEmails))
+	for _, id := range r.AttendeeUserIds {
+		attendees = append(attendees, api.ReservationAttendee{
+			UserID: api.NewOptUUID(id),
+			Email:  api.OptEmailString{},
+		})
+	}
+	for _, email := range r.AttendeeEmails {
+		attendees = append(attendees, api.ReservationAttendee{
+			UserID: api.OptUUID{},
+			Email:  api.NewOptEmailString(api.EmailString(email)),
+		})
+	}
+	return attendees
+}
+
+// isAttendee reports whether the caller attends the reservation.
+func isAttendee(caller *AuthenticatedUser, r db.Reservation) bool {
+	for _, id := range r.AttendeeUserIds {
+		if caller.ID == id.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func invalidAttendeesProblem(err *invalidAttendeesError) *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, err.detail)
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// blackoutPeriod is an occurrence of a blackout window.
+type blackoutPeriod struct {
+	blackout db.FacilityBlackout
+	start    time.Time
+	end      time.Time
+}
+
+// blackoutConflictError is returned inside transactions when a requested period overlaps a blackout.
+type blackoutConflictError struct {
+	period blackoutPeriod
+}
+
+func (e *blackoutConflictError) Error() string {
+	return fmt.Sprintf("period overlaps blackout %s", e.period.blackout.ID)
+}
+
+// expandBlackout returns the occurrences of a blackout. A one-off blackout has a single occurrence.
+func expandBlackout(b db.FacilityBlackout) ([]occurrence, error) {
+	if b.Rrule == nil || b.TimeZone == nil {
+		return []occurrence{{StartsAt: b.StartsAt, EndsAt: b.EndsAt}}, nil
+	}
+
+	loc, err := loadSeriesLocation(*b.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return expandRecurrence(*b.Rrule, b.StartsAt, b.EndsAt, loc)
+}
+
+// loadBlackouts loads the blackout occurrences of the given facilities overlapping [from, to),
+// ordered by start time.
+func loadBlackouts(
+	ctx context.Context,
+	q db.Querier,
+	facilityIDs []int32,
+	from, to time.Time,
+) (map[int32][]blackoutPeriod, error) {
+	blackouts, err := q.ListBlackoutsInRange(ctx, db.ListBlackoutsInRangeParams{
+		FacilityIds: facilityIDs,
+		To:          to,
+		From:        from,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blackouts: %w", err)
+	}
+
+	periods := make(map[int32][]blackoutPeriod)
+	for _, b := range blackouts {
+		occurrences, err := expandBlackout(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand blackout %s: %w", b.ID, err)
+		}
+		for _, o := range occurrences {
+			if o.StartsAt.Before(to) && o.EndsAt.After(from) {
+				periods[b.FacilityID] = append(periods[b.FacilityID], blackoutPeriod{
+					blackout: b,
+					start:    o.StartsAt,
+					end:      o.EndsAt,
+				})
+			}
+		}
+	}
+	for _, list := range periods {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].start.Before(list[j].start) })
+	}
+	return periods, nil
+}
+
+// findBlackout returns a blackout occurrence of the facility overlapping [startsAt, endsAt).
+// It reports false when the period is not blacked out.
+func findBlackout(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+) (blackoutPeriod, bool, error) {
+	periods, err := loadBlackouts(ctx, q, []int32{facilityID}, startsAt, endsAt)
+	if err != nil {
+		return blackoutPeriod{}, false, err
+	}
+	period, found := overlappingBlackout(periods[facilityID], startsAt, endsAt)
+	return period, found, nil
+}
+
+// checkBlackoutsLocked locks the facility for share and returns a *blackoutConflictError when [startsAt, endsAt)
+// overlaps one of its blackouts. Called inside the transaction writing a reservation, it keeps blackouts created
+// concurrently from being missed.
+func checkBlackoutsLocked(ctx context.Context, tx *Transaction, facilityID int32, startsAt, endsAt time.Time) error {
+	if _, err := tx.GetFacilityByIDForShare(ctx, facilityID); err != nil {
+		return fmt.Errorf("failed to get facility: %w", err)
+	}
+	blackout, found, err := findBlackout(ctx, tx, facilityID, startsAt, endsAt)
+	if err != nil {
+		return err
+	}
+	if found {
+		return &blackoutConflictError{period: blackout}
+	}
+	return nil
+}
+
+// overlappingBlackout returns the first of the ordered blackout periods overlapping [start, end).
+func overlappingBlackout(periods []blackoutPeriod, start, end time.Time) (blackoutPeriod, bool) {
+	for _, p := range periods {
+		if p.start.Before(end) && p.end.After(start) {
+			return p, true
+		}
+	}
+	return blackoutPeriod{}, false
+}
+
+// subtractBlackouts cuts the blackout periods out of the open periods. Both must be ordered by start time.
+func subtractBlackouts(open []openPeriod, blackouts []blackoutPeriod) []openPeriod {
+	if len(blackouts) == 0 {
+		return open
+	}
+
+	remaining := make([]openPeriod, 0, len(open))
+	for _, p := range open {
+		start := p.start
+		for _, b := range blackouts {
+			if !b.start.Before(p.end) || !b.end.After(start) {
+				continue
+			}
+			if b.start.After(start) {
+				remaining = append(remaining, openPeriod{start: start, end: b.start})
+			}
+			start = b.end
+		}
+		if start.Before(p.end) {
+			remaining = append(remaining, openPeriod{start: start, end: p.end})
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/policy"
+)
+
+// bookingPolicyDay is the unit of the advance window of a booking policy.
+const bookingPolicyDay = 24 * time.Hour
+
+// bookingPolicyError is returned inside transactions when requested periods violate the booking policy of
+// their facility.
+type bookingPolicyError struct {
+	violations []policy.Violation
+}
+
+func (e *bookingPolicyError) Error() string {
+	return fmt.Sprintf("%d booking policy rules violated", len(e.violations))
+}
+
+// loadBookingPolicies returns the booking policy set on a facility and the organization-wide default.
+// A policy that was never set is returned as a zero value, which sets no rules.
+func loadBookingPolicies(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+) (own, defaults db.BookingPolicy, err error) {
+	defaults, err = q.GetDefaultBookingPolicy(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return db.BookingPolicy{}, db.BookingPolicy{}, fmt.Errorf("failed to get default booking policy: %w", err)
+	}
+	own, err = q.GetFacilityBookingPolicy(ctx, &facilityID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return db.BookingPolicy{}, db.BookingPolicy{}, fmt.Errorf("failed to get facility booking policy: %w", err)
+	}
+	return own, defaults, nil
+}
+
+// effectiveBookingPolicy returns the rules of a facility policy with the unset ones taken from the default.
+func effectiveBookingPolicy(own, defaults db.BookingPolicy) db.BookingPolicy {
+	effective := own
+	if effective.MinDurationMinutes == nil {
+		effective.MinDurationMinutes = defaults.MinDurationMinutes
+	}
+	if effective.MaxDurationMinutes == nil {
+		effective.MaxDurationMinutes = defaults.MaxDurationMinutes
+	}
+	if effective.GranularityMinutes == nil {
+		effective.GranularityMinutes = defaults.GranularityMinutes
+	}
+	if effective.MaxAdvanceDays == nil {
+		effective.MaxAdvanceDays = defaults.MaxAdvanceDays
+	}
+	if !effective.SameDayCutoff.Valid {
+		effective.SameDayCutoff = defaults.SameDayCutoff
+	}
+	return effective
+}
+
+// evaluateBookingPolicy evaluates a reservation of [startsAt, endsAt) made now against the effective booking
+// policy of the facility in its time zone and returns every violated rule.
+func evaluateBookingPolicy(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+) ([]policy.Violation, error) {
+	rules, loc, err := loadEffectiveBookingPolicy(ctx, q, facilityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.Evaluate(rules, policy.Request{
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Now:      time.Now(),
+		Location: loc,
+	}), nil
+}
+
+// loadEffectiveBookingPolicy returns the effective booking policy of the facility and the time zone it is
+// evaluated in.
+func loadEffectiveBookingPolicy(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+) (policy.Policy, *time.Location, error) {
+	own, defaults, err := loadBookingPolicies(ctx, q, facilityID)
+	if err != nil {
+		return policy.Policy{}, nil, err
+	}
+	loc, err := facilityTimeZone(ctx, q, facilityID)
+	if err != nil {
+		return policy.Policy{}, nil, err
+	}
+	return toPolicy(effectiveBookingPolicy(own, defaults)), loc, nil
+}
+
+// toPolicy converts a stored booking policy into the rules evaluated by the policy package.
+func toPolicy(p db.BookingPolicy) policy.Policy {
+	rules := policy.Policy{
+		MinDuration:   durationOf(p.MinDurationMinutes, time.Minute),
+		MaxDuration:   durationOf(p.MaxDurationMinutes, time.Minute),
+		Granularity:   durationOf(p.GranularityMinutes, time.Minute),
+		MaxAdvance:    durationOf(p.MaxAdvanceDays, bookingPolicyDay),
+		SameDayCutoff: nil,
+	}
+	if p.SameDayCutoff.Valid {
+		cutoff := dayOffset(p.SameDayCutoff)
+		rules.SameDayCutoff = &cutoff
+	}
+	return rules
+}
+
+// durationOf converts a count of units into a duration, or nil when the count is not set.
+func durationOf(count *int32, unit time.Duration) *time.Duration {
+	if count == nil {
+		return nil
+	}
+	d := time.Duration(*count) * unit
+	return &d
+}
+
+func bookingPolicyViolationProblem(violations []policy.Violation) *api.ProblemDetails {
+	problem := newProblem(http.StatusBadRequest, "The reservation violates the booking policy of the facility.")
+	problem.Violations = make([]api.BookingPolicyViolation, 0, len(violations))
+	for _, v := range violations {
+		problem.Violations = append(problem.Violations, api.BookingPolicyViolation{
+			Rule:   api.BookingPolicyRule(v.Rule),
+			Detail: v.Detail,
+		})
+	}
+	return problem
+}
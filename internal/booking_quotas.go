@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// daysPerWeek is the length of the calendar week booking quotas count hours in.
+const daysPerWeek = 7
+
+// bookingQuota is a quota a user is subject to, either the one of every user or the one set for the user.
+type bookingQuota struct {
+	limits     db.BookingQuota
+	isOverride bool
+}
+
+// quotaExceededError is returned inside transactions when a reservation would exceed a booking quota of its user.
+type quotaExceededError struct {
+	detail string
+}
+
+func (e *quotaExceededError) Error() string {
+	return "booking quota exceeded: " + e.detail
+}
+
+// userBookingQuotas resolves the quotas a user is subject to from the rows returned by ListUserBookingQuotas,
+// organization-wide first. A quota set for the user replaces the one of every user in the same scope.
+func userBookingQuotas(rows []db.BookingQuota) []bookingQuota {
+	quotas := make([]bookingQuota, 0, len(rows))
+	for _, r := range rows {
+		quota := bookingQuota{limits: r, isOverride: r.UserID != nil}
+		// Overrides are ordered right after the quota they replace.
+		if n := len(quotas); n > 0 && sameFacilityScope(quotas[n-1].limits.FacilityID, r.FacilityID) {
+			quotas[n-1] = quota
+			continue
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas
+}
+
+// enforceBookingQuotas checks that a reservation of [startsAt, endsAt) of the facility keeps its user within
+// their booking quotas, counting hours in the calendar week in the time zone of the facility.
+// excludeID identifies the reservation being replaced, if any.
+// The user is locked first so that concurrent reservations of the user cannot exceed a quota together.
+func enforceBookingQuotas(
+	ctx context.Context,
+	tx *Transaction,
+	userID uuid.UUID,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+	excludeID *uuid.UUID,
+) error {
+	if _, err := tx.GetUserByIDForUpdate(ctx, userID); err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	rows, err := tx.ListUserBookingQuotas(ctx, &userID)
+	if err != nil {
+		return fmt.Errorf("failed to list booking quotas: %w", err)
+	}
+
+	loc, err := facilityTimeZone(ctx, tx, facilityID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	weekStartsAt := weekStart(startsAt, loc)
+	for _, quota := range userBookingQuotas(rows) {
+		if scope := quota.limits.FacilityID; scope != nil && *scope != facilityID {
+			continue
+		}
+
+		usage, err := tx.GetBookingQuotaUsage(ctx, db.GetBookingQuotaUsageParams{
+			WeekStartsAt: weekStartsAt,
+			WeekEndsAt:   weekStartsAt.AddDate(0, 0, daysPerWeek),
+			Now:          now,
+			UserID:       userID,
+			FacilityID:   quota.limits.FacilityID,
+			ExcludeID:    excludeID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get booking quota usage: %w", err)
+		}
+
+		booked := time.Duration(usage.BookedMinutes)*time.Minute + endsAt.Sub(startsAt)
+		if limit := quota.limits.MaxHoursPerWeek; limit != nil && booked > time.Duration(*limit)*time.Hour {
+			return &quotaExceededError{detail: fmt.Sprintf(
+				"The reservation would exceed the %d hours per week allowed by %s.", *limit, quotaScopeName(quota))}
+		}
+		limit := quota.limits.MaxUpcomingReservations
+		if limit != nil && endsAt.After(now) && usage.UpcomingReservations >= int64(*limit) {
+			return &quotaExceededError{detail: fmt.Sprintf(
+				"The reservation would exceed the %d upcoming reservations allowed by %s.",
+				*limit, quotaScopeName(quota))}
+		}
+	}
+	return nil
+}
+
+// bookingQuotaUsage returns the usage of a user counted against a quota in the current week, in the time zone of
+// the facility of a per-facility quota and in the default time zone otherwise.
+func bookingQuotaUsage(
+	ctx context.Context,
+	q db.Querier,
+	userID uuid.UUID,
+	quota bookingQuota,
+) (api.BookingQuotaUsage, error) {
+	loc := defaultTimeZone
+	if id := quota.limits.FacilityID; id != nil {
+		facilityLoc, err := facilityTimeZone(ctx, q, *id)
+		if err != nil {
+			return api.BookingQuotaUsage{}, err
+		}
+		loc = facilityLoc
+	}
+
+	now := time.Now()
+	weekStartsAt := weekStart(now, loc)
+	usage, err := q.GetBookingQuotaUsage(ctx, db.GetBookingQuotaUsageParams{
+		WeekStartsAt: weekStartsAt,
+		WeekEndsAt:   weekStartsAt.AddDate(0, 0, daysPerWeek),
+		Now:          now,
+		UserID:       userID,
+		FacilityID:   quota.limits.FacilityID,
+		ExcludeID:    nil,
+	})
+	if err != nil {
+		return api.BookingQuotaUsage{}, fmt.Errorf("failed to get booking quota usage: %w", err)
+	}
+
+	res := api.BookingQuotaUsage{
+		FacilityID:           api.OptInt{},
+		Quota:                toAPIBookingQuota(quota.limits),
+		IsOverride:           quota.isOverride,
+		WeekStartsAt:         weekStartsAt,
+		BookedMinutes:        usage.BookedMinutes,
+		UpcomingReservations: usage.UpcomingReservations,
+	}
+	if id := quota.limits.FacilityID; id != nil {
+		res.FacilityID = api.NewOptInt(int(*id))
+	}
+	return res, nil
+}
+
+// weekStart returns midnight of the Monday starting the calendar week of t in loc.
+func weekStart(t time.Time, loc *time.Location) time.Time {
+	date := civilDate(t, loc)
+	offset := (date.Weekday() - time.Monday + daysPerWeek) % daysPerWeek
+	return localTime(date.AddDate(0, 0, -int(offset)), loc)
+}
+
+func sameFacilityScope(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func quotaScopeName(quota bookingQuota) string {
+	if quota.limits.FacilityID == nil {
+		return "the organization-wide booking quota"
+	}
+	return "the booking quota of the facility"
+}
+
+// toAPIBookingQuota converts a stored booking quota into its API representation.
+func toAPIBookingQuota(q db.BookingQuota) api.BookingQuota {
+	return api.BookingQuota{
+		MaxHoursPerWeek:         optInt32(q.MaxHoursPerWeek),
+		MaxUpcomingReservations: optInt32(q.MaxUpcomingReservations),
+	}
+}
+
+func quotaExceededProblem(err *quotaExceededError) *api.ProblemDetails {
+	return newProblem(http.StatusConflict, err.detail)
+}
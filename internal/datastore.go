@@ -2,7 +2,9 @@ package internal
 
 import (
 	"context"
+	"errors"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/thara/facility_reservation_go/internal/db"
 	"github.com/thara/facility_reservation_go/internal/derrors"
 )
@@ -27,3 +29,32 @@ func (ds *DataStore) Transaction(ctx context.Context, fn TransactionFunc) (err e
 	defer derrors.Wrap(&err, "DataStore.Transaction(ctx, fn)")
 	return ds.dbService.Transaction(ctx, fn) //nolint:wrapcheck // propagate error
 }
+
+const (
+	// uniqueViolationCode is the PostgreSQL SQLSTATE for unique_violation.
+	uniqueViolationCode = "23505"
+	// exclusionViolationCode is the PostgreSQL SQLSTATE for exclusion_violation.
+	exclusionViolationCode = "23P01"
+	// foreignKeyViolationCode is the PostgreSQL SQLSTATE for foreign_key_violation.
+	foreignKeyViolationCode = "23503"
+)
+
+// isUniqueViolation reports whether err was caused by a unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// isExclusionViolation reports whether err was caused by an exclusion constraint violation,
+// such as an overlapping reservation.
+func isExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == exclusionViolationCode
+}
+
+// isForeignKeyViolation reports whether err was caused by a foreign key constraint violation,
+// such as a reference to a missing row or the deletion of a row that is still referenced.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
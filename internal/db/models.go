@@ -5,27 +5,303 @@
 package db
 
 import (
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Facility struct {
-	ID          int32     `json:"id"`
+type LocationKind string
+
+const (
+	LocationKindSite     LocationKind = "site"
+	LocationKindBuilding LocationKind = "building"
+	LocationKindFloor    LocationKind = "floor"
+)
+
+func (e *LocationKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LocationKind(s)
+	case string:
+		*e = LocationKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LocationKind: %T", src)
+	}
+	return nil
+}
+
+type NullLocationKind struct {
+	LocationKind LocationKind `json:"location_kind"`
+	Valid        bool         `json:"valid"` // Valid is true if LocationKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLocationKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.LocationKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LocationKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLocationKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LocationKind), nil
+}
+
+func (e LocationKind) Valid() bool {
+	switch e {
+	case LocationKindSite,
+		LocationKindBuilding,
+		LocationKindFloor:
+		return true
+	}
+	return false
+}
+
+func AllLocationKindValues() []LocationKind {
+	return []LocationKind{
+		LocationKindSite,
+		LocationKindBuilding,
+		LocationKindFloor,
+	}
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusConfirmed ReservationStatus = "confirmed"
+	ReservationStatusCancelled ReservationStatus = "cancelled"
+	ReservationStatusPending   ReservationStatus = "pending"
+	ReservationStatusRejected  ReservationStatus = "rejected"
+	ReservationStatusExpired   ReservationStatus = "expired"
+	ReservationStatusHeld      ReservationStatus = "held"
+	ReservationStatusNoShow    ReservationStatus = "no_show"
+)
+
+func (e *ReservationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReservationStatus(s)
+	case string:
+		*e = ReservationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReservationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReservationStatus struct {
+	ReservationStatus ReservationStatus `json:"reservation_status"`
+	Valid             bool              `json:"valid"` // Valid is true if ReservationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReservationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReservationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReservationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReservationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReservationStatus), nil
+}
+
+func (e ReservationStatus) Valid() bool {
+	switch e {
+	case ReservationStatusConfirmed,
+		ReservationStatusCancelled,
+		ReservationStatusPending,
+		ReservationStatusRejected,
+		ReservationStatusExpired,
+		ReservationStatusHeld,
+		ReservationStatusNoShow:
+		return true
+	}
+	return false
+}
+
+func AllReservationStatusValues() []ReservationStatus {
+	return []ReservationStatus{
+		ReservationStatusConfirmed,
+		ReservationStatusCancelled,
+		ReservationStatusPending,
+		ReservationStatusRejected,
+		ReservationStatusExpired,
+		ReservationStatusHeld,
+		ReservationStatusNoShow,
+	}
+}
+
+type Amenity struct {
+	ID          uuid.UUID `json:"id"`
+	Slug        string    `json:"slug"`
 	Name        string    `json:"name"`
 	Description *string   `json:"description"`
-	Location    *string   `json:"location"`
-	Priority    *int64    `json:"priority"`
-	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+type BookingPolicy struct {
+	ID                 uuid.UUID   `json:"id"`
+	FacilityID         *int32      `json:"facility_id"`
+	MinDurationMinutes *int32      `json:"min_duration_minutes"`
+	MaxDurationMinutes *int32      `json:"max_duration_minutes"`
+	GranularityMinutes *int32      `json:"granularity_minutes"`
+	MaxAdvanceDays     *int32      `json:"max_advance_days"`
+	SameDayCutoff      pgtype.Time `json:"same_day_cutoff"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+}
+
+type BookingQuota struct {
+	ID                      uuid.UUID  `json:"id"`
+	FacilityID              *int32     `json:"facility_id"`
+	UserID                  *uuid.UUID `json:"user_id"`
+	MaxHoursPerWeek         *int32     `json:"max_hours_per_week"`
+	MaxUpcomingReservations *int32     `json:"max_upcoming_reservations"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+}
+
+type DelegationGrant struct {
+	ID         uuid.UUID `json:"id"`
+	GrantorID  uuid.UUID `json:"grantor_id"`
+	DelegateID uuid.UUID `json:"delegate_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Facility struct {
+	ID                    int32      `json:"id"`
+	Name                  string     `json:"name"`
+	Description           *string    `json:"description"`
+	Location              *string    `json:"location"`
+	Priority              *int64     `json:"priority"`
+	IsActive              bool       `json:"is_active"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	SetupBufferMinutes    int32      `json:"setup_buffer_minutes"`
+	TeardownBufferMinutes int32      `json:"teardown_buffer_minutes"`
+	RequiresApproval      bool       `json:"requires_approval"`
+	CheckInGraceMinutes   *int32     `json:"check_in_grace_minutes"`
+	Capacity              *int32     `json:"capacity"`
+	LocationID            *uuid.UUID `json:"location_id"`
+	TimeZone              *string    `json:"time_zone"`
+}
+
+type FacilityBlackout struct {
+	ID         uuid.UUID `json:"id"`
+	FacilityID int32     `json:"facility_id"`
+	Reason     string    `json:"reason"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Rrule      *string   `json:"rrule"`
+	TimeZone   *string   `json:"time_zone"`
+	LastEndsAt time.Time `json:"last_ends_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type FacilityOpeningHour struct {
+	ID         uuid.UUID   `json:"id"`
+	FacilityID int32       `json:"facility_id"`
+	Weekday    int16       `json:"weekday"`
+	OpensAt    pgtype.Time `json:"opens_at"`
+	ClosesAt   pgtype.Time `json:"closes_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type FacilityOpeningHourOverride struct {
+	FacilityID int32       `json:"facility_id"`
+	Date       time.Time   `json:"date"`
+	OpensAt    pgtype.Time `json:"opens_at"`
+	ClosesAt   pgtype.Time `json:"closes_at"`
+	Reason     *string     `json:"reason"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+type Location struct {
+	ID        uuid.UUID    `json:"id"`
+	ParentID  *uuid.UUID   `json:"parent_id"`
+	Kind      LocationKind `json:"kind"`
+	Name      string       `json:"name"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	TimeZone  *string      `json:"time_zone"`
+}
+
+type Reservation struct {
+	ID               uuid.UUID                        `json:"id"`
+	FacilityID       int32                            `json:"facility_id"`
+	UserID           uuid.UUID                        `json:"user_id"`
+	Title            string                           `json:"title"`
+	Description      *string                          `json:"description"`
+	Period           pgtype.Range[pgtype.Timestamptz] `json:"period"`
+	Status           ReservationStatus                `json:"status"`
+	CancelledAt      *time.Time                       `json:"cancelled_at"`
+	CreatedAt        time.Time                        `json:"created_at"`
+	UpdatedAt        time.Time                        `json:"updated_at"`
+	SeriesID         *uuid.UUID                       `json:"series_id"`
+	OriginalStartsAt *time.Time                       `json:"original_starts_at"`
+	IsException      bool                             `json:"is_exception"`
+	BlockedPeriod    pgtype.Range[pgtype.Timestamptz] `json:"blocked_period"`
+	ReviewedAt       *time.Time                       `json:"reviewed_at"`
+	HoldExpiresAt    *time.Time                       `json:"hold_expires_at"`
+	CheckedInAt      *time.Time                       `json:"checked_in_at"`
+	CheckedOutAt     *time.Time                       `json:"checked_out_at"`
+	BookedBy         *uuid.UUID                       `json:"booked_by"`
+	BundleID         *uuid.UUID                       `json:"bundle_id"`
+	AttendeeUserIds  []uuid.UUID                      `json:"attendee_user_ids"`
+	AttendeeEmails   []string                         `json:"attendee_emails"`
+}
+
+type ReservationBundle struct {
+	ID          uuid.UUID         `json:"id"`
+	UserID      uuid.UUID         `json:"user_id"`
+	Title       string            `json:"title"`
+	Description *string           `json:"description"`
+	Status      ReservationStatus `json:"status"`
+	CancelledAt *time.Time        `json:"cancelled_at"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+type ReservationSeries struct {
+	ID          uuid.UUID         `json:"id"`
+	FacilityID  int32             `json:"facility_id"`
+	UserID      uuid.UUID         `json:"user_id"`
+	Title       string            `json:"title"`
+	Description *string           `json:"description"`
+	Rrule       string            `json:"rrule"`
+	TimeZone    string            `json:"time_zone"`
+	StartsAt    time.Time         `json:"starts_at"`
+	EndsAt      time.Time         `json:"ends_at"`
+	Status      ReservationStatus `json:"status"`
+	CancelledAt *time.Time        `json:"cancelled_at"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
 type User struct {
 	ID        uuid.UUID `json:"id"`
 	Username  string    `json:"username"`
 	IsStaff   bool      `json:"is_staff"`
 	CreatedAt time.Time `json:"created_at"`
+	Email     *string   `json:"email"`
 }
 
 type UserToken struct {
@@ -36,3 +312,15 @@ type UserToken struct {
 	ExpiresAt *time.Time `json:"expires_at"`
 	CreatedAt time.Time  `json:"created_at"`
 }
+
+type WaitlistEntry struct {
+	ID            uuid.UUID                        `json:"id"`
+	FacilityID    int32                            `json:"facility_id"`
+	UserID        uuid.UUID                        `json:"user_id"`
+	Title         string                           `json:"title"`
+	Description   *string                          `json:"description"`
+	Period        pgtype.Range[pgtype.Timestamptz] `json:"period"`
+	ReservationID *uuid.UUID                       `json:"reservation_id"`
+	PromotedAt    *time.Time                       `json:"promoted_at"`
+	CreatedAt     time.Time                        `json:"created_at"`
+}
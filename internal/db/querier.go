@@ -6,29 +6,183 @@ package db
 
 import (
 	"context"
+	"time"
 
 	uuid "github.com/google/uuid"
 )
 
 type Querier interface {
+	// Slugs of amenities that do not exist are ignored, so callers compare the number of added rows with the slugs.
+	AddFacilityAmenities(ctx context.Context, arg AddFacilityAmenitiesParams) (int64, error)
+	CancelBundleReservations(ctx context.Context, bundleID uuid.UUID) ([]Reservation, error)
+	CancelReservation(ctx context.Context, id uuid.UUID) (Reservation, error)
+	CancelReservationBundle(ctx context.Context, id uuid.UUID) (ReservationBundle, error)
+	CancelReservationSeries(ctx context.Context, id uuid.UUID) (ReservationSeries, error)
+	CancelSeriesReservationsFrom(ctx context.Context, arg CancelSeriesReservationsFromParams) ([]Reservation, error)
+	CheckInReservation(ctx context.Context, id uuid.UUID) (Reservation, error)
+	// The rest of the period, including the teardown buffer, is released for other reservations.
+	CheckOutReservation(ctx context.Context, arg CheckOutReservationParams) (Reservation, error)
+	ConfirmHold(ctx context.Context, arg ConfirmHoldParams) (Reservation, error)
+	CountUsersByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
+	CreateAmenity(ctx context.Context, arg CreateAmenityParams) (Amenity, error)
+	CreateBlackout(ctx context.Context, arg CreateBlackoutParams) (FacilityBlackout, error)
+	// Facilities requiring approval cannot be reserved by bundle, so reservations of a bundle are always confirmed.
+	// Bundles have no attendees.
+	CreateBundleReservation(ctx context.Context, arg CreateBundleReservationParams) (Reservation, error)
+	CreateDelegationGrant(ctx context.Context, arg CreateDelegationGrantParams) (DelegationGrant, error)
 	CreateFacility(ctx context.Context, arg CreateFacilityParams) (Facility, error)
+	// A hold has no details until it is confirmed.
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Reservation, error)
+	CreateLocation(ctx context.Context, arg CreateLocationParams) (Location, error)
+	CreateOpeningHours(ctx context.Context, arg CreateOpeningHoursParams) (FacilityOpeningHour, error)
+	CreateReservation(ctx context.Context, arg CreateReservationParams) (Reservation, error)
+	CreateReservationBundle(ctx context.Context, arg CreateReservationBundleParams) (ReservationBundle, error)
+	// Used for promotions from the waitlist, which are skipped instead of failing the transaction when the period is taken.
+	CreateReservationIfFree(ctx context.Context, arg CreateReservationIfFreeParams) (int64, error)
+	CreateReservationSeries(ctx context.Context, arg CreateReservationSeriesParams) (ReservationSeries, error)
+	// Occurrences overlapping a confirmed reservation are skipped instead of failing the transaction.
+	CreateSeriesOccurrence(ctx context.Context, arg CreateSeriesOccurrenceParams) (int64, error)
 	CreateToken(ctx context.Context, arg CreateTokenParams) (UserToken, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteFacility(ctx context.Context, id int32) error
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (WaitlistEntry, error)
+	DeleteAmenity(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteBlackout(ctx context.Context, arg DeleteBlackoutParams) (int64, error)
+	DeleteDelegationGrant(ctx context.Context, id uuid.UUID) error
+	DeleteExpiredFacilityHolds(ctx context.Context, arg DeleteExpiredFacilityHoldsParams) error
+	DeleteExpiredHolds(ctx context.Context, now time.Time) ([]Reservation, error)
+	DeleteFacility(ctx context.Context, id int32) (int64, error)
+	DeleteFacilityAmenities(ctx context.Context, facilityID int32) error
+	DeleteLocation(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteOpeningHourOverride(ctx context.Context, arg DeleteOpeningHourOverrideParams) (int64, error)
+	DeleteOpeningHours(ctx context.Context, facilityID int32) error
+	DeleteReservation(ctx context.Context, id uuid.UUID) error
+	// Occurrences edited or skipped individually are kept.
+	DeleteSeriesReservationsFrom(ctx context.Context, arg DeleteSeriesReservationsFromParams) (int64, error)
 	DeleteToken(ctx context.Context, id uuid.UUID) error
-	DeleteUser(ctx context.Context, id uuid.UUID) error
+	// The user is removed from the attendees of reservations as well.
+	DeleteUser(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteUserBookingQuota(ctx context.Context, arg DeleteUserBookingQuotaParams) (int64, error)
+	DeleteWaitlistEntry(ctx context.Context, id uuid.UUID) error
+	// Requests that were neither approved nor rejected before they start release their period.
+	ExpirePendingReservations(ctx context.Context, now time.Time) ([]Reservation, error)
+	GetAmenityByID(ctx context.Context, id uuid.UUID) (Amenity, error)
+	GetBlackoutByID(ctx context.Context, arg GetBlackoutByIDParams) (FacilityBlackout, error)
+	// Booking quota queries for per-user limits, organization-wide or per facility
+	GetBookingQuota(ctx context.Context, arg GetBookingQuotaParams) (BookingQuota, error)
+	// Usage of the confirmed, pending and held reservations of a user counted against a quota, and of the part of
+	// no-shows blocked until their release.
+	// A NULL facility_id counts every facility.
+	GetBookingQuotaUsage(ctx context.Context, arg GetBookingQuotaUsageParams) (GetBookingQuotaUsageRow, error)
+	// Booking policy queries for per-facility rules and the organization-wide default
+	GetDefaultBookingPolicy(ctx context.Context) (BookingPolicy, error)
+	GetDelegationGrantByID(ctx context.Context, id uuid.UUID) (DelegationGrant, error)
+	GetFacilityBookingPolicy(ctx context.Context, facilityID *int32) (BookingPolicy, error)
 	GetFacilityByID(ctx context.Context, id int32) (Facility, error)
+	// Locking the facility for share keeps its blackouts from being created until the reservation checked against
+	// them is written.
+	GetFacilityByIDForShare(ctx context.Context, id int32) (Facility, error)
+	GetFacilityByIDForUpdate(ctx context.Context, id int32) (Facility, error)
+	GetLocationByID(ctx context.Context, id uuid.UUID) (Location, error)
+	// Reservation bundle queries for booking several facilities at once
+	GetReservationBundleByID(ctx context.Context, id uuid.UUID) (ReservationBundle, error)
+	GetReservationBundleByIDForUpdate(ctx context.Context, id uuid.UUID) (ReservationBundle, error)
+	// Reservations queries for booking operations
+	GetReservationByID(ctx context.Context, id uuid.UUID) (Reservation, error)
+	GetReservationByIDForUpdate(ctx context.Context, id uuid.UUID) (Reservation, error)
+	// Reservation series queries for recurring bookings
+	GetReservationSeriesByID(ctx context.Context, id uuid.UUID) (ReservationSeries, error)
+	GetReservationSeriesByIDForUpdate(ctx context.Context, id uuid.UUID) (ReservationSeries, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByIDForUpdate(ctx context.Context, id uuid.UUID) (User, error)
 	// Users queries for Phase 1 token-based authentication
 	GetUserByToken(ctx context.Context, token string) (GetUserByTokenRow, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
-	ListAllFacilities(ctx context.Context) ([]Facility, error)
+	GetWaitlistEntryByIDForUpdate(ctx context.Context, id uuid.UUID) (WaitlistEntry, error)
+	HasDelegationGrant(ctx context.Context, arg HasDelegationGrantParams) (bool, error)
+	ListActiveBundleReservationsForUpdate(ctx context.Context, bundleID uuid.UUID) ([]Reservation, error)
+	// Amenity queries for the amenities catalogue and the amenities of facilities
+	ListAmenities(ctx context.Context) ([]Amenity, error)
+	// Blackout queries for facility maintenance windows
+	ListBlackouts(ctx context.Context, facilityID int32) ([]FacilityBlackout, error)
+	// Blackouts whose occurrences may overlap [from, to); recurring ones still have to be expanded.
+	ListBlackoutsInRange(ctx context.Context, arg ListBlackoutsInRangeParams) ([]FacilityBlackout, error)
+	// Delegation grant queries for booking on behalf of other users
+	// Users filtered by user_id see the grants they gave and received.
+	ListDelegationGrants(ctx context.Context, userID *uuid.UUID) ([]DelegationGrant, error)
 	// Facilities queries for public and admin operations
-	ListFacilities(ctx context.Context) ([]Facility, error)
+	// Facilities filtered by amenities offer every one of them. The amenities must be listed once.
+	// Facilities filtered by location are located in it or in any location within it.
+	ListFacilities(ctx context.Context, arg ListFacilitiesParams) ([]Facility, error)
+	ListFacilitiesByIDs(ctx context.Context, ids []int32) ([]Facility, error)
+	// Facilities are ordered by name and id, in the order of idx_facilities_name_page. A page starts after the name and
+	// id of the last facility of the previous one. Facilities are filtered like ListFacilitiesPageByPriority.
+	ListFacilitiesPageByName(ctx context.Context, arg ListFacilitiesPageByNameParams) ([]Facility, error)
+	// Facilities are ordered by priority, with facilities without one last, then by name and id, in the order of
+	// idx_facilities_priority_page. A page starts after the priority, name and id of the last facility of the previous
+	// one. Facilities are filtered by amenities and location like ListFacilities.
+	ListFacilitiesPageByPriority(ctx context.Context, arg ListFacilitiesPageByPriorityParams) ([]Facility, error)
+	ListFacilityAmenities(ctx context.Context, facilityIds []int32) ([]ListFacilityAmenitiesRow, error)
+	// A new reservation needs room for its own buffers, so the periods blocked by existing reservations are widened
+	// by the teardown buffer before and the setup buffer after them.
+	// Facilities filtered by amenities offer every one of them. The amenities must be listed once.
+	// Facilities filtered by location are located in it or in any location within it.
+	// Buffers are at most 1440 minutes, so reservations further away from the window than that are never read.
+	ListFacilityAvailability(ctx context.Context, arg ListFacilityAvailabilityParams) ([]ListFacilityAvailabilityRow, error)
+	// The time zone of a facility is its own, else that of the nearest location containing it that sets one, else UTC.
+	ListFacilityTimeZones(ctx context.Context, facilityIds []int32) ([]ListFacilityTimeZonesRow, error)
+	// Location queries for the site, building and floor hierarchy
+	ListLocations(ctx context.Context) ([]Location, error)
+	ListOpeningHourOverrides(ctx context.Context, arg ListOpeningHourOverridesParams) ([]FacilityOpeningHourOverride, error)
+	// Opening hours queries for facility booking windows
+	ListOpeningHours(ctx context.Context, facilityIds []int32) ([]FacilityOpeningHour, error)
+	ListPendingReservations(ctx context.Context, facilityID *int32) ([]Reservation, error)
+	// Users filtered by user_id see their own bundles and those of the users who granted them delegation.
+	ListReservationBundles(ctx context.Context, userID *uuid.UUID) ([]ReservationBundle, error)
+	// Users filtered by user_id see their own series and those of the users who granted them delegation.
+	ListReservationSeries(ctx context.Context, userID *uuid.UUID) ([]ReservationSeries, error)
+	// Users filtered by user_id see their own reservations, those of the users who granted them delegation
+	// and those they attend.
+	ListReservations(ctx context.Context, arg ListReservationsParams) ([]Reservation, error)
+	ListReservationsByBundleIDs(ctx context.Context, bundleIds []uuid.UUID) ([]Reservation, error)
+	ListReservationsBySeriesIDs(ctx context.Context, seriesIds []uuid.UUID) ([]Reservation, error)
+	ListSeriesExceptions(ctx context.Context, seriesID uuid.UUID) ([]Reservation, error)
+	// Quotas of every user together with those set for the given user, ordered by scope
+	// with the organization-wide ones first and overrides after the quota they replace.
+	ListUserBookingQuotas(ctx context.Context, userID *uuid.UUID) ([]BookingQuota, error)
 	ListUserTokens(ctx context.Context, userID uuid.UUID) ([]UserToken, error)
 	ListUsers(ctx context.Context) ([]User, error)
+	// Waiting entries of the facility overlapping the released period that have not started, in the order they were made.
+	ListWaitlistCandidatesForUpdate(ctx context.Context, arg ListWaitlistCandidatesForUpdateParams) ([]WaitlistEntry, error)
+	// Waitlist queries for requests of already reserved periods
+	ListWaitlistEntries(ctx context.Context, arg ListWaitlistEntriesParams) ([]WaitlistEntry, error)
+	// Used when a series is split so that exceptions after the split point follow the new series.
+	MoveSeriesExceptions(ctx context.Context, arg MoveSeriesExceptionsParams) (int64, error)
+	PromoteWaitlistEntry(ctx context.Context, arg PromoteWaitlistEntryParams) (WaitlistEntry, error)
+	// Running reservations of facilities with a check-in grace period that were not checked in by its end
+	// release their remaining period. Like checking out, the period ends at the release, so that the part of it
+	// that was blocked stays counted against booking quotas. The deadline must have passed strictly, so that a
+	// reservation without a grace period is not released into an empty period at its start.
+	// Reservations are returned as they were before the release, so that the released periods can be read from them.
+	ReleaseNoShows(ctx context.Context, now time.Time) ([]Reservation, error)
+	ReviewReservation(ctx context.Context, arg ReviewReservationParams) (Reservation, error)
+	// Active facilities match when their name, location or description contains any word of the query, or when their
+	// name is similar to the query. They are ranked by how well their words match, weighted by field, plus the similarity
+	// of their name. Headlines enclose the matched words in <mark> and </mark>.
+	// The document must be the expression of idx_facilities_search for the index to be used.
+	SearchFacilities(ctx context.Context, arg SearchFacilitiesParams) ([]SearchFacilitiesRow, error)
+	TouchReservationBundle(ctx context.Context, id uuid.UUID) (ReservationBundle, error)
+	UpdateAmenity(ctx context.Context, arg UpdateAmenityParams) (Amenity, error)
 	UpdateFacility(ctx context.Context, arg UpdateFacilityParams) (Facility, error)
 	UpdateFacilityPartial(ctx context.Context, arg UpdateFacilityPartialParams) (Facility, error)
+	UpdateLocation(ctx context.Context, arg UpdateLocationParams) (Location, error)
+	// Attendees are kept unless given.
+	UpdateReservation(ctx context.Context, arg UpdateReservationParams) (Reservation, error)
+	UpdateReservationSeries(ctx context.Context, arg UpdateReservationSeriesParams) (ReservationSeries, error)
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	// A NULL facility_id sets the organization-wide default.
+	UpsertBookingPolicy(ctx context.Context, arg UpsertBookingPolicyParams) (BookingPolicy, error)
+	UpsertBookingQuota(ctx context.Context, arg UpsertBookingQuotaParams) (BookingQuota, error)
+	UpsertOpeningHourOverride(ctx context.Context, arg UpsertOpeningHourOverrideParams) (FacilityOpeningHourOverride, error)
 }
 
 var _ Querier = (*Queries)(nil)
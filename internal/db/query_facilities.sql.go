@@ -7,20 +7,34 @@ package db
 
 import (
 	"context"
+
+	uuid "github.com/google/uuid"
 )
 
 const createFacility = `-- name: CreateFacility :one
-INSERT INTO facilities (name, description, location, priority, is_active)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at
+INSERT INTO facilities (
+    name, description, location, priority, is_active, setup_buffer_minutes, teardown_buffer_minutes, requires_approval,
+    check_in_grace_minutes, capacity, location_id, time_zone
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone
 `
 
 type CreateFacilityParams struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
-	Location    *string `json:"location"`
-	Priority    *int64  `json:"priority"`
-	IsActive    bool    `json:"is_active"`
+	Name                  string     `json:"name"`
+	Description           *string    `json:"description"`
+	Location              *string    `json:"location"`
+	Priority              *int64     `json:"priority"`
+	IsActive              bool       `json:"is_active"`
+	SetupBufferMinutes    int32      `json:"setup_buffer_minutes"`
+	TeardownBufferMinutes int32      `json:"teardown_buffer_minutes"`
+	RequiresApproval      bool       `json:"requires_approval"`
+	CheckInGraceMinutes   *int32     `json:"check_in_grace_minutes"`
+	Capacity              *int32     `json:"capacity"`
+	LocationID            *uuid.UUID `json:"location_id"`
+	TimeZone              *string    `json:"time_zone"`
 }
 
 func (q *Queries) CreateFacility(ctx context.Context, arg CreateFacilityParams) (Facility, error) {
@@ -30,6 +44,13 @@ func (q *Queries) CreateFacility(ctx context.Context, arg CreateFacilityParams)
 		arg.Location,
 		arg.Priority,
 		arg.IsActive,
+		arg.SetupBufferMinutes,
+		arg.TeardownBufferMinutes,
+		arg.RequiresApproval,
+		arg.CheckInGraceMinutes,
+		arg.Capacity,
+		arg.LocationID,
+		arg.TimeZone,
 	)
 	var i Facility
 	err := row.Scan(
@@ -41,22 +62,34 @@ func (q *Queries) CreateFacility(ctx context.Context, arg CreateFacilityParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
 	)
 	return i, err
 }
 
-const deleteFacility = `-- name: DeleteFacility :exec
+const deleteFacility = `-- name: DeleteFacility :execrows
 DELETE FROM facilities
 WHERE id = $1
 `
 
-func (q *Queries) DeleteFacility(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deleteFacility, id)
-	return err
+func (q *Queries) DeleteFacility(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteFacility, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getFacilityByID = `-- name: GetFacilityByID :one
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
 WHERE id = $1
 `
@@ -73,18 +106,119 @@ func (q *Queries) GetFacilityByID(ctx context.Context, id int32) (Facility, erro
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
 	)
 	return i, err
 }
 
-const listAllFacilities = `-- name: ListAllFacilities :many
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+const getFacilityByIDForShare = `-- name: GetFacilityByIDForShare :one
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
+WHERE id = $1
+FOR SHARE
+`
+
+// Locking the facility for share keeps its blackouts from being created until the reservation checked against
+// them is written.
+func (q *Queries) GetFacilityByIDForShare(ctx context.Context, id int32) (Facility, error) {
+	row := q.db.QueryRow(ctx, getFacilityByIDForShare, id)
+	var i Facility
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Location,
+		&i.Priority,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const getFacilityByIDForUpdate = `-- name: GetFacilityByIDForUpdate :one
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetFacilityByIDForUpdate(ctx context.Context, id int32) (Facility, error) {
+	row := q.db.QueryRow(ctx, getFacilityByIDForUpdate, id)
+	var i Facility
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Location,
+		&i.Priority,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
+	)
+	return i, err
+}
+
+const listFacilities = `-- name: ListFacilities :many
+
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE is_active = true
+  AND ($1::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY($1::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality($1::varchar[])
+  ))
+  AND ($2::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = $2
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
 ORDER BY priority ASC, name ASC
 `
 
-func (q *Queries) ListAllFacilities(ctx context.Context) ([]Facility, error) {
-	rows, err := q.db.Query(ctx, listAllFacilities)
+type ListFacilitiesParams struct {
+	Amenities  []string   `json:"amenities"`
+	LocationID *uuid.UUID `json:"location_id"`
+}
+
+// Facilities queries for public and admin operations
+// Facilities filtered by amenities offer every one of them. The amenities must be listed once.
+// Facilities filtered by location are located in it or in any location within it.
+func (q *Queries) ListFacilities(ctx context.Context, arg ListFacilitiesParams) ([]Facility, error) {
+	rows, err := q.db.Query(ctx, listFacilities, arg.Amenities, arg.LocationID)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +235,13 @@ func (q *Queries) ListAllFacilities(ctx context.Context) ([]Facility, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SetupBufferMinutes,
+			&i.TeardownBufferMinutes,
+			&i.RequiresApproval,
+			&i.CheckInGraceMinutes,
+			&i.Capacity,
+			&i.LocationID,
+			&i.TimeZone,
 		); err != nil {
 			return nil, err
 		}
@@ -112,17 +253,98 @@ func (q *Queries) ListAllFacilities(ctx context.Context) ([]Facility, error) {
 	return items, nil
 }
 
-const listFacilities = `-- name: ListFacilities :many
+const listFacilitiesByIDs = `-- name: ListFacilitiesByIDs :many
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE id = ANY($1::integer[])
+`
+
+func (q *Queries) ListFacilitiesByIDs(ctx context.Context, ids []int32) ([]Facility, error) {
+	rows, err := q.db.Query(ctx, listFacilitiesByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Facility
+	for rows.Next() {
+		var i Facility
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Location,
+			&i.Priority,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SetupBufferMinutes,
+			&i.TeardownBufferMinutes,
+			&i.RequiresApproval,
+			&i.CheckInGraceMinutes,
+			&i.Capacity,
+			&i.LocationID,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-SELECT id, name, description, location, priority, is_active, created_at, updated_at
+const listFacilitiesPageByName = `-- name: ListFacilitiesPageByName :many
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
 FROM facilities
-WHERE is_active = true
-ORDER BY priority ASC, name ASC
+WHERE ($1::boolean IS NULL OR is_active = $1)
+  AND ($2::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY($2::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality($2::varchar[])
+  ))
+  AND ($3::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = $3
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
+  AND ($4::integer IS NULL
+      OR (name, id) > ($5::varchar, $4::integer))
+ORDER BY name ASC, id ASC
+LIMIT $6
 `
 
-// Facilities queries for public and admin operations
-func (q *Queries) ListFacilities(ctx context.Context) ([]Facility, error) {
-	rows, err := q.db.Query(ctx, listFacilities)
+type ListFacilitiesPageByNameParams struct {
+	IsActive   *bool      `json:"is_active"`
+	Amenities  []string   `json:"amenities"`
+	LocationID *uuid.UUID `json:"location_id"`
+	AfterID    *int32     `json:"after_id"`
+	AfterName  *string    `json:"after_name"`
+	PageLimit  int32      `json:"page_limit"`
+}
+
+// Facilities are ordered by name and id, in the order of idx_facilities_name_page. A page starts after the name and
+// id of the last facility of the previous one. Facilities are filtered like ListFacilitiesPageByPriority.
+func (q *Queries) ListFacilitiesPageByName(ctx context.Context, arg ListFacilitiesPageByNameParams) ([]Facility, error) {
+	rows, err := q.db.Query(ctx, listFacilitiesPageByName,
+		arg.IsActive,
+		arg.Amenities,
+		arg.LocationID,
+		arg.AfterID,
+		arg.AfterName,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +361,212 @@ func (q *Queries) ListFacilities(ctx context.Context) ([]Facility, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SetupBufferMinutes,
+			&i.TeardownBufferMinutes,
+			&i.RequiresApproval,
+			&i.CheckInGraceMinutes,
+			&i.Capacity,
+			&i.LocationID,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFacilitiesPageByPriority = `-- name: ListFacilitiesPageByPriority :many
+SELECT id, name, description, location, priority, is_active, created_at, updated_at,
+       setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+       location_id, time_zone
+FROM facilities
+WHERE ($1::boolean IS NULL OR is_active = $1)
+  AND ($2::varchar[] IS NULL OR id IN (
+      SELECT fa.facility_id
+      FROM facility_amenities fa
+      JOIN amenities a ON a.id = fa.amenity_id
+      WHERE a.slug = ANY($2::varchar[])
+      GROUP BY fa.facility_id
+      HAVING COUNT(*) = cardinality($2::varchar[])
+  ))
+  AND ($3::uuid IS NULL OR location_id IN (
+      WITH RECURSIVE subtree AS (
+          SELECT l.id FROM locations l WHERE l.id = $3
+          UNION ALL
+          SELECT l.id FROM locations l JOIN subtree st ON l.parent_id = st.id
+      )
+      SELECT id FROM subtree
+  ))
+  AND ($4::integer IS NULL OR (COALESCE(priority, 9223372036854775807), name, id)
+      > ($5::bigint, $6::varchar, $4::integer))
+ORDER BY COALESCE(priority, 9223372036854775807) ASC, name ASC, id ASC
+LIMIT $7
+`
+
+type ListFacilitiesPageByPriorityParams struct {
+	IsActive   *bool      `json:"is_active"`
+	Amenities  []string   `json:"amenities"`
+	LocationID *uuid.UUID `json:"location_id"`
+	AfterID    *int32     `json:"after_id"`
+	AfterRank  *int64     `json:"after_rank"`
+	AfterName  *string    `json:"after_name"`
+	PageLimit  int32      `json:"page_limit"`
+}
+
+// Facilities are ordered by priority, with facilities without one last, then by name and id, in the order of
+// idx_facilities_priority_page. A page starts after the priority, name and id of the last facility of the previous
+// one. Facilities are filtered by amenities and location like ListFacilities.
+func (q *Queries) ListFacilitiesPageByPriority(ctx context.Context, arg ListFacilitiesPageByPriorityParams) ([]Facility, error) {
+	rows, err := q.db.Query(ctx, listFacilitiesPageByPriority,
+		arg.IsActive,
+		arg.Amenities,
+		arg.LocationID,
+		arg.AfterID,
+		arg.AfterRank,
+		arg.AfterName,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Facility
+	for rows.Next() {
+		var i Facility
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Location,
+			&i.Priority,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SetupBufferMinutes,
+			&i.TeardownBufferMinutes,
+			&i.RequiresApproval,
+			&i.CheckInGraceMinutes,
+			&i.Capacity,
+			&i.LocationID,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFacilityTimeZones = `-- name: ListFacilityTimeZones :many
+WITH RECURSIVE chain AS (
+    SELECT f.id AS facility_id, f.time_zone, f.location_id AS next_id, 0 AS depth
+    FROM facilities f
+    WHERE f.id = ANY($1::integer[])
+    UNION ALL
+    SELECT c.facility_id, l.time_zone, l.parent_id, c.depth + 1
+    FROM chain c
+    JOIN locations l ON l.id = c.next_id
+    WHERE c.time_zone IS NULL
+)
+SELECT DISTINCT ON (facility_id) facility_id, COALESCE(time_zone, 'UTC')::varchar AS time_zone
+FROM chain
+ORDER BY facility_id, time_zone IS NULL, depth
+`
+
+type ListFacilityTimeZonesRow struct {
+	FacilityID int32  `json:"facility_id"`
+	TimeZone   string `json:"time_zone"`
+}
+
+// The time zone of a facility is its own, else that of the nearest location containing it that sets one, else UTC.
+func (q *Queries) ListFacilityTimeZones(ctx context.Context, facilityIds []int32) ([]ListFacilityTimeZonesRow, error) {
+	rows, err := q.db.Query(ctx, listFacilityTimeZones, facilityIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFacilityTimeZonesRow
+	for rows.Next() {
+		var i ListFacilityTimeZonesRow
+		if err := rows.Scan(&i.FacilityID, &i.TimeZone); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchFacilities = `-- name: SearchFacilities :many
+WITH search AS (
+    SELECT replace(plainto_tsquery('english', $1::text)::text, ' & ', ' | ')::tsquery AS terms
+)
+SELECT f.id,
+       (ts_rank_cd(
+           setweight(to_tsvector('english', f.name), 'A')
+           || setweight(to_tsvector('english', coalesce(f.location, '')), 'B')
+           || setweight(to_tsvector('english', coalesce(f.description, '')), 'C'),
+           s.terms
+       ) + similarity(f.name, $1::text))::float8 AS rank,
+       ts_headline('english', f.name, s.terms,
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS name_headline,
+       ts_headline('english', coalesce(f.location, ''), s.terms,
+                   'HighlightAll=true, StartSel=<mark>, StopSel=</mark>')::text AS location_headline,
+       ts_headline('english', coalesce(f.description, ''), s.terms,
+                   'MaxFragments=2, StartSel=<mark>, StopSel=</mark>')::text AS description_headline
+FROM facilities f
+CROSS JOIN search s
+WHERE f.is_active = true
+  AND ((
+      setweight(to_tsvector('english', f.name), 'A')
+      || setweight(to_tsvector('english', coalesce(f.location, '')), 'B')
+      || setweight(to_tsvector('english', coalesce(f.description, '')), 'C')
+  ) @@ s.terms OR f.name % $1::text)
+ORDER BY rank DESC, f.name ASC, f.id ASC
+LIMIT $2
+`
+
+type SearchFacilitiesParams struct {
+	Query       string `json:"query"`
+	ResultLimit int32  `json:"result_limit"`
+}
+
+type SearchFacilitiesRow struct {
+	ID                  int32   `json:"id"`
+	Rank                float64 `json:"rank"`
+	NameHeadline        string  `json:"name_headline"`
+	LocationHeadline    string  `json:"location_headline"`
+	DescriptionHeadline string  `json:"description_headline"`
+}
+
+// Active facilities match when their name, location or description contains any word of the query, or when their
+// name is similar to the query. They are ranked by how well their words match, weighted by field, plus the similarity
+// of their name. Headlines enclose the matched words in <mark> and </mark>.
+// The document must be the expression of idx_facilities_search for the index to be used.
+func (q *Queries) SearchFacilities(ctx context.Context, arg SearchFacilitiesParams) ([]SearchFacilitiesRow, error) {
+	rows, err := q.db.Query(ctx, searchFacilities, arg.Query, arg.ResultLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchFacilitiesRow
+	for rows.Next() {
+		var i SearchFacilitiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Rank,
+			&i.NameHeadline,
+			&i.LocationHeadline,
+			&i.DescriptionHeadline,
 		); err != nil {
 			return nil, err
 		}
@@ -157,18 +585,34 @@ SET name = $2,
     location = $4,
     priority = $5,
     is_active = $6,
+    setup_buffer_minutes = $7,
+    teardown_buffer_minutes = $8,
+    requires_approval = $9,
+    check_in_grace_minutes = $10,
+    capacity = $11,
+    location_id = $12,
+    time_zone = $13,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone
 `
 
 type UpdateFacilityParams struct {
-	ID          int32   `json:"id"`
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
-	Location    *string `json:"location"`
-	Priority    *int64  `json:"priority"`
-	IsActive    bool    `json:"is_active"`
+	ID                    int32      `json:"id"`
+	Name                  string     `json:"name"`
+	Description           *string    `json:"description"`
+	Location              *string    `json:"location"`
+	Priority              *int64     `json:"priority"`
+	IsActive              bool       `json:"is_active"`
+	SetupBufferMinutes    int32      `json:"setup_buffer_minutes"`
+	TeardownBufferMinutes int32      `json:"teardown_buffer_minutes"`
+	RequiresApproval      bool       `json:"requires_approval"`
+	CheckInGraceMinutes   *int32     `json:"check_in_grace_minutes"`
+	Capacity              *int32     `json:"capacity"`
+	LocationID            *uuid.UUID `json:"location_id"`
+	TimeZone              *string    `json:"time_zone"`
 }
 
 func (q *Queries) UpdateFacility(ctx context.Context, arg UpdateFacilityParams) (Facility, error) {
@@ -179,6 +623,13 @@ func (q *Queries) UpdateFacility(ctx context.Context, arg UpdateFacilityParams)
 		arg.Location,
 		arg.Priority,
 		arg.IsActive,
+		arg.SetupBufferMinutes,
+		arg.TeardownBufferMinutes,
+		arg.RequiresApproval,
+		arg.CheckInGraceMinutes,
+		arg.Capacity,
+		arg.LocationID,
+		arg.TimeZone,
 	)
 	var i Facility
 	err := row.Scan(
@@ -190,6 +641,13 @@ func (q *Queries) UpdateFacility(ctx context.Context, arg UpdateFacilityParams)
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
 	)
 	return i, err
 }
@@ -201,18 +659,34 @@ SET name = COALESCE($1, name),
     location = COALESCE($3, location),
     priority = COALESCE($4, priority),
     is_active = COALESCE($5, is_active),
+    setup_buffer_minutes = COALESCE($6, setup_buffer_minutes),
+    teardown_buffer_minutes = COALESCE($7, teardown_buffer_minutes),
+    requires_approval = COALESCE($8, requires_approval),
+    check_in_grace_minutes = COALESCE($9, check_in_grace_minutes),
+    capacity = COALESCE($10, capacity),
+    location_id = COALESCE($11, location_id),
+    time_zone = COALESCE($12, time_zone),
     updated_at = NOW()
-WHERE id = $6
-RETURNING id, name, description, location, priority, is_active, created_at, updated_at
+WHERE id = $13
+RETURNING id, name, description, location, priority, is_active, created_at, updated_at,
+          setup_buffer_minutes, teardown_buffer_minutes, requires_approval, check_in_grace_minutes, capacity,
+          location_id, time_zone
 `
 
 type UpdateFacilityPartialParams struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Location    *string `json:"location"`
-	Priority    *int64  `json:"priority"`
-	IsActive    *bool   `json:"is_active"`
-	ID          int32   `json:"id"`
+	Name                  *string    `json:"name"`
+	Description           *string    `json:"description"`
+	Location              *string    `json:"location"`
+	Priority              *int64     `json:"priority"`
+	IsActive              *bool      `json:"is_active"`
+	SetupBufferMinutes    *int32     `json:"setup_buffer_minutes"`
+	TeardownBufferMinutes *int32     `json:"teardown_buffer_minutes"`
+	RequiresApproval      *bool      `json:"requires_approval"`
+	CheckInGraceMinutes   *int32     `json:"check_in_grace_minutes"`
+	Capacity              *int32     `json:"capacity"`
+	LocationID            *uuid.UUID `json:"location_id"`
+	TimeZone              *string    `json:"time_zone"`
+	ID                    int32      `json:"id"`
 }
 
 func (q *Queries) UpdateFacilityPartial(ctx context.Context, arg UpdateFacilityPartialParams) (Facility, error) {
@@ -222,6 +696,13 @@ func (q *Queries) UpdateFacilityPartial(ctx context.Context, arg UpdateFacilityP
 		arg.Location,
 		arg.Priority,
 		arg.IsActive,
+		arg.SetupBufferMinutes,
+		arg.TeardownBufferMinutes,
+		arg.RequiresApproval,
+		arg.CheckInGraceMinutes,
+		arg.Capacity,
+		arg.LocationID,
+		arg.TimeZone,
 		arg.ID,
 	)
 	var i Facility
@@ -234,6 +715,13 @@ func (q *Queries) UpdateFacilityPartial(ctx context.Context, arg UpdateFacilityP
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SetupBufferMinutes,
+		&i.TeardownBufferMinutes,
+		&i.RequiresApproval,
+		&i.CheckInGraceMinutes,
+		&i.Capacity,
+		&i.LocationID,
+		&i.TimeZone,
 	)
 	return i, err
 }
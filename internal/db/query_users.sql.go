@@ -12,6 +12,19 @@ import (
 	uuid "github.com/google/uuid"
 )
 
+const countUsersByIDs = `-- name: CountUsersByIDs :one
+SELECT COUNT(*)
+FROM users
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) CountUsersByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsersByIDs, ids)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createToken = `-- name: CreateToken :one
 INSERT INTO user_tokens (id, user_id, token, name, expires_at)
 VALUES ($1, $2, $3, $4, $5)
@@ -47,25 +60,32 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (UserT
 }
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (id, username, is_staff)
-VALUES ($1, $2, $3)
-RETURNING id, username, is_staff, created_at
+INSERT INTO users (id, username, is_staff, email)
+VALUES ($1, $2, $3, $4)
+RETURNING id, username, is_staff, created_at, email
 `
 
 type CreateUserParams struct {
 	ID       uuid.UUID `json:"id"`
 	Username string    `json:"username"`
 	IsStaff  bool      `json:"is_staff"`
+	Email    *string   `json:"email"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.ID, arg.Username, arg.IsStaff)
+	row := q.db.QueryRow(ctx, createUser,
+		arg.ID,
+		arg.Username,
+		arg.IsStaff,
+		arg.Email,
+	)
 	var i User
 	err := row.Scan(
 		&i.ID,
 		&i.Username,
 		&i.IsStaff,
 		&i.CreatedAt,
+		&i.Email,
 	)
 	return i, err
 }
@@ -80,18 +100,27 @@ func (q *Queries) DeleteToken(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const deleteUser = `-- name: DeleteUser :exec
+const deleteUser = `-- name: DeleteUser :execrows
+WITH detached AS (
+    UPDATE reservations
+    SET attendee_user_ids = array_remove(attendee_user_ids, $1)
+    WHERE $1 = ANY(attendee_user_ids)
+)
 DELETE FROM users
 WHERE id = $1
 `
 
-func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteUser, id)
-	return err
+// The user is removed from the attendees of reservations as well.
+func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users 
 WHERE id = $1
 `
@@ -104,6 +133,27 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.Username,
 		&i.IsStaff,
 		&i.CreatedAt,
+		&i.Email,
+	)
+	return i, err
+}
+
+const getUserByIDForUpdate = `-- name: GetUserByIDForUpdate :one
+SELECT id, username, is_staff, created_at, email
+FROM users
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetUserByIDForUpdate(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByIDForUpdate, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.IsStaff,
+		&i.CreatedAt,
+		&i.Email,
 	)
 	return i, err
 }
@@ -132,7 +182,7 @@ func (q *Queries) GetUserByToken(ctx context.Context, token string) (GetUserByTo
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users 
 WHERE username = $1
 `
@@ -145,6 +195,7 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.Username,
 		&i.IsStaff,
 		&i.CreatedAt,
+		&i.Email,
 	)
 	return i, err
 }
@@ -184,7 +235,7 @@ func (q *Queries) ListUserTokens(ctx context.Context, userID uuid.UUID) ([]UserT
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, username, is_staff, created_at
+SELECT id, username, is_staff, created_at, email
 FROM users
 ORDER BY created_at
 `
@@ -203,6 +254,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 			&i.Username,
 			&i.IsStaff,
 			&i.CreatedAt,
+			&i.Email,
 		); err != nil {
 			return nil, err
 		}
@@ -213,3 +265,35 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 	}
 	return items, nil
 }
+
+const updateUser = `-- name: UpdateUser :one
+UPDATE users
+SET username = $2, email = $3, is_staff = $4
+WHERE id = $1
+RETURNING id, username, is_staff, created_at, email
+`
+
+type UpdateUserParams struct {
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Email    *string   `json:"email"`
+	IsStaff  bool      `json:"is_staff"`
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUser,
+		arg.ID,
+		arg.Username,
+		arg.Email,
+		arg.IsStaff,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.IsStaff,
+		&i.CreatedAt,
+		&i.Email,
+	)
+	return i, err
+}
@@ -167,6 +167,7 @@ func TestDatabaseService_Transaction_Rollback(t *testing.T) {
 			ID:       userID,
 			Username: username,
 			IsStaff:  false,
+			Email:    nil,
 		})
 		require.NoError(t, err)
 
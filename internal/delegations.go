@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+var (
+	// errDelegationRequired is returned when the caller books for a user who did not grant them delegation.
+	errDelegationRequired = errors.New("delegation grant required")
+	// errOwnerNotFound is returned when the caller books for a user who does not exist.
+	errOwnerNotFound = errors.New("reservation owner not found")
+)
+
+// canActFor reports whether the caller may book and manage reservations of the user:
+// staff users act for everyone, other users for themselves and the users who granted them delegation.
+// Grants are looked up on every call, so revoking one takes effect immediately.
+func canActFor(ctx context.Context, q db.Querier, caller *AuthenticatedUser, userID uuid.UUID) (bool, error) {
+	if caller.IsStaff || caller.ID == userID.String() {
+		return true, nil
+	}
+
+	delegateID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return false, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+	granted, err := q.HasDelegationGrant(ctx, db.HasDelegationGrantParams{
+		GrantorID:  userID,
+		DelegateID: delegateID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to look up delegation grant: %w", err)
+	}
+	return granted, nil
+}
+
+// reservationOwner returns the owner of a reservation booked by the caller for the requested user,
+// defaulting to the caller, together with the ID of the caller recorded as its booker.
+// It returns errDelegationRequired or errOwnerNotFound when the caller cannot book for the requested user.
+func reservationOwner(
+	ctx context.Context,
+	q db.Querier,
+	caller *AuthenticatedUser,
+	requested api.OptUUID,
+) (ownerID, bookedBy uuid.UUID, err error) {
+	bookedBy, err = uuid.Parse(caller.ID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid authenticated user ID: %w", err)
+	}
+	ownerID = requested.Or(bookedBy)
+	if ownerID == bookedBy {
+		return ownerID, bookedBy, nil
+	}
+
+	allowed, err := canActFor(ctx, q, caller, ownerID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if !allowed {
+		return uuid.Nil, uuid.Nil, errDelegationRequired
+	}
+	_, err = q.GetUserByID(ctx, ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, uuid.Nil, errOwnerNotFound
+	}
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return ownerID, bookedBy, nil
+}
+
+func delegationRequiredProblem() *api.ProblemDetails {
+	return newProblem(http.StatusForbidden, "The user has not granted you delegation to book on their behalf.")
+}
+
+func ownerNotFoundProblem() *api.ProblemDetails {
+	return newProblem(http.StatusBadRequest, "user_id does not identify a user.")
+}
@@ -1,3 +0,0 @@
-package middlewares
-
-var WithUser = withUser
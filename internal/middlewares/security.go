@@ -0,0 +1,44 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+// SecurityHandler resolves bearer tokens for operations that declare BearerAuth.
+// Operations without a security requirement never reach it, so they stay anonymous.
+type SecurityHandler struct {
+	querier internal.UserTokenQuerier
+}
+
+var _ api.SecurityHandler = (*SecurityHandler)(nil)
+
+// NewSecurityHandler creates a SecurityHandler that validates tokens against the given querier.
+func NewSecurityHandler(querier internal.UserTokenQuerier) *SecurityHandler {
+	return &SecurityHandler{querier: querier}
+}
+
+// HandleBearerAuth validates the bearer token and stores the authenticated user in the context.
+func (h *SecurityHandler) HandleBearerAuth(
+	ctx context.Context,
+	operationName api.OperationName,
+	t api.BearerAuth,
+) (context.Context, error) {
+	user, err := internal.GetAuthenticatedUser(ctx, h.querier, t.Token)
+	if err != nil {
+		return ctx, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	slog.InfoContext(ctx, "user authenticated",
+		"operation", operationName,
+		"user_id", user.ID,
+		"username", user.Username,
+		"is_staff", user.IsStaff,
+	)
+
+	return internal.WithAuthenticatedUser(ctx, user), nil
+}
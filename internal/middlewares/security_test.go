@@ -0,0 +1,136 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/middlewares"
+)
+
+// mockUserTokenQuerier implements internal.UserTokenQuerier for testing.
+type mockUserTokenQuerier struct {
+	getUserByTokenFunc func(ctx context.Context, token string) (db.GetUserByTokenRow, error)
+}
+
+func (m *mockUserTokenQuerier) GetUserByToken(ctx context.Context, token string) (db.GetUserByTokenRow, error) {
+	if m.getUserByTokenFunc != nil {
+		return m.getUserByTokenFunc(ctx, token)
+	}
+	return db.GetUserByTokenRow{
+		ID:       uuid.UUID{},
+		Username: "",
+		IsStaff:  false,
+	}, nil
+}
+
+func TestSecurityHandler_HandleBearerAuth(t *testing.T) {
+	testUserID := uuid.New()
+	validToken := "valid-token-123"
+
+	querier := &mockUserTokenQuerier{
+		getUserByTokenFunc: func(_ context.Context, token string) (db.GetUserByTokenRow, error) {
+			if token != validToken {
+				return db.GetUserByTokenRow{}, errors.New("no rows in result set")
+			}
+			return db.GetUserByTokenRow{
+				ID:       testUserID,
+				Username: "testuser",
+				IsStaff:  true,
+			}, nil
+		},
+	}
+	handler := middlewares.NewSecurityHandler(querier)
+
+	t.Run("valid token stores user in context", func(t *testing.T) {
+		ctx, err := handler.HandleBearerAuth(t.Context(), api.FacilitiesCreateOperation, api.BearerAuth{
+			Token: validToken,
+			Roles: nil,
+		})
+		require.NoError(t, err)
+
+		user, ok := internal.AuthenticatedUserFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, testUserID.String(), user.ID)
+		assert.True(t, user.IsStaff)
+	})
+
+	t.Run("invalid token is rejected", func(t *testing.T) {
+		_, err := handler.HandleBearerAuth(t.Context(), api.FacilitiesCreateOperation, api.BearerAuth{
+			Token: "invalid-token",
+			Roles: nil,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestSecurityHandler_Server(t *testing.T) {
+	regularToken := "regular-token"
+
+	querier := &mockUserTokenQuerier{
+		getUserByTokenFunc: func(_ context.Context, token string) (db.GetUserByTokenRow, error) {
+			if token != regularToken {
+				return db.GetUserByTokenRow{}, errors.New("no rows in result set")
+			}
+			return db.GetUserByTokenRow{
+				ID:       uuid.New(),
+				Username: "regular-user",
+				IsStaff:  false,
+			}, nil
+		},
+	}
+
+	// Requests in this test are rejected before the handlers touch the database.
+	server, err := api.NewServer(internal.NewAPIService(nil), middlewares.NewSecurityHandler(querier))
+	require.NoError(t, err)
+
+	newCreateRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/facilities/", strings.NewReader(`{"id":0,"name":"Room A"}`))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("secured operation without token returns 401", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, newCreateRequest())
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "UNAUTHORIZED")
+	})
+
+	t.Run("secured operation with invalid token returns 401", func(t *testing.T) {
+		req := newCreateRequest()
+		req.Header.Set("Authorization", "Bearer invalid-token")
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("secured operation with non-staff token returns 403", func(t *testing.T) {
+		req := newCreateRequest()
+		req.Header.Set("Authorization", "Bearer "+regularToken)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("anonymous operation is reachable without token", func(t *testing.T) {
+		// ID 0 never identifies a facility, so the handler answers 404 without a database.
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/facilities/0/", nil)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// Notifier delivers notifications about changes users did not make themselves.
+type Notifier interface {
+	// WaitlistPromoted notifies the user of a waitlist entry that it was promoted to a reservation.
+	WaitlistPromoted(ctx context.Context, entry db.WaitlistEntry)
+}
+
+// logNotifier is the default Notifier. It records notifications in the log
+// until a delivery channel such as email is configured.
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+// newLogNotifier creates a Notifier recording notifications with logger.
+func newLogNotifier(logger *slog.Logger) Notifier {
+	return logNotifier{logger: logger}
+}
+
+func (n logNotifier) WaitlistPromoted(ctx context.Context, entry db.WaitlistEntry) {
+	n.logger.InfoContext(ctx, "waitlist entry promoted",
+		"user_id", entry.UserID,
+		"waitlist_entry_id", entry.ID,
+		"reservation_id", entry.ReservationID,
+		"facility_id", entry.FacilityID,
+	)
+}
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// errOutsideOpeningHours is returned inside transactions when a moved reservation falls outside the opening hours
+// of its facility.
+var errOutsideOpeningHours = errors.New("reservation is outside opening hours")
+
+// endOfDay is the offset of a closing time at midnight of the following day, stored as 24:00.
+const endOfDay = 24 * time.Hour
+
+// dayPeriod is an opening period within a day given as offsets from midnight.
+type dayPeriod struct {
+	opens  time.Duration
+	closes time.Duration
+}
+
+// openPeriod is a period [start, end) in which a facility is open.
+type openPeriod struct {
+	start time.Time
+	end   time.Time
+}
+
+// openingHours is the calendar of a facility built from its weekly rules and date overrides, interpreted in the
+// time zone of the facility. An override replaces the weekly rules for its date. A facility without weekly rules
+// is open all day except on dates with an override.
+type openingHours struct {
+	weekly    [7][]dayPeriod
+	hasRules  bool
+	overrides map[string][]dayPeriod
+	loc       *time.Location
+}
+
+// newOpeningHours builds the calendar of a single facility.
+func newOpeningHours(
+	rules []db.FacilityOpeningHour,
+	overrides []db.FacilityOpeningHourOverride,
+	loc *time.Location,
+) openingHours {
+	h := openingHours{
+		weekly:    [7][]dayPeriod{},
+		hasRules:  len(rules) > 0,
+		overrides: make(map[string][]dayPeriod, len(overrides)),
+		loc:       loc,
+	}
+	for _, r := range rules {
+		h.weekly[r.Weekday] = append(h.weekly[r.Weekday], dayPeriod{
+			opens:  dayOffset(r.OpensAt),
+			closes: dayOffset(r.ClosesAt),
+		})
+	}
+	for i := range h.weekly {
+		sort.Slice(h.weekly[i], func(a, b int) bool { return h.weekly[i][a].opens < h.weekly[i][b].opens })
+	}
+	for _, o := range overrides {
+		periods := make([]dayPeriod, 0, 1)
+		if o.OpensAt.Valid && o.ClosesAt.Valid {
+			periods = append(periods, dayPeriod{opens: dayOffset(o.OpensAt), closes: dayOffset(o.ClosesAt)})
+		}
+		h.overrides[o.Date.Format(time.DateOnly)] = periods
+	}
+	return h
+}
+
+// day returns the opening periods on the given date, given as midnight UTC.
+func (h openingHours) day(date time.Time) []dayPeriod {
+	if periods, ok := h.overrides[date.Format(time.DateOnly)]; ok {
+		return periods
+	}
+	if !h.hasRules {
+		return []dayPeriod{{opens: 0, closes: endOfDay}}
+	}
+	return h.weekly[date.Weekday()]
+}
+
+// openPeriods returns the periods within [from, to) in which the facility is open, ordered by start time.
+// Adjacent periods, including those continuing across midnight, are merged.
+func (h openingHours) openPeriods(from, to time.Time) []openPeriod {
+	periods := make([]openPeriod, 0)
+	for date := civilDate(from, h.loc); localTime(date, h.loc).Before(to); date = date.AddDate(0, 0, 1) {
+		for _, p := range h.day(date) {
+			start, end := localTime(date.Add(p.opens), h.loc), localTime(date.Add(p.closes), h.loc)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if !start.Before(end) {
+				continue
+			}
+			if n := len(periods); n > 0 && !start.After(periods[n-1].end) {
+				if end.After(periods[n-1].end) {
+					periods[n-1].end = end
+				}
+				continue
+			}
+			periods = append(periods, openPeriod{start: start, end: end})
+		}
+	}
+	return periods
+}
+
+// covers reports whether the facility is open during the whole period [start, end).
+func (h openingHours) covers(start, end time.Time) bool {
+	periods := h.openPeriods(start, end)
+	return len(periods) == 1 && periods[0].start.Equal(start) && periods[0].end.Equal(end)
+}
+
+// loadOpeningHours loads the calendars of the given facilities for the dates overlapping [from, to) in their
+// time zones.
+func loadOpeningHours(
+	ctx context.Context,
+	q db.Querier,
+	facilityIDs []int32,
+	from, to time.Time,
+) (map[int32]openingHours, error) {
+	rules, err := q.ListOpeningHours(ctx, facilityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	zones, err := facilityTimeZones(ctx, q, facilityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The dates of [from, to) differ by at most a day between time zones, so the range of dates in UTC widened by
+	// a day covers them in every zone.
+	fromDate, toDate := civilDate(from, time.UTC).AddDate(0, 0, -1), civilDate(to, time.UTC).AddDate(0, 0, 1)
+	overrides, err := q.ListOpeningHourOverrides(ctx, db.ListOpeningHourOverridesParams{
+		FacilityIds: facilityIDs,
+		From:        &fromDate,
+		To:          &toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening hour overrides: %w", err)
+	}
+
+	rulesByFacility := make(map[int32][]db.FacilityOpeningHour)
+	for _, r := range rules {
+		rulesByFacility[r.FacilityID] = append(rulesByFacility[r.FacilityID], r)
+	}
+	overridesByFacility := make(map[int32][]db.FacilityOpeningHourOverride)
+	for _, o := range overrides {
+		overridesByFacility[o.FacilityID] = append(overridesByFacility[o.FacilityID], o)
+	}
+
+	calendars := make(map[int32]openingHours, len(facilityIDs))
+	for _, id := range facilityIDs {
+		calendars[id] = newOpeningHours(rulesByFacility[id], overridesByFacility[id], zones[id])
+	}
+	return calendars, nil
+}
+
+// withinOpeningHours reports whether the facility is open during the whole period [startsAt, endsAt).
+func withinOpeningHours(
+	ctx context.Context,
+	q db.Querier,
+	facilityID int32,
+	startsAt, endsAt time.Time,
+) (bool, error) {
+	calendars, err := loadOpeningHours(ctx, q, []int32{facilityID}, startsAt, endsAt)
+	if err != nil {
+		return false, err
+	}
+	return calendars[facilityID].covers(startsAt, endsAt), nil
+}
+
+// civilDate returns the date of t in loc as midnight UTC, the representation of a DATE column.
+func civilDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// dayOffset converts a TIME column into an offset from midnight.
+func dayOffset(t pgtype.Time) time.Duration {
+	return time.Duration(t.Microseconds) * time.Microsecond
+}
+
+// toPgTime converts an offset from midnight into a TIME column.
+func toPgTime(offset time.Duration) pgtype.Time {
+	return pgtype.Time{Microseconds: offset.Microseconds(), Valid: true}
+}
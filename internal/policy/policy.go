@@ -0,0 +1,120 @@
+// Package policy evaluates the booking policies of facilities against requested reservations.
+package policy
+
+import (
+	"fmt"
+	"time"
+)
+
+// Rule identifies a rule of a booking policy.
+type Rule string
+
+const (
+	// RuleMinDuration requires reservations to last at least a given duration.
+	RuleMinDuration Rule = "min_duration"
+	// RuleMaxDuration requires reservations to last at most a given duration.
+	RuleMaxDuration Rule = "max_duration"
+	// RuleGranularity requires reservations to start and end on multiples of a given duration from midnight.
+	RuleGranularity Rule = "granularity"
+	// RuleAdvanceWindow limits how far ahead of its start a reservation may be made.
+	RuleAdvanceWindow Rule = "advance_window"
+	// RuleSameDayCutoff forbids reservations for the same day after a given time of day.
+	RuleSameDayCutoff Rule = "same_day_cutoff"
+)
+
+// Policy holds the rules reservations of a facility must follow. A nil rule is not enforced.
+type Policy struct {
+	MinDuration *time.Duration
+	MaxDuration *time.Duration
+	Granularity *time.Duration
+	MaxAdvance  *time.Duration
+	// SameDayCutoff is the time of day, as an offset from midnight, after which the facility
+	// can no longer be reserved for the same day.
+	SameDayCutoff *time.Duration
+}
+
+// Request is a reservation to be checked against a policy.
+type Request struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	// Now is the time the reservation is made at.
+	Now time.Time
+	// Location is the time zone in which times of day and dates are interpreted.
+	Location *time.Location
+}
+
+// Violation is a rule a request fails to satisfy.
+type Violation struct {
+	Rule   Rule
+	Detail string
+}
+
+// Evaluate checks a request against every rule of the policy and returns all violations in rule order.
+// It returns an empty slice when the request satisfies the policy.
+func Evaluate(p Policy, r Request) []Violation {
+	violations := make([]Violation, 0)
+	duration := r.EndsAt.Sub(r.StartsAt)
+
+	if p.MinDuration != nil && duration < *p.MinDuration {
+		violations = append(violations, Violation{
+			Rule:   RuleMinDuration,
+			Detail: fmt.Sprintf("The reservation must last at least %d minutes.", minutes(*p.MinDuration)),
+		})
+	}
+	if p.MaxDuration != nil && duration > *p.MaxDuration {
+		violations = append(violations, Violation{
+			Rule:   RuleMaxDuration,
+			Detail: fmt.Sprintf("The reservation must last at most %d minutes.", minutes(*p.MaxDuration)),
+		})
+	}
+	if p.Granularity != nil && (!aligned(r.StartsAt.In(r.Location), *p.Granularity) ||
+		!aligned(r.EndsAt.In(r.Location), *p.Granularity)) {
+		violations = append(violations, Violation{
+			Rule: RuleGranularity,
+			Detail: fmt.Sprintf("The reservation must start and end on a multiple of %d minutes.",
+				minutes(*p.Granularity)),
+		})
+	}
+	if p.MaxAdvance != nil {
+		if latest := r.Now.Add(*p.MaxAdvance); r.StartsAt.After(latest) {
+			violations = append(violations, Violation{
+				Rule: RuleAdvanceWindow,
+				Detail: fmt.Sprintf("The reservation must not start later than %s.",
+					latest.In(r.Location).Format(time.RFC3339)),
+			})
+		}
+	}
+	if p.SameDayCutoff != nil {
+		now := r.Now.In(r.Location)
+		if sameDate(r.StartsAt.In(r.Location), now) && sinceMidnight(now) >= *p.SameDayCutoff {
+			cutoff := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(*p.SameDayCutoff)
+			violations = append(violations, Violation{
+				Rule:   RuleSameDayCutoff,
+				Detail: fmt.Sprintf("Reservations for the same day must be made before %s.", cutoff.Format("15:04")),
+			})
+		}
+	}
+	return violations
+}
+
+// aligned reports whether the wall clock time of t is a multiple of granularity from midnight.
+func aligned(t time.Time, granularity time.Duration) bool {
+	return granularity <= 0 || sinceMidnight(t)%granularity == 0
+}
+
+// sinceMidnight returns the wall clock time of t as an offset from midnight.
+func sinceMidnight(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second +
+		time.Duration(t.Nanosecond())
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func minutes(d time.Duration) int64 {
+	return int64(d / time.Minute)
+}
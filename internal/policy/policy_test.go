@@ -0,0 +1,104 @@
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal/policy"
+)
+
+func durationOf(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestEvaluate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 2030-01-07 15:00 in Tokyo.
+	now := time.Date(2030, 1, 7, 6, 0, 0, 0, time.UTC)
+	p := policy.Policy{
+		MinDuration:   durationOf(30 * time.Minute),
+		MaxDuration:   durationOf(2 * time.Hour),
+		Granularity:   durationOf(15 * time.Minute),
+		MaxAdvance:    durationOf(7 * 24 * time.Hour),
+		SameDayCutoff: durationOf(12 * time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		policy   policy.Policy
+		startsAt time.Time
+		endsAt   time.Time
+		want     []policy.Rule
+	}{
+		{
+			name:     "satisfied",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 8, 9, 0, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 8, 10, 30, 0, 0, tokyo),
+			want:     []policy.Rule{},
+		},
+		{
+			name:     "too short",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 8, 9, 0, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 8, 9, 15, 0, 0, tokyo),
+			want:     []policy.Rule{policy.RuleMinDuration},
+		},
+		{
+			name:     "too long and misaligned",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 8, 9, 0, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 8, 11, 10, 0, 0, tokyo),
+			want:     []policy.Rule{policy.RuleMaxDuration, policy.RuleGranularity},
+		},
+		{
+			name:     "beyond the advance window",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 20, 9, 0, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 20, 10, 0, 0, 0, tokyo),
+			want:     []policy.Rule{policy.RuleAdvanceWindow},
+		},
+		{
+			name:     "same day after the cutoff",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 7, 18, 0, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 7, 19, 0, 0, 0, tokyo),
+			want:     []policy.Rule{policy.RuleSameDayCutoff},
+		},
+		{
+			name:     "every rule at once",
+			policy:   p,
+			startsAt: time.Date(2030, 1, 7, 18, 5, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 1, 7, 18, 10, 0, 0, tokyo),
+			want:     []policy.Rule{policy.RuleMinDuration, policy.RuleGranularity, policy.RuleSameDayCutoff},
+		},
+		{
+			name:     "empty policy",
+			policy:   policy.Policy{},
+			startsAt: time.Date(2030, 1, 7, 18, 5, 0, 0, tokyo),
+			endsAt:   time.Date(2030, 3, 7, 18, 10, 0, 0, tokyo),
+			want:     []policy.Rule{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := policy.Evaluate(tt.policy, policy.Request{
+				StartsAt: tt.startsAt,
+				EndsAt:   tt.endsAt,
+				Now:      now,
+				Location: tokyo,
+			})
+
+			rules := make([]policy.Rule, 0, len(violations))
+			for _, v := range violations {
+				assert.NotEmpty(t, v.Detail)
+				rules = append(rules, v.Rule)
+			}
+			assert.Equal(t, tt.want, rules)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// maxSeriesOccurrences bounds the number of reservations a single series can expand into.
+const maxSeriesOccurrences = 366
+
+// errInvalidRecurrence is returned when a series cannot be expanded into occurrences.
+var errInvalidRecurrence = errors.New("invalid recurrence")
+
+// occurrence is the period of a single reservation of a series.
+type occurrence struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// loadSeriesLocation resolves the IANA time zone in which a series is expanded.
+func loadSeriesLocation(name string) (*time.Location, error) {
+	loc, err := loadTimeZone(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidRecurrence, err)
+	}
+	return loc, nil
+}
+
+// expandRecurrence expands an RFC 5545 RRULE whose first occurrence is [startsAt, endsAt).
+// The rule is evaluated in loc so that occurrences keep their wall-clock time across DST changes,
+// and every occurrence lasts as long as the first one. Occurrences other than startsAt itself at a wall-clock time
+// skipped or repeated by a DST change are resolved as localTime does.
+func expandRecurrence(rule string, startsAt, endsAt time.Time, loc *time.Location) ([]occurrence, error) {
+	if strings.ContainsAny(rule, "\r\n") {
+		return nil, fmt.Errorf("%w: the rule must not contain DTSTART", errInvalidRecurrence)
+	}
+
+	opt, err := rrule.StrToROptionInLocation(rule, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidRecurrence, err)
+	}
+	// Sub-daily frequencies follow DAILY in rrule.Frequency.
+	if opt.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("%w: FREQ must be DAILY, WEEKLY, MONTHLY or YEARLY", errInvalidRecurrence)
+	}
+	if opt.Count <= 0 && opt.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT or UNTIL is required", errInvalidRecurrence)
+	}
+	opt.Dtstart = startsAt.In(loc)
+
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidRecurrence, err)
+	}
+
+	duration := endsAt.Sub(startsAt)
+	occurrences := make([]occurrence, 0)
+	next := r.Iterator()
+	for start, ok := next(); ok; start, ok = next() {
+		if len(occurrences) == maxSeriesOccurrences {
+			return nil, fmt.Errorf("%w: the rule yields more than %d occurrences",
+				errInvalidRecurrence, maxSeriesOccurrences)
+		}
+		// rrule-go leaves the resolution of such times to time.Date, which does not guarantee one.
+		if !start.Equal(startsAt) {
+			start = localTime(wallClock(start), loc)
+		}
+		occurrences = append(occurrences, occurrence{
+			StartsAt: start,
+			EndsAt:   start.Add(duration),
+		})
+	}
+	if len(occurrences) == 0 {
+		return nil, fmt.Errorf("%w: the rule yields no occurrences", errInvalidRecurrence)
+	}
+	return occurrences, nil
+}
+
+// splitRecurrence splits a rule whose first occurrence starts at startsAt into the rules before and from at.
+// The tail rule keeps the remaining COUNT so that both rules together yield as many occurrences as the original.
+// It also reports the number of occurrences before at; the head rule is meaningless when it is zero.
+func splitRecurrence(rule string, startsAt, at time.Time, loc *time.Location) (string, string, int, error) {
+	opt, err := rrule.StrToROptionInLocation(rule, loc)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("%w: %w", errInvalidRecurrence, err)
+	}
+	occurrences, err := expandRecurrence(rule, startsAt, startsAt, loc)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	before := 0
+	for _, o := range occurrences {
+		if o.StartsAt.Before(at) {
+			before++
+		}
+	}
+
+	tail := *opt
+	if tail.Count > 0 {
+		tail.Count -= before
+	}
+
+	// UNTIL is inclusive, so the head ends one second before the split occurrence.
+	head := *opt
+	head.Count = 0
+	head.Until = at.Add(-time.Second)
+
+	return head.RRuleString(), tail.RRuleString(), before, nil
+}
+
+// shiftWallClock moves t by the wall-clock distance between from and to in loc.
+// Shifting by wall-clock time keeps occurrences on both sides of a DST change at the same local time.
+func shiftWallClock(t, from, to time.Time, loc *time.Location) time.Time {
+	delta := wallClock(to.In(loc)).Sub(wallClock(from.In(loc)))
+	return localTime(wallClock(t.In(loc)).Add(delta), loc)
+}
+
+// wallClock returns the local date and time of t as if it were UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/db"
+	"github.com/thara/facility_reservation_go/internal/derrors"
+)
+
+// Sweeper periodically releases periods blocked by reservations that can no longer become confirmed or be used:
+// holds whose TTL has passed, pending requests that started without a decision
+// and reservations not checked in by the grace deadline of their facility.
+// Waitlist entries fitting the released periods are promoted to reservations.
+type Sweeper struct {
+	ds       *DataStore
+	interval time.Duration
+	notifier Notifier
+}
+
+// SweeperOption configures optional dependencies of a Sweeper.
+type SweeperOption func(*Sweeper)
+
+// WithSweeperNotifier makes the sweeper deliver notifications through n. By default they are only logged.
+func WithSweeperNotifier(n Notifier) SweeperOption {
+	return func(s *Sweeper) {
+		s.notifier = n
+	}
+}
+
+// NewSweeper creates a new Sweeper running every interval.
+func NewSweeper(ds *DataStore, interval time.Duration, opts ...SweeperOption) *Sweeper {
+	s := &Sweeper{
+		ds:       ds,
+		interval: interval,
+		notifier: newLogNotifier(slog.Default()),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run sweeps on every tick until ctx is cancelled. Failures are logged and retried on the next tick.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.Sweep(ctx, now); err != nil {
+				slog.ErrorContext(ctx, "failed to sweep reservations", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep deletes the holds expired at now, expires the pending requests started by now
+// and releases the reservations whose check-in grace deadline passed by now as no-shows.
+// Waitlist entries fitting the released periods are promoted in the same transaction,
+// and their users are notified once it commits.
+func (s *Sweeper) Sweep(ctx context.Context, now time.Time) (err error) {
+	defer derrors.Wrap(&err, "Sweeper.Sweep(ctx, %s)", now)
+
+	var holds, pending, noShows []db.Reservation
+	var promoted []db.WaitlistEntry
+	err = s.ds.Transaction(ctx, func(ctx context.Context, tx *Transaction) error {
+		var err error
+		holds, err = tx.DeleteExpiredHolds(ctx, now)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired holds: %w", err)
+		}
+		pending, err = tx.ExpirePendingReservations(ctx, now)
+		if err != nil {
+			return fmt.Errorf("failed to expire pending reservations: %w", err)
+		}
+		noShows, err = tx.ReleaseNoShows(ctx, now)
+		if err != nil {
+			return fmt.Errorf("failed to release no-shows: %w", err)
+		}
+		promoted, err = promoteWaitlists(ctx, tx, slices.Concat(holds, pending, noShows))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("transaction failed: %w", err)
+	}
+
+	for _, entry := range promoted {
+		s.notifier.WaitlistPromoted(ctx, entry)
+	}
+
+	if len(holds) > 0 || len(pending) > 0 || len(noShows) > 0 {
+		slog.InfoContext(ctx, "swept reservations",
+			"expired_holds", len(holds), "expired_pending", len(pending), "no_shows", len(noShows),
+			"promoted_waitlist_entries", len(promoted))
+	}
+	return nil
+}
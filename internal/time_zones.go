@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thara/facility_reservation_go/internal/api"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// dstSearchWindow is longer than any UTC offset, so the offsets in effect that long before and after a wall-clock
+// time are those on either side of a DST change at that time.
+const dstSearchWindow = 24 * time.Hour
+
+// defaultTimeZone is the time zone of facilities for which neither they nor the locations containing them set one.
+var defaultTimeZone = time.UTC
+
+// errUnknownTimeZone is returned when a name does not identify an IANA time zone.
+var errUnknownTimeZone = errors.New("unknown time zone")
+
+// loadTimeZone resolves an IANA time zone name.
+func loadTimeZone(name string) (*time.Location, error) {
+	// time.LoadLocation maps "" to UTC and "Local" to the server time zone; neither is a valid IANA name here.
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w %q", errUnknownTimeZone, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", errUnknownTimeZone, name)
+	}
+	return loc, nil
+}
+
+// timeZoneProblem checks that an optional time zone set through the API is an IANA time zone name.
+// It reports false when the time zone is omitted or valid.
+func timeZoneProblem(name *string) (*api.ProblemDetails, bool) {
+	if name == nil {
+		return nil, false
+	}
+	if _, err := loadTimeZone(*name); err != nil {
+		return newProblem(http.StatusBadRequest, "time_zone must be an IANA time zone name, e.g. Asia/Tokyo."), true
+	}
+	return nil, false
+}
+
+// facilityTimeZones returns the time zone in which the local-time rules of each of the facilities are evaluated:
+// its own, else that of the nearest location containing it that sets one, else the default.
+func facilityTimeZones(ctx context.Context, q db.Querier, facilityIDs []int32) (map[int32]*time.Location, error) {
+	rows, err := q.ListFacilityTimeZones(ctx, facilityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list facility time zones: %w", err)
+	}
+
+	zones := make(map[int32]*time.Location, len(facilityIDs))
+	for _, id := range facilityIDs {
+		zones[id] = defaultTimeZone
+	}
+	for _, row := range rows {
+		loc, err := loadTimeZone(row.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load time zone of facility %d: %w", row.FacilityID, err)
+		}
+		zones[row.FacilityID] = loc
+	}
+	return zones, nil
+}
+
+// facilityTimeZone returns the time zone in which the local-time rules of a single facility are evaluated.
+func facilityTimeZone(ctx context.Context, q db.Querier, facilityID int32) (*time.Location, error) {
+	zones, err := facilityTimeZones(ctx, q, []int32{facilityID})
+	if err != nil {
+		return nil, err
+	}
+	return zones[facilityID], nil
+}
+
+// localTime returns the instant at which the wall clock in loc shows wall, a local date and time given as UTC.
+// Like RFC 5545, a wall-clock time skipped by a DST gap is moved forward by the length of the gap,
+// and one repeated by a DST overlap resolves to its first occurrence.
+func localTime(wall time.Time, loc *time.Location) time.Time {
+	_, before := wall.Add(-dstSearchWindow).In(loc).Zone()
+	_, after := wall.Add(dstSearchWindow).In(loc).Zone()
+	for _, offset := range []int{before, after} {
+		if t := wall.Add(-time.Duration(offset) * time.Second).In(loc); wallClock(t).Equal(wall) {
+			return t
+		}
+	}
+	// Within a gap, the offset in effect before it maps wall past the gap.
+	return wall.Add(-time.Duration(before) * time.Second).In(loc)
+}
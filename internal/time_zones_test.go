@@ -0,0 +1,192 @@
+package internal_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thara/facility_reservation_go/internal"
+	"github.com/thara/facility_reservation_go/internal/api"
+)
+
+func TestTimeZonesValidation(t *testing.T) {
+	// These requests are rejected before any database access, so a nil DataStore is sufficient.
+	svc := internal.NewAPIService(nil)
+
+	staffCtx := internal.WithAuthenticatedUser(t.Context(), &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	})
+
+	t.Run("facility create rejects an unknown time zone", func(t *testing.T) {
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:     "Room A",
+			TimeZone: api.NewOptString("Mars/Olympus_Mons"),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesCreateBadRequest{}, res)
+	})
+
+	t.Run("facility update rejects the server time zone", func(t *testing.T) {
+		res, err := svc.FacilitiesUpdate(staffCtx, &api.PublicFacility{
+			Name:     "Room A",
+			TimeZone: api.NewOptString("Local"),
+		}, api.FacilitiesUpdateParams{ID: 1})
+		require.NoError(t, err)
+		assert.IsType(t, &api.FacilitiesUpdateBadRequest{}, res)
+	})
+
+	t.Run("location create rejects an unknown time zone", func(t *testing.T) {
+		res, err := svc.LocationsCreate(staffCtx, &api.Location{
+			Kind:     api.LocationKindSite,
+			Name:     "Campus",
+			TimeZone: api.NewOptString("Mars/Olympus_Mons"),
+		})
+		require.NoError(t, err)
+		assert.IsType(t, &api.LocationsCreateBadRequest{}, res)
+	})
+}
+
+func TestTimeZones(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := t.Context()
+	ds := internal.NewDataStore(setupTestDatabase(ctx, t))
+	svc := internal.NewAPIService(ds)
+
+	staffUser := &internal.AuthenticatedUser{
+		ID:       "staff-user-id",
+		Username: "staff-user",
+		IsStaff:  true,
+	}
+	staffCtx := internal.WithAuthenticatedUser(ctx, staffUser)
+
+	created, err := internal.CreateUser(ctx, ds, staffUser, internal.CreateUserParams{
+		Username: gofakeit.Username(),
+		IsStaff:  false,
+		Email:    nil,
+	})
+	require.NoError(t, err)
+	userCtx := internal.WithAuthenticatedUser(ctx, &internal.AuthenticatedUser{
+		ID:       created.User.ID.String(),
+		Username: created.User.Username,
+		IsStaff:  false,
+	})
+
+	newLocation := func(t *testing.T, req *api.Location) *api.Location {
+		t.Helper()
+		res, err := svc.LocationsCreate(staffCtx, req)
+		require.NoError(t, err)
+		location, ok := res.(*api.Location)
+		require.True(t, ok, "unexpected response %T", res)
+		return location
+	}
+	// The database is shared between runs, so sites get a random suffix.
+	site := newLocation(t, &api.Location{
+		Kind:     api.LocationKindSite,
+		Name:     fmt.Sprintf("Berlin %d", gofakeit.Number(1, math.MaxInt32)),
+		TimeZone: api.NewOptString("Europe/Berlin"),
+	})
+	building := newLocation(t, &api.Location{
+		Kind:     api.LocationKindBuilding,
+		Name:     "Building A",
+		ParentID: api.NewOptUUID(site.ID),
+	})
+
+	newFacility := func(t *testing.T, timeZone api.OptString) *api.PublicFacility {
+		t.Helper()
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{
+			Name:       gofakeit.Company(),
+			LocationID: api.NewOptUUID(building.ID),
+			TimeZone:   timeZone,
+		})
+		require.NoError(t, err)
+		facility, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		return facility
+	}
+	inherited := newFacility(t, api.OptString{})
+	own := newFacility(t, api.NewOptString("Asia/Tokyo"))
+
+	t.Run("facilities inherit the time zone of their location", func(t *testing.T) {
+		assert.False(t, inherited.TimeZone.Set)
+		assert.Equal(t, api.NewOptString("Europe/Berlin"), inherited.EffectiveTimeZone)
+		assert.Equal(t, api.NewOptString("Asia/Tokyo"), own.EffectiveTimeZone)
+
+		res, err := svc.FacilitiesCreate(staffCtx, &api.PublicFacility{Name: gofakeit.Company()})
+		require.NoError(t, err)
+		unlocated, ok := res.(*api.PublicFacility)
+		require.True(t, ok, "unexpected response %T", res)
+		assert.Equal(t, api.NewOptString("UTC"), unlocated.EffectiveTimeZone)
+	})
+
+	t.Run("availability follows opening hours across a DST change", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		// Find the next day on which Berlin changes between standard and daylight saving time.
+		day := time.Now().In(berlin)
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, berlin)
+		for {
+			_, before := day.Zone()
+			_, after := day.AddDate(0, 0, 1).Zone()
+			if before != after {
+				break
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+		_, before := day.Zone()
+		_, after := day.AddDate(0, 0, 1).Zone()
+
+		// Open every day from 01:00 to 05:00, across the change at 02:00 or 03:00.
+		rules := make([]api.OpeningHoursRule, 0, 7)
+		for weekday := int32(0); weekday <= 6; weekday++ {
+			rules = append(rules, api.OpeningHoursRule{Weekday: weekday, OpensAt: clock(1, 0), ClosesAt: clock(5, 0)})
+		}
+		updateRes, err := svc.FacilitiesOpeningHoursUpdate(staffCtx, &api.OpeningHours{Rules: rules},
+			api.FacilitiesOpeningHoursUpdateParams{ID: inherited.ID})
+		require.NoError(t, err)
+		require.IsType(t, &api.OpeningHours{}, updateRes)
+
+		res, err := svc.AvailabilityList(ctx, api.AvailabilityListParams{
+			From:       day.Add(-time.Hour),
+			To:         day.Add(6 * time.Hour),
+			FacilityID: []int{inherited.ID},
+		})
+		require.NoError(t, err)
+		list, ok := res.(*api.AvailabilityListOKApplicationJSON)
+		require.True(t, ok, "unexpected response %T", res)
+		require.Len(t, *list, 1)
+		assert.Equal(t, "Europe/Berlin", (*list)[0].TimeZone)
+
+		slots := (*list)[0].Slots
+		require.Len(t, slots, 1)
+		assert.Equal(t, 1, slots[0].LocalStartsAt.Hour())
+		assert.Equal(t, 5, slots[0].LocalEndsAt.Hour())
+		assert.Equal(t, 4*time.Hour-time.Duration(after-before)*time.Second, slots[0].EndsAt.Sub(slots[0].StartsAt))
+	})
+
+	t.Run("reservations report local timestamps of the facility", func(t *testing.T) {
+		startsAt := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+		res, err := svc.ReservationsCreate(userCtx, &api.ReservationInput{
+			FacilityID: own.ID,
+			Title:      "Meeting",
+			StartsAt:   startsAt,
+			EndsAt:     startsAt.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		reservation, ok := res.(*api.Reservation)
+		require.True(t, ok, "unexpected response %T", res)
+
+		assert.Equal(t, "Asia/Tokyo", reservation.TimeZone)
+		assert.True(t, startsAt.Equal(reservation.LocalStartsAt))
+		_, offset := reservation.LocalStartsAt.Zone()
+		assert.Equal(t, 9*60*60, offset)
+	})
+}
@@ -28,10 +28,25 @@ type AuthenticatedUser struct {
 	IsStaff  bool   `json:"is_staff"`
 }
 
+// authenticatedUserKey is the context key under which the authenticated user is stored.
+type authenticatedUserKey struct{}
+
+// WithAuthenticatedUser returns a new context with the authenticated user stored in it.
+func WithAuthenticatedUser(ctx context.Context, user *AuthenticatedUser) context.Context {
+	return context.WithValue(ctx, authenticatedUserKey{}, user)
+}
+
+// AuthenticatedUserFromContext retrieves the authenticated user from the context.
+func AuthenticatedUserFromContext(ctx context.Context) (*AuthenticatedUser, bool) {
+	user, ok := ctx.Value(authenticatedUserKey{}).(*AuthenticatedUser)
+	return user, ok && user != nil
+}
+
 // CreateUserParams holds parameters for creating a new user.
 type CreateUserParams struct {
 	Username string
 	IsStaff  bool
+	Email    *string
 }
 
 // CreateUserResult holds the result of creating a user with token.
@@ -66,6 +81,7 @@ func CreateUser(
 			ID:       userID,
 			Username: params.Username,
 			IsStaff:  params.IsStaff,
+			Email:    params.Email,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create user: %w", err)
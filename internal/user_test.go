@@ -32,6 +32,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  true,
+			Email:    nil,
 		}
 
 		result, err := internal.CreateUser(ctx, ds, staffUser, params)
@@ -61,6 +62,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		result, err := internal.CreateUser(ctx, ds, staffUser, params)
@@ -79,6 +81,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		_, err := internal.CreateUser(ctx, ds, staffUser, params)
@@ -98,6 +101,7 @@ func TestCreateUser(t *testing.T) {
 			params := internal.CreateUserParams{
 				Username: gofakeit.Name(),
 				IsStaff:  false,
+				Email:    nil,
 			}
 
 			result, err := internal.CreateUser(ctx, ds, staffUser, params)
@@ -113,6 +117,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		result, err := internal.CreateUser(ctx, ds, staffUser, params)
@@ -137,6 +142,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		result, err := internal.CreateUser(ctx, ds, nil, params)
@@ -156,6 +162,7 @@ func TestCreateUser(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		result, err := internal.CreateUser(ctx, ds, nonStaffUser, params)
@@ -189,6 +196,7 @@ func TestCreateUserTransactionRollback(t *testing.T) {
 		params := internal.CreateUserParams{
 			Username: gofakeit.Name(),
 			IsStaff:  false,
+			Email:    nil,
 		}
 
 		// Create user successfully
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thara/facility_reservation_go/internal/db"
+)
+
+// promoteWaitlist promotes waiting entries competing for the period blocked by a released reservation
+// to reservations, in the order they were made. The reservation must still carry the period it blocked
+// before its release. Entries whose period is still taken, blacked out or would exceed a booking quota
+// of their user keep waiting. It returns the promoted entries.
+func promoteWaitlist(ctx context.Context, tx *Transaction, released db.Reservation) ([]db.WaitlistEntry, error) {
+	// The facility is locked for share before its blackouts are checked, see GetFacilityByIDForShare.
+	facility, err := tx.GetFacilityByIDForShare(ctx, released.FacilityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get facility: %w", err)
+	}
+	if !facility.IsActive {
+		return nil, nil
+	}
+	if err := deleteExpiredHolds(ctx, tx, facility.ID); err != nil {
+		return nil, err
+	}
+
+	// An entry competes for the released period when its own blocked period, widened by the buffers, overlaps it.
+	candidates, err := tx.ListWaitlistCandidatesForUpdate(ctx, db.ListWaitlistCandidatesForUpdateParams{
+		FacilityID: facility.ID,
+		From:       released.BlockedPeriod.Lower.Time.Add(-time.Duration(facility.TeardownBufferMinutes) * time.Minute),
+		To:         released.BlockedPeriod.Upper.Time.Add(time.Duration(facility.SetupBufferMinutes) * time.Minute),
+		Now:        time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+
+	var promoted []db.WaitlistEntry
+	for _, entry := range candidates {
+		entry, ok, err := promoteWaitlistEntry(ctx, tx, facility, entry)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			promoted = append(promoted, entry)
+		}
+	}
+	return promoted, nil
+}
+
+// promoteWaitlists promotes waiting entries competing for the periods blocked by each of the released
+// reservations, see promoteWaitlist. It returns the promoted entries.
+func promoteWaitlists(ctx context.Context, tx *Transaction, released []db.Reservation) ([]db.WaitlistEntry, error) {
+	var promoted []db.WaitlistEntry
+	for _, r := range released {
+		entries, err := promoteWaitlist(ctx, tx, r)
+		if err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, entries...)
+	}
+	return promoted, nil
+}
+
+// promoteWaitlistEntry reserves the period of a waiting entry for its user and marks the entry as promoted.
+// It reports false when the entry cannot be reserved yet.
+func promoteWaitlistEntry(
+	ctx context.Context,
+	tx *Transaction,
+	facility db.Facility,
+	entry db.WaitlistEntry,
+) (db.WaitlistEntry, bool, error) {
+	startsAt, endsAt := entry.Period.Lower.Time, entry.Period.Upper.Time
+
+	_, found, err := findBlackout(ctx, tx, facility.ID, startsAt, endsAt)
+	if err != nil {
+		return db.WaitlistEntry{}, false, err
+	}
+	if found {
+		return db.WaitlistEntry{}, false, nil
+	}
+	err = enforceBookingQuotas(ctx, tx, entry.UserID, facility.ID, startsAt, endsAt, nil)
+	var quotaErr *quotaExceededError
+	if errors.As(err, &quotaErr) {
+		return db.WaitlistEntry{}, false, nil
+	}
+	if err != nil {
+		return db.WaitlistEntry{}, false, err
+	}
+
+	// Promotions to facilities requiring approval still have to be approved by staff.
+	status := db.ReservationStatusConfirmed
+	if facility.RequiresApproval {
+		status = db.ReservationStatusPending
+	}
+	reservationID := uuid.Must(uuid.NewV7())
+	blockedStartsAt, blockedEndsAt := blockedPeriod(facility, startsAt, endsAt)
+	created, err := tx.CreateReservationIfFree(ctx, db.CreateReservationIfFreeParams{
+		ID:              reservationID,
+		FacilityID:      facility.ID,
+		UserID:          entry.UserID,
+		Title:           entry.Title,
+		Description:     entry.Description,
+		StartsAt:        startsAt,
+		EndsAt:          endsAt,
+		BlockedStartsAt: blockedStartsAt,
+		BlockedEndsAt:   blockedEndsAt,
+		Status:          status,
+	})
+	if err != nil {
+		return db.WaitlistEntry{}, false, fmt.Errorf("failed to create reservation: %w", err)
+	}
+	if created == 0 {
+		return db.WaitlistEntry{}, false, nil
+	}
+
+	entry, err = tx.PromoteWaitlistEntry(ctx, db.PromoteWaitlistEntryParams{
+		ReservationID: reservationID,
+		ID:            entry.ID,
+	})
+	if err != nil {
+		return db.WaitlistEntry{}, false, fmt.Errorf("failed to promote waitlist entry: %w", err)
+	}
+	return entry, true, nil
+}